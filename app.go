@@ -17,6 +17,7 @@ import (
 	cfgviper "github.com/petabytecl/gaz/config/viper"
 	"github.com/petabytecl/gaz/cron"
 	"github.com/petabytecl/gaz/eventbus"
+	"github.com/petabytecl/gaz/health"
 	"github.com/petabytecl/gaz/logger"
 	"github.com/petabytecl/gaz/worker"
 )
@@ -24,6 +25,8 @@ import (
 const (
 	defaultShutdownTimeout = 30 * time.Second
 	defaultPerHookTimeout  = 10 * time.Second
+
+	defaultStartupGateTimeout = 30 * time.Second
 )
 
 // configProviderType is cached for efficient interface checks.
@@ -52,7 +55,15 @@ func callExitFunc(code int) {
 type AppOptions struct {
 	ShutdownTimeout time.Duration
 	PerHookTimeout  time.Duration
+	PerStartTimeout time.Duration
 	LoggerConfig    *logger.Config
+	EventBusReplay  int
+	EventBusTopics  int
+	CronJitter      time.Duration
+	CronClock       cron.Clock
+
+	WorkerHeartbeatTimeout time.Duration
+	WorkerHeartbeatRestart bool
 }
 
 // Option configures App settings.
@@ -74,6 +85,16 @@ func WithPerHookTimeout(d time.Duration) Option {
 	}
 }
 
+// WithPerStartTimeout bounds each service's OnStart hook to d when Run or
+// Restart starts services. A hook that exceeds it fails startup with an error
+// naming the service that matches ErrStartTimeout, and its context is
+// cancelled. Zero (the default) means no timeout.
+func WithPerStartTimeout(d time.Duration) Option {
+	return func(a *App) {
+		a.opts.PerStartTimeout = d
+	}
+}
+
 // WithLoggerConfig sets the logger configuration.
 func WithLoggerConfig(cfg *logger.Config) Option {
 	return func(a *App) {
@@ -81,6 +102,54 @@ func WithLoggerConfig(cfg *logger.Config) Option {
 	}
 }
 
+// WithEventBusReplay makes the App's EventBus retain the last n events of
+// each type and replay them to new subscribers. This closes startup races
+// where one worker publishes before another has subscribed in OnStart.
+// See eventbus.WithReplay.
+func WithEventBusReplay(n int) Option {
+	return func(a *App) {
+		a.opts.EventBusReplay = n
+	}
+}
+
+// WithEventBusTopicMetrics breaks the App's per-event EventBus metrics down
+// by topic for up to limit distinct topics, counting the rest under
+// eventbus.OtherTopic. See eventbus.WithTopicMetrics.
+func WithEventBusTopicMetrics(limit int) Option {
+	return func(a *App) {
+		a.opts.EventBusTopics = limit
+	}
+}
+
+// WithCronJitter delays each scheduled cron run by a random duration below d,
+// so jobs sharing a schedule do not all start at the same instant. Jobs that
+// implement cron.JitterProvider use their own bound. See cron.WithJitter.
+func WithCronJitter(d time.Duration) Option {
+	return func(a *App) {
+		a.opts.CronJitter = d
+	}
+}
+
+// WithCronClock sets the clock the cron scheduler uses to decide when jobs
+// are due. Tests pass a cron.FakeClock to run scheduled jobs by advancing
+// time instead of waiting. See cron.WithClock.
+func WithCronClock(clock cron.Clock) Option {
+	return func(a *App) {
+		a.opts.CronClock = clock
+	}
+}
+
+// WithWorkerHeartbeat marks workers implementing worker.Heartbeater unhealthy
+// when they have not beaten within timeout, failing the "workers" readiness
+// check. With restart set, a stalled worker is also restarted. See
+// worker.WithHeartbeatTimeout and worker.WithHeartbeatRestart.
+func WithWorkerHeartbeat(timeout time.Duration, restart bool) Option {
+	return func(a *App) {
+		a.opts.WorkerHeartbeatTimeout = timeout
+		a.opts.WorkerHeartbeatRestart = restart
+	}
+}
+
 // WithStrictConfig enables strict configuration validation.
 // If enabled, Build() fails if the config file contains any keys
 // that are not mapped to fields in the config struct.
@@ -94,6 +163,53 @@ func WithStrictConfig() Option {
 	}
 }
 
+// WithSignals sets the OS signals that trigger graceful shutdown.
+// Default is SIGINT and SIGTERM. Passing only SIGTERM is common in containers
+// where the SIGINT double-tap force exit is not wanted.
+//
+// The double-signal force exit only applies when os.Interrupt is in the set.
+func WithSignals(sigs ...os.Signal) Option {
+	return func(a *App) {
+		a.signals = sigs
+	}
+}
+
+// WithReloadHandler registers a callback invoked on SIGHUP instead of
+// shutting down. The App keeps running after the callback returns.
+// SIGHUP is subscribed automatically; it does not need to be passed to WithSignals.
+func WithReloadHandler(fn func(ctx context.Context)) Option {
+	return func(a *App) {
+		a.reloadFn = fn
+	}
+}
+
+// StartupGateOption configures WithStartupGate.
+type StartupGateOption func(*App)
+
+// WithGateTimeout sets how long WithStartupGate waits for the startup checks
+// to pass. Default is 30 seconds.
+func WithGateTimeout(d time.Duration) StartupGateOption {
+	return func(a *App) {
+		a.startupGateTimeout = d
+	}
+}
+
+// WithStartupGate holds worker and scheduler startup until all health startup
+// checks pass, so background consumers don't run against half-initialized
+// dependencies. Run fails with ErrStartupGateFailed if the checks do not pass
+// within the gate timeout (see WithGateTimeout).
+//
+// Has no effect unless the health module is registered.
+func WithStartupGate(opts ...StartupGateOption) Option {
+	return func(a *App) {
+		a.startupGate = true
+		a.startupGateTimeout = defaultStartupGateTimeout
+		for _, opt := range opts {
+			opt(a)
+		}
+	}
+}
+
 // App is the application runtime wrapper.
 // It orchestrates dependency injection, lifecycle management, and signal handling.
 type App struct {
@@ -102,8 +218,11 @@ type App struct {
 	built       bool            // tracks if Build() was called
 	buildErrors []error         // collects registration errors for Build()
 	modules     map[string]bool // tracks registered module names for duplicate detection
+	moduleFlags map[string]bool // tracks module names whose flags have been added
+	pending     []Module        // modules with declared dependencies, applied in Build()
 	cobraCmd    *cobra.Command  // cobra command for module flags integration
 	flagFns     []func(*pflag.FlagSet)
+	flagGroups  []*pflag.FlagSet // named flag sets from AddFlagsGroup, in registration order
 
 	// Logger instance - nil until Build() is called
 	Logger *slog.Logger
@@ -111,7 +230,15 @@ type App struct {
 	// Configuration
 	configMgr    *config.Manager
 	configTarget any
-	strictConfig bool // enables strict config validation
+	strictConfig bool                  // enables strict config validation
+	configHooks  []func(cfg any) error // from WithConfigValidationHook, run after loading
+
+	// Signal handling
+	signals  []os.Signal           // signals that trigger shutdown (nil = SIGINT, SIGTERM)
+	reloadFn func(context.Context) // invoked on SIGHUP instead of shutdown (nil = disabled)
+
+	startupGate        bool          // hold workers until health startup checks pass
+	startupGateTimeout time.Duration // how long the startup gate waits
 
 	// Provider config tracking
 	providerConfigs []providerConfigEntry // collected from ConfigProvider implementers
@@ -134,9 +261,24 @@ type App struct {
 	// EventBus for pub/sub - nil until Build() is called
 	eventBus *eventbus.EventBus
 
-	mu      sync.Mutex
-	running bool
-	stopCh  chan struct{}
+	// Health manager - nil until Build() is called, and only set when the
+	// health module is registered
+	healthMgr *health.Manager
+
+	// Lifecycle callbacks for code outside the DI graph
+	onStarted  []func(context.Context)
+	onStopping []func(context.Context)
+
+	mu              sync.Mutex
+	running         bool
+	buildAbort      error      // set when BuildWithContext gave up on a running build
+	buildMu         sync.Mutex // serializes builds; held instead of mu while providers run
+	stopCh          chan struct{}
+	startupDuration time.Duration // time the last Run took to start (see Metrics)
+
+	// Closed once Run has started all services and workers (see WaitReady)
+	ready     chan struct{}
+	readyOnce sync.Once
 
 	// Stop idempotency
 	stopOnce sync.Once
@@ -177,7 +319,9 @@ func New(opts ...Option) *App {
 				Format: "json",
 			},
 		},
-		modules: make(map[string]bool),
+		modules:     make(map[string]bool),
+		moduleFlags: make(map[string]bool),
+		ready:       make(chan struct{}),
 	}
 	for _, opt := range opts {
 		opt(app)
@@ -202,6 +346,22 @@ func (a *App) Container() *Container {
 	return a.container
 }
 
+// ConfigManager returns the app's config.Manager for operations the App does
+// not wrap, such as AllSettings, Snapshot, Restore, or Reload. ProviderValues
+// reads from the same backend, so values changed through it are visible to
+// providers. WithConfig replaces the manager, so call ConfigManager after it.
+// Returns nil if the app has no config manager.
+//
+// Example:
+//
+//	settings := app.ConfigManager().AllSettings()
+func (a *App) ConfigManager() *config.Manager {
+	if a == nil {
+		return nil
+	}
+	return a.configMgr
+}
+
 // AddFlagsFn registers a function that adds flags to the application.
 // Flags are stored and applied when WithCobra option is processed or
 // when a Cobra command is attached.
@@ -218,6 +378,98 @@ func (a *App) AddFlagsFn(fn func(*pflag.FlagSet)) {
 	}
 }
 
+// AddFlagsGroup registers a function that adds flags under a named group.
+// The flags are collected in a pflag.FlagSet named after the group and added
+// to the application like AddFlagsFn. When a Cobra command is attached via
+// WithCobra, --help lists them in their own "<group> Flags:" section instead
+// of the flat flag list, which keeps help readable for apps using many modules.
+//
+// Calling AddFlagsGroup again with the same name adds to the existing group.
+// Use FlagGroupName to look up a flag's group.
+//
+// Example:
+//
+//	app.AddFlagsGroup("grpc", func(fs *pflag.FlagSet) {
+//	    fs.Int("grpc-port", 50051, "gRPC listen port")
+//	})
+func (a *App) AddFlagsGroup(name string, fn func(*pflag.FlagSet)) {
+	if fn == nil {
+		return
+	}
+	fs := a.FlagGroup(name)
+	if fs == nil {
+		fs = pflag.NewFlagSet(name, pflag.ContinueOnError)
+		a.flagGroups = append(a.flagGroups, fs)
+	}
+	fn(fs)
+	fs.VisitAll(func(f *pflag.Flag) {
+		_ = fs.SetAnnotation(f.Name, FlagGroupAnnotation, []string{name})
+	})
+	a.AddFlagsFn(func(dst *pflag.FlagSet) {
+		dst.AddFlagSet(fs)
+	})
+}
+
+// FlagGroup returns the flag set registered with AddFlagsGroup under name,
+// or nil if there is no such group.
+func (a *App) FlagGroup(name string) *pflag.FlagSet {
+	for _, fs := range a.flagGroups {
+		if fs.Name() == name {
+			return fs
+		}
+	}
+	return nil
+}
+
+// OnStarted registers a callback invoked by Run() after all services and
+// workers have started successfully. Callbacks run sequentially in
+// registration order and receive the context passed to Run().
+//
+// This is intended for code outside the DI graph, such as printing a banner
+// from main(). Callbacks do not fire if Build() or startup fails.
+func (a *App) OnStarted(fn func(ctx context.Context)) *App {
+	if fn == nil {
+		return a
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.onStarted = append(a.onStarted, fn)
+	return a
+}
+
+// WaitReady returns a channel that is closed once Run (or RunOnce) has
+// started all services and workers and the OnStarted callbacks have run.
+// It stays open if Build or startup fails. The channel is closed at most
+// once per App; it stays closed after the App stops.
+//
+// It gives tests and supervisors that launch Run in a goroutine a
+// synchronization point:
+//
+//	go func() { errCh <- app.Run(ctx) }()
+//	select {
+//	case <-app.WaitReady():
+//	case err := <-errCh:
+//	    return err
+//	}
+func (a *App) WaitReady() <-chan struct{} {
+	return a.ready
+}
+
+// OnStopping registers a callback invoked at the very start of shutdown,
+// before any worker or service OnStop hook runs. Callbacks run sequentially
+// in registration order and receive the shutdown context.
+//
+// Callbacks do not fire if the App was never built.
+func (a *App) OnStopping(fn func(ctx context.Context)) *App {
+	if fn == nil {
+		return a
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.onStopping = append(a.onStopping, fn)
+	return a
+}
+
 // EventBus returns the application's EventBus for pub/sub.
 // Returns nil if called before Build().
 // Prefer injecting *eventbus.EventBus as a dependency instead.
@@ -225,6 +477,22 @@ func (a *App) EventBus() *eventbus.EventBus {
 	return a.eventBus
 }
 
+// Scheduler returns the application's cron scheduler, e.g. to trigger a job
+// with TriggerNow from admin tooling. Returns nil if called before Build().
+func (a *App) Scheduler() *cron.Scheduler {
+	return a.scheduler
+}
+
+// HealthManager returns the application's health.Manager.
+// Returns nil if called before Build() or if the health module is not registered.
+//
+// When present, Build() registers readiness checks for the worker manager
+// ("workers"), the cron scheduler ("cron", only if jobs exist), and the
+// EventBus ("eventbus").
+func (a *App) HealthManager() *health.Manager {
+	return a.healthMgr
+}
+
 // getLogger returns the app's logger or slog.Default() if not initialized.
 // This allows methods to safely log before Build() is called.
 func (a *App) getLogger() *slog.Logger {
@@ -273,6 +541,29 @@ func (a *App) WithConfig(target any, opts ...config.Option) *App {
 	return a
 }
 
+// WithConfigValidationHook adds a check that runs after the config target
+// has been loaded and passed struct-tag and Validator validation. The hook
+// receives the populated target passed to WithConfig, so it can enforce
+// cross-field rules the tags cannot express. A returned error fails Build.
+// Hooks run in the order they were added and only when a target is set.
+//
+// Example:
+//
+//	app.WithConfig(&cfg).WithConfigValidationHook(func(c any) error {
+//	    tls := c.(*Config).TLS
+//	    if tls.Enabled && (tls.CertFile == "" || tls.KeyFile == "") {
+//	        return errors.New("tls.cert_file and tls.key_file are required when TLS is enabled")
+//	    }
+//	    return nil
+//	})
+func (a *App) WithConfigValidationHook(hook func(cfg any) error) *App {
+	if a.built {
+		panic("gaz: cannot add config validation hook after Build()")
+	}
+	a.configHooks = append(a.configHooks, hook)
+	return a
+}
+
 // configMapMerger is implemented by backends that support merging config maps.
 type configMapMerger interface {
 	MergeConfigMap(cfg map[string]any) error
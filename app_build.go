@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"runtime/debug"
 
 	"github.com/petabytecl/gaz/cron"
 	"github.com/petabytecl/gaz/di"
@@ -39,6 +40,21 @@ func (a *App) initializeLogger() error {
 		a.Logger, a.logCloser = logger.NewLoggerWithCloser(&cfg)
 	}
 
+	// Let registered services (e.g. the OTEL log bridge) wrap the handler
+	// before the logger is shared.
+	wrappers, err := ResolveAll[logger.HandlerWrapper](a.container)
+	if err != nil {
+		return fmt.Errorf("resolve log handler wrappers: %w", err)
+	}
+	if len(wrappers) > 0 {
+		handler := a.Logger.Handler()
+		for _, w := range wrappers {
+			handler = w.WrapHandler(handler)
+		}
+		a.Logger = slog.New(handler)
+		slog.SetDefault(a.Logger)
+	}
+
 	// Register Logger in container
 	if regErr := For[*slog.Logger](a.container).Instance(a.Logger); regErr != nil {
 		return fmt.Errorf("register logger: %w", regErr)
@@ -50,7 +66,7 @@ func (a *App) initializeLogger() error {
 
 // initializeSubsystems creates WorkerManager, Scheduler, EventBus.
 // Called during Build() after logger is initialized.
-func (a *App) initializeSubsystems() error {
+func (a *App) initializeSubsystems(ctx context.Context) error {
 	// Use slog.Default() if Logger is nil (shouldn't happen after initializeLogger)
 	log := a.Logger
 	if log == nil {
@@ -58,7 +74,14 @@ func (a *App) initializeSubsystems() error {
 	}
 
 	// WorkerManager
-	a.workerMgr = worker.NewManager(log)
+	workerOpts := []worker.ManagerOption{worker.WithStopLayerTimeout(a.opts.PerHookTimeout)}
+	if a.opts.WorkerHeartbeatTimeout > 0 {
+		workerOpts = append(workerOpts, worker.WithHeartbeatTimeout(a.opts.WorkerHeartbeatTimeout))
+		if a.opts.WorkerHeartbeatRestart {
+			workerOpts = append(workerOpts, worker.WithHeartbeatRestart())
+		}
+	}
+	a.workerMgr = worker.NewManager(log, workerOpts...)
 	a.workerMgr.SetCriticalFailHandler(func() {
 		log.Error("critical worker failed, initiating shutdown")
 		go func() {
@@ -69,16 +92,27 @@ func (a *App) initializeSubsystems() error {
 	})
 
 	// Scheduler with cancellable context
-	a.cronCtx, a.cronCancel = context.WithCancel(context.Background())
-	a.scheduler = cron.NewScheduler(a.container, a.cronCtx, log)
+	a.cronCtx, a.cronCancel = context.WithCancel(context.WithoutCancel(ctx))
+	a.scheduler = cron.NewScheduler(a.container, a.cronCtx, log,
+		cron.WithJitter(a.opts.CronJitter),
+		cron.WithClock(a.opts.CronClock),
+	)
 
 	// EventBus
-	a.eventBus = eventbus.New(log)
+	a.eventBus = eventbus.New(log,
+		eventbus.WithReplay(a.opts.EventBusReplay),
+		eventbus.WithTopicMetrics(a.opts.EventBusTopics),
+	)
 
 	// Register EventBus in container
 	if err := For[*eventbus.EventBus](a.container).Instance(a.eventBus); err != nil {
 		return fmt.Errorf("register eventbus: %w", err)
 	}
+
+	// Register the App as the metrics source for observability modules
+	if err := For[MetricsSource](a.container).Instance(a); err != nil {
+		return fmt.Errorf("register metrics source: %w", err)
+	}
 	return nil
 }
 
@@ -104,8 +138,13 @@ func (a *App) registerInstance(instance any) error {
 }
 
 // discoverWorkers iterates registered services and registers those implementing
-// worker.Worker interface with the WorkerManager.
-func (a *App) discoverWorkers() {
+// worker.Worker interface with the WorkerManager. Workers are stopped in
+// reverse dependency order, like services. Returns the provider panics
+// raised while resolving services (see discoveryError).
+func (a *App) discoverWorkers() error {
+	var errs []error
+	workers := make(map[string]di.ServiceWrapper)
+	workerNames := make(map[string]string)
 	a.container.ForEachService(func(name string, svc di.ServiceWrapper) {
 		// Skip transient services
 		if svc.IsTransient() {
@@ -113,22 +152,87 @@ func (a *App) discoverWorkers() {
 		}
 
 		// Try to resolve and check for Worker interface
-		instance, err := a.container.ResolveByName(name, nil)
+		instance, err := a.resolveForDiscovery(name)
 		if err != nil {
-			return // Skip services that fail to resolve
+			// Skip services that fail to resolve
+			if panicErr := discoveryError(svc, err); panicErr != nil {
+				errs = append(errs, panicErr)
+			}
+			return
+		}
+
+		// The App manages the EventBus itself so it outlives services during
+		// shutdown (see doStop); it must not be supervised as a worker.
+		if instance == any(a.eventBus) {
+			return
 		}
 
 		if w, ok := instance.(worker.Worker); ok {
-			// Register with default options
-			// Providers can customize via WithWorkerOptions in future
-			if regErr := a.workerMgr.Register(w); regErr != nil {
+			// Workers may supply their own options; defaults apply otherwise
+			var opts []worker.WorkerOption
+			if op, isProvider := w.(worker.OptionsProvider); isProvider {
+				opts = op.WorkerOptions()
+			}
+			if regErr := a.workerMgr.Register(w, opts...); regErr != nil {
 				a.getLogger().Warn("failed to register worker",
 					"name", name,
 					"error", regErr,
 				)
+				return
 			}
+			workers[name] = svc
+			workerNames[name] = w.Name()
 		}
 	})
+
+	a.workerMgr.SetStopOrder(a.workerStopOrder(workers, workerNames))
+	return errors.Join(errs...)
+}
+
+// workerStopOrder returns layers of worker names in reverse dependency order:
+// a worker that resolved another worker, directly or through other services,
+// stops first. Discovery resolved every worker, so the graph is complete.
+func (a *App) workerStopOrder(workers map[string]di.ServiceWrapper, workerNames map[string]string) [][]string {
+	startupOrder, err := ComputeStartupOrder(a.container.GetGraph(), workers)
+	if err != nil {
+		// Resolution already rejects cycles; fall back to concurrent stop.
+		a.getLogger().Warn("failed to compute worker stop order", "error", err)
+		return nil
+	}
+
+	shutdownOrder := ComputeShutdownOrder(startupOrder)
+	layers := make([][]string, 0, len(shutdownOrder))
+	for _, layer := range shutdownOrder {
+		names := make([]string, 0, len(layer))
+		for _, name := range layer {
+			names = append(names, workerNames[name])
+		}
+		layers = append(layers, names)
+	}
+	return layers
+}
+
+// resolveForDiscovery resolves a service by name, returning a provider panic
+// as a *di.PanicError.
+func (a *App) resolveForDiscovery(name string) (instance any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &di.PanicError{Service: name, Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return a.container.ResolveByName(name, nil)
+}
+
+// discoveryError returns err if it is a provider panic that Build must
+// report, or nil for resolution failures that discovery skips. Panics of
+// eager services return nil too: container.Build resolves them again and
+// reports the panic itself.
+func discoveryError(svc di.ServiceWrapper, err error) error {
+	var panicErr *di.PanicError
+	if !errors.As(err, &panicErr) || svc.IsEager() {
+		return nil
+	}
+	return err
 }
 
 // discoverCronJobs iterates registered services and registers those implementing
@@ -141,9 +245,23 @@ func (a *App) discoverWorkers() {
 //
 // This ensures the service is registered with the CronJob interface type,
 // allowing discovery without resolving unrelated transient services.
-func (a *App) discoverCronJobs() {
+//
+// Returns the provider panics raised while resolving transient jobs; worker
+// discovery already reported those of the others.
+func (a *App) discoverCronJobs() error {
+	var errs []error
 	cronJobTypeName := di.TypeName[cron.CronJob]()
 
+	// A registered Locker coordinates job runs across replicas.
+	if Has[cron.Locker](a.container) {
+		locker, err := Resolve[cron.Locker](a.container)
+		if err != nil {
+			a.getLogger().Warn("failed to resolve cron locker", "error", err)
+		} else {
+			cron.WithLocker(locker)(a.scheduler)
+		}
+	}
+
 	a.container.ForEachService(func(name string, svc di.ServiceWrapper) {
 		// Only process services registered as cron.CronJob interface
 		// TypeName() returns the interface type, so check if name equals it
@@ -160,18 +278,28 @@ func (a *App) discoverCronJobs() {
 		}
 
 		// Try to resolve and check for CronJob interface
-		instance, err := a.container.ResolveByName(name, nil)
+		instance, err := a.resolveForDiscovery(name)
 		if err != nil {
-			return // Skip services that fail to resolve
+			// Skip services that fail to resolve
+			if panicErr := discoveryError(svc, err); panicErr != nil && svc.IsTransient() {
+				errs = append(errs, panicErr)
+			}
+			return
 		}
 
 		if job, ok := instance.(cron.CronJob); ok {
+			var opts []cron.JobOption
+			if jp, ok := job.(cron.JitterProvider); ok {
+				opts = append(opts, cron.WithJobJitter(jp.Jitter()))
+			}
+
 			// Register with scheduler using service name for later resolution
 			if regErr := a.scheduler.RegisterJob(
 				name,           // serviceName for container resolution
 				job.Name(),     // human name for logging
 				job.Schedule(), // cron expression
 				job.Timeout(),  // execution timeout
+				opts...,
 			); regErr != nil {
 				a.getLogger().Warn("failed to register cron job",
 					"name", job.Name(),
@@ -180,17 +308,111 @@ func (a *App) discoverCronJobs() {
 			}
 		}
 	})
+	return errors.Join(errs...)
+}
+
+// registerSubsystemHealthChecks adds readiness checks for the worker manager,
+// cron scheduler, and EventBus to the health.Manager, if one is registered.
+// Called during Build() after workers and cron jobs are discovered.
+func (a *App) registerSubsystemHealthChecks() error {
+	if !Has[*health.Manager](a.container) {
+		return nil
+	}
+
+	mgr, err := Resolve[*health.Manager](a.container)
+	if err != nil {
+		return fmt.Errorf("resolve health manager: %w", err)
+	}
+
+	mgr.AddReadinessCheck("workers", a.workerMgr.HealthCheck)
+	if a.scheduler.JobCount() > 0 {
+		mgr.AddReadinessCheck("cron", a.scheduler.JobHealthCheck)
+	}
+	mgr.AddReadinessCheck("eventbus", a.eventBus.HealthCheck)
+
+	a.healthMgr = mgr
+	return nil
 }
 
 // Build validates all registrations and instantiates eager services.
 // It aggregates all errors and returns them using errors.Join.
 // Build is idempotent - calling it multiple times after success returns nil.
 func (a *App) Build() error {
-	a.mu.Lock()
-	defer a.mu.Unlock()
+	return a.build(context.Background())
+}
+
+// BuildWithContext is like Build but respects ctx: once ctx is done it
+// returns an error wrapping ctx.Err() without waiting for a hanging provider.
+// Use it to put a deadline on startup. Run calls it with its own context.
+//
+// Providers do not take a context, so an abandoned provider keeps running in
+// the background and the build stops at the next phase once it returns. The
+// app is then failed: later Build calls return the context error without
+// waiting for it, and Stop does not block on it.
+// Cancellation only bounds the build itself; subsystems created here (such
+// as the cron scheduler) keep ctx's values but not its deadline.
+func (a *App) BuildWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("gaz: build aborted: %w", err)
+	}
+
+	type result struct {
+		err   error
+		panic *di.PanicError
+	}
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		defer func() {
+			if p := recover(); p != nil {
+				// Keep the stack of the panicking goroutine; re-raising the
+				// bare value in the caller would lose it.
+				if pe, ok := p.(*di.PanicError); ok {
+					r.panic = pe
+				} else {
+					r.panic = &di.PanicError{Value: p, Stack: debug.Stack()}
+				}
+			}
+			done <- r
+		}()
+		r.err = a.build(ctx)
+	}()
+
+	select {
+	case r := <-done:
+		if r.panic != nil {
+			panic(r.panic) // Re-raise build panics in the caller
+		}
+		return r.err
+	case <-ctx.Done():
+		err := fmt.Errorf("gaz: build aborted: %w", ctx.Err())
+		a.mu.Lock()
+		if !a.built && a.buildAbort == nil {
+			a.buildAbort = err
+		}
+		a.mu.Unlock()
+		return err
+	}
+}
+
+// build runs the Build phases, checking ctx before the phases that resolve
+// services. Builds are serialized by buildMu rather than mu, so a build
+// abandoned by BuildWithContext does not block Stop and other users of mu.
+func (a *App) build(ctx context.Context) error {
+	if built, err := a.buildStatus(); built || err != nil {
+		return err // Already built (idempotent) or aborted
+	}
+
+	a.buildMu.Lock()
+	defer a.buildMu.Unlock()
+
+	if built, err := a.buildStatus(); built || err != nil {
+		return err // Built or aborted while waiting for buildMu
+	}
 
-	if a.built {
-		return nil // Already built, idempotent
+	// Apply modules with declared dependencies in dependency order
+	if err := a.applyPendingModules(); err != nil {
+		return err
 	}
 
 	// Load configuration first
@@ -214,7 +436,7 @@ func (a *App) Build() error {
 	}
 
 	// Initialize subsystems (WorkerManager, Scheduler, EventBus) after logger
-	if err := a.initializeSubsystems(); err != nil {
+	if err := a.initializeSubsystems(ctx); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -250,16 +472,21 @@ func (a *App) Build() error {
 		}
 	}
 
-	// Discover workers from registered services
-	a.discoverWorkers()
+	// Discovery resolves services, so stop here if the caller gave up
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("gaz: build aborted: %w", err))
+		return errors.Join(errs...)
+	}
 
-	// Register EventBus with worker manager for lifecycle management
-	if err := a.workerMgr.Register(a.eventBus); err != nil {
-		errs = append(errs, fmt.Errorf("registering eventbus: %w", err))
+	// Discover workers from registered services
+	if err := a.discoverWorkers(); err != nil {
+		errs = append(errs, fmt.Errorf("discover workers: %w", err))
 	}
 
 	// Discover cron jobs from registered services
-	a.discoverCronJobs()
+	if err := a.discoverCronJobs(); err != nil {
+		errs = append(errs, fmt.Errorf("discover cron jobs: %w", err))
+	}
 
 	// Register scheduler with worker manager (only if jobs exist)
 	if a.scheduler.JobCount() > 0 {
@@ -268,8 +495,14 @@ func (a *App) Build() error {
 		}
 	}
 
-	// Delegate to container.Build() for eager instantiation
-	if err := a.container.Build(); err != nil {
+	// Wire subsystem readiness checks before eager services (such as the
+	// health ManagementServer) snapshot the manager's checks
+	if err := a.registerSubsystemHealthChecks(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Delegate to container.BuildWithContext() for eager instantiation
+	if err := a.container.BuildWithContext(ctx); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -277,6 +510,19 @@ func (a *App) Build() error {
 		return errors.Join(errs...)
 	}
 
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.buildAbort != nil {
+		return a.buildAbort
+	}
 	a.built = true
 	return nil
 }
+
+// buildStatus reports whether Build has completed, and the error of an
+// aborted build, if any.
+func (a *App) buildStatus() (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.built, a.buildAbort
+}
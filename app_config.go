@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 
 	"github.com/petabytecl/gaz/config"
 	cfgviper "github.com/petabytecl/gaz/config/viper"
@@ -37,6 +38,11 @@ func (a *App) loadConfig() error {
 				return fmt.Errorf("loading config into target: %w", err)
 			}
 		}
+		for _, hook := range a.configHooks {
+			if err := hook(a.configTarget); err != nil {
+				return fmt.Errorf("validating config: %w", err)
+			}
+		}
 	} else {
 		// Otherwise just load the config file (for ConfigProvider pattern)
 		if err := a.configMgr.Load(); err != nil {
@@ -149,6 +155,7 @@ func (a *App) collectProviderConfigs() error {
 		return nil // Already collected
 	}
 	keyOwners := make(map[string]string)
+	var ownedKeys []string // Registration order, for deterministic errors
 	var collisionErrors []error
 
 	// Iterate in sorted order for deterministic dependency graph recording
@@ -205,15 +212,27 @@ func (a *App) collectProviderConfigs() error {
 
 		// Check for collisions
 		for _, flag := range flags {
-			fullKey := namespace + "." + flag.Key
+			fullKey := fullConfigKey(namespace, flag)
 			if existingProvider, found := keyOwners[fullKey]; found {
 				collisionErrors = append(collisionErrors, fmt.Errorf(
 					"%w: key %q registered by both %q and %q",
 					ErrConfigKeyCollision, fullKey, existingProvider, typeName,
 				))
-			} else {
-				keyOwners[fullKey] = typeName
+				continue
 			}
+
+			// A key cannot be both a value and a group of other keys
+			// (e.g., "server.http" and "server.http.port").
+			for _, existingKey := range ownedKeys {
+				if strings.HasPrefix(fullKey, existingKey+".") || strings.HasPrefix(existingKey, fullKey+".") {
+					collisionErrors = append(collisionErrors, fmt.Errorf(
+						"%w: key %q registered by %q overlaps key %q registered by %q",
+						ErrConfigKeyCollision, fullKey, typeName, existingKey, keyOwners[existingKey],
+					))
+				}
+			}
+			keyOwners[fullKey] = typeName
+			ownedKeys = append(ownedKeys, fullKey)
 		}
 	}
 
@@ -235,15 +254,7 @@ func (a *App) registerProviderFlags() error {
 
 	var validationErrors []error
 	for _, entry := range a.providerConfigs {
-		// Convert gaz.ConfigFlag to config.ConfigFlag
-		cfgFlags := make([]config.ConfigFlag, len(entry.flags))
-		for i, f := range entry.flags {
-			cfgFlags[i] = config.ConfigFlag{
-				Key:      f.Key,
-				Default:  f.Default,
-				Required: f.Required,
-			}
-		}
+		cfgFlags := toConfigFlags(entry.flags)
 
 		if err := a.configMgr.RegisterProviderFlags(entry.namespace, cfgFlags); err != nil {
 			return fmt.Errorf("registering provider flags for %s: %w", entry.namespace, err)
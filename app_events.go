@@ -0,0 +1,70 @@
+package gaz
+
+import (
+	"context"
+
+	"github.com/petabytecl/gaz/eventbus"
+)
+
+// Lifecycle events published on the App's EventBus during Run and Stop.
+// Subscribe to them to trigger warm-up, cache priming, or metrics:
+//
+//	eventbus.Subscribe(app.EventBus(), func(ctx context.Context, e gaz.ServiceStarted) {
+//	    startedServices.Inc()
+//	})
+//
+// Each event type is delivered in publish order, but subscribers of different
+// event types run independently.
+type (
+	// AppStarting is published when Run begins starting services.
+	AppStarting struct{}
+
+	// AppStarted is published once all services and workers have started.
+	AppStarted struct{}
+
+	// AppStopping is published when shutdown begins, before anything is
+	// torn down.
+	AppStopping struct{}
+
+	// AppStopped is published after all services have stopped, just before
+	// the EventBus closes.
+	AppStopped struct{}
+
+	// ServiceStarted is published after a service's OnStart hook succeeds.
+	ServiceStarted struct {
+		Name string
+	}
+
+	// ServiceStopped is published after a service's OnStop hook returns,
+	// whether or not it failed.
+	ServiceStopped struct {
+		Name string
+	}
+)
+
+// EventName implements eventbus.Event.
+func (AppStarting) EventName() string { return "AppStarting" }
+
+// EventName implements eventbus.Event.
+func (AppStarted) EventName() string { return "AppStarted" }
+
+// EventName implements eventbus.Event.
+func (AppStopping) EventName() string { return "AppStopping" }
+
+// EventName implements eventbus.Event.
+func (AppStopped) EventName() string { return "AppStopped" }
+
+// EventName implements eventbus.Event.
+func (ServiceStarted) EventName() string { return "ServiceStarted" }
+
+// EventName implements eventbus.Event.
+func (ServiceStopped) EventName() string { return "ServiceStopped" }
+
+// publishLifecycle publishes a lifecycle event on the App's EventBus.
+// It is a no-op before Build creates the bus.
+func publishLifecycle[T eventbus.Event](ctx context.Context, a *App, event T) {
+	if a.eventBus == nil {
+		return
+	}
+	eventbus.Publish(ctx, a.eventBus, event, "")
+}
@@ -0,0 +1,97 @@
+package gaz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/petabytecl/gaz/eventbus"
+)
+
+func (s *AppTestSuite) TestLifecycleEventsPublishedDuringRunAndStop() {
+	app := New()
+
+	err := For[*AppTestServiceA](app.Container()).Named("A").Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{}, nil
+		})
+	s.Require().NoError(err)
+
+	err = For[*AppTestServiceB](app.Container()).Named("B").Eager().
+		Provider(func(c *Container) (*AppTestServiceB, error) {
+			a, resolveErr := Resolve[*AppTestServiceA](c, Named("A"))
+			if resolveErr != nil {
+				return nil, resolveErr
+			}
+			return &AppTestServiceB{A: a}, nil
+		})
+	s.Require().NoError(err)
+
+	s.Require().NoError(app.Build())
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+	snapshot := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(events)
+	}
+
+	bus := app.EventBus()
+	eventbus.Subscribe(bus, func(_ context.Context, _ AppStarting) { record("app starting") })
+	eventbus.Subscribe(bus, func(_ context.Context, _ AppStarted) { record("app started") })
+	eventbus.Subscribe(bus, func(_ context.Context, _ AppStopping) { record("app stopping") })
+	eventbus.Subscribe(bus, func(_ context.Context, _ AppStopped) { record("app stopped") })
+	eventbus.Subscribe(bus, func(_ context.Context, e ServiceStarted) { record("started " + e.Name) })
+	eventbus.Subscribe(bus, func(_ context.Context, e ServiceStopped) { record("stopped " + e.Name) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	// Every startup event is delivered before shutdown begins
+	s.Eventually(func() bool { return len(snapshot()) == 4 }, time.Second, 10*time.Millisecond)
+	startup := snapshot()
+	s.ElementsMatch([]string{"app starting", "started A", "started B", "app started"}, startup)
+	s.Equal([]string{"started A", "started B"}, filterPrefix(startup, "started "))
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(2 * time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+
+	// Stop closes the bus after draining, so every event has been handled
+	shutdown := snapshot()[4:]
+	s.ElementsMatch([]string{"app stopping", "stopped B", "stopped A", "app stopped"}, shutdown)
+	s.Equal([]string{"stopped B", "stopped A"}, filterPrefix(shutdown, "stopped "))
+}
+
+func (s *AppTestSuite) TestPublishLifecycleBeforeBuildIsNoop() {
+	app := New()
+	s.Nil(app.EventBus())
+	s.NotPanics(func() {
+		publishLifecycle(context.Background(), app, AppStarting{})
+	})
+}
+
+// filterPrefix returns the events that start with prefix, in order.
+func filterPrefix(events []string, prefix string) []string {
+	var out []string
+	for _, e := range events {
+		if strings.HasPrefix(e, prefix) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,212 @@
+package gaz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/petabytecl/gaz/health"
+	"github.com/petabytecl/gaz/worker"
+)
+
+// =============================================================================
+// Health Test Helpers
+// =============================================================================
+
+// failingStartWorker returns an error from every OnStart call.
+type failingStartWorker struct {
+	name string
+}
+
+func (w *failingStartWorker) OnStart(_ context.Context) error {
+	return errors.New("intentional start failure")
+}
+
+func (w *failingStartWorker) OnStop(_ context.Context) error { return nil }
+
+func (w *failingStartWorker) Name() string { return w.name }
+
+// silentWorker implements worker.Heartbeater but never beats.
+type silentWorker struct {
+	worker.Heartbeat
+	name string
+}
+
+func (w *silentWorker) OnStart(_ context.Context) error { return nil }
+
+func (w *silentWorker) OnStop(_ context.Context) error { return nil }
+
+func (w *silentWorker) Name() string { return w.name }
+
+// newHealthTestApp creates an App with the health module registered on a
+// random port.
+func newHealthTestApp(opts ...Option) *App {
+	app := New(opts...)
+	app.Module("health",
+		func(c *Container) error {
+			return For[health.Config](c).Instance(health.TestConfig())
+		},
+		health.Module,
+	)
+	return app
+}
+
+// =============================================================================
+// App Health Integration Tests
+// =============================================================================
+
+func (s *AppTestSuite) TestApp_HealthManager_NilBeforeBuild() {
+	app := newHealthTestApp()
+	s.Nil(app.HealthManager())
+}
+
+func (s *AppTestSuite) TestApp_HealthManager_NilWithoutHealthModule() {
+	app := New()
+	s.Require().NoError(app.Build())
+	s.Nil(app.HealthManager())
+}
+
+func (s *AppTestSuite) TestApp_HealthManager_SubsystemChecksRegistered() {
+	app := newHealthTestApp()
+	s.Require().NoError(app.Build())
+
+	mgr := app.HealthManager()
+	s.Require().NotNil(mgr)
+
+	result := mgr.ReadinessChecker().Check(context.Background())
+	s.Contains(result.Details, "shutdown")
+	s.Contains(result.Details, "workers")
+	s.Contains(result.Details, "eventbus")
+	// No cron jobs registered, so no cron check
+	s.NotContains(result.Details, "cron")
+}
+
+func (s *AppTestSuite) TestApp_HealthManager_TrippedWorkerFlipsReadiness() {
+	app := newHealthTestApp()
+	s.Require().NoError(app.Build())
+
+	// Register a worker that trips its circuit breaker on first failure
+	s.Require().NoError(app.workerMgr.Register(
+		&failingStartWorker{name: "tripping-worker"},
+		worker.WithMaxRestarts(1),
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(ctx)
+	}()
+
+	mgr := app.HealthManager()
+	s.Require().Eventually(func() bool {
+		result := mgr.ReadinessChecker().Check(context.Background())
+		return result.Details["workers"].Status == health.StatusDown
+	}, 2*time.Second, 10*time.Millisecond)
+
+	result := mgr.ReadinessChecker().Check(context.Background())
+	s.Equal(health.StatusDown, result.Status)
+	s.ErrorIs(result.Details["workers"].Error, worker.ErrCircuitBreakerTripped)
+
+	cancel()
+	select {
+	case <-runErr:
+	case <-time.After(5 * time.Second):
+		s.Fail("app did not stop")
+	}
+}
+
+func (s *AppTestSuite) TestApp_WorkerHeartbeat_FlipsReadiness() {
+	app := newHealthTestApp(WithWorkerHeartbeat(50*time.Millisecond, false))
+	s.Require().NoError(app.Build())
+	s.Require().NoError(app.workerMgr.Register(&silentWorker{name: "silent-worker"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(ctx)
+	}()
+
+	mgr := app.HealthManager()
+	s.Require().Eventually(func() bool {
+		result := mgr.ReadinessChecker().Check(context.Background())
+		return result.Details["workers"].Status == health.StatusDown
+	}, 2*time.Second, 10*time.Millisecond)
+
+	result := mgr.ReadinessChecker().Check(context.Background())
+	s.ErrorIs(result.Details["workers"].Error, worker.ErrHeartbeatTimeout)
+
+	cancel()
+	select {
+	case <-runErr:
+	case <-time.After(5 * time.Second):
+		s.Fail("app did not stop")
+	}
+}
+
+func (s *AppTestSuite) TestApp_StartupGate_HoldsWorkersUntilReady() {
+	app := newHealthTestApp(WithStartupGate())
+
+	testW := newTestWorker("gated-worker")
+	s.Require().NoError(For[*testWorker](app.Container()).Instance(testW))
+	s.Require().NoError(app.Build())
+
+	readyAt := time.Now().Add(200 * time.Millisecond)
+	app.HealthManager().AddStartupCheck("warmup", func(_ context.Context) error {
+		if time.Now().Before(readyAt) {
+			return errors.New("still warming up")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(ctx)
+	}()
+
+	select {
+	case <-testW.started:
+		s.False(time.Now().Before(readyAt), "worker started before startup checks passed")
+	case <-time.After(2 * time.Second):
+		s.Fail("worker did not start")
+	}
+
+	cancel()
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		s.Fail("app did not stop")
+	}
+}
+
+func (s *AppTestSuite) TestApp_StartupGate_FailsWhenNeverReady() {
+	app := newHealthTestApp(WithStartupGate(WithGateTimeout(500 * time.Millisecond)))
+
+	testW := newTestWorker("never-started-worker")
+	s.Require().NoError(For[*testWorker](app.Container()).Instance(testW))
+	s.Require().NoError(app.Build())
+
+	app.HealthManager().AddStartupCheck("never", func(_ context.Context) error {
+		return errors.New("never ready")
+	})
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case err := <-runErr:
+		s.Require().ErrorIs(err, ErrStartupGateFailed)
+	case <-time.After(2 * time.Second):
+		s.Fail("Run did not fail within the gate timeout")
+	}
+	s.Zero(testW.getStartCount(), "worker should not start when gate fails")
+}
@@ -0,0 +1,70 @@
+package gaz
+
+import (
+	"time"
+
+	"github.com/petabytecl/gaz/cron"
+	"github.com/petabytecl/gaz/eventbus"
+	"github.com/petabytecl/gaz/worker"
+)
+
+// AppMetrics is a point-in-time snapshot of the App's lifecycle and
+// subsystem counters, as returned by App.Metrics.
+type AppMetrics struct {
+	// Services is the number of services registered in the container.
+	Services int
+
+	// StartupDuration is how long the last Run took from the start of the
+	// lifecycle until all services and workers were up. Zero until Run has
+	// started successfully.
+	StartupDuration time.Duration
+
+	// Workers holds the worker manager's counters.
+	Workers worker.Stats
+
+	// Jobs holds the status of every registered cron job.
+	Jobs []cron.JobStatus
+
+	// EventBus holds the EventBus delivery counters.
+	EventBus eventbus.Metrics
+}
+
+// MetricsSource provides AppMetrics snapshots. During Build the App
+// registers itself in the container as a MetricsSource, so modules such as
+// server/metrics can report on it without a reference to the App.
+type MetricsSource interface {
+	Metrics() AppMetrics
+}
+
+// JobCount returns the number of registered cron jobs.
+func (m AppMetrics) JobCount() int {
+	return len(m.Jobs)
+}
+
+// Metrics returns a snapshot of the App's lifecycle and subsystem counters.
+// It combines worker.Manager.Stats, cron.Scheduler.Status, and
+// eventbus.EventBus.Metrics so a /metrics endpoint or debug page can report
+// on the whole application in one call. Before Build(), only Services is set.
+//
+// Example:
+//
+//	m := app.Metrics()
+//	log.Printf("%d workers, %d restarts, %d events dropped",
+//	    m.Workers.Workers, m.Workers.Restarts, m.EventBus.Dropped)
+func (a *App) Metrics() AppMetrics {
+	a.mu.Lock()
+	m := AppMetrics{StartupDuration: a.startupDuration}
+	a.mu.Unlock()
+
+	m.Services = len(a.container.List())
+	if a.workerMgr != nil {
+		m.Workers = a.workerMgr.Stats()
+	}
+	if a.scheduler != nil {
+		m.Jobs = a.scheduler.Status()
+	}
+	if a.eventBus != nil {
+		m.EventBus = a.eventBus.Metrics()
+	}
+	return m
+}
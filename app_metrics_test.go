@@ -0,0 +1,79 @@
+package gaz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz/cron"
+	"github.com/petabytecl/gaz/eventbus"
+)
+
+type metricsTestEvent struct{}
+
+func (metricsTestEvent) EventName() string { return "metricsTestEvent" }
+
+func TestAppMetrics_BeforeBuild(t *testing.T) {
+	app := New()
+	require.NoError(t, For[*testWorker](app.Container()).Named("w").Instance(newTestWorker("w")))
+
+	m := app.Metrics()
+	assert.Equal(t, 1, m.Services)
+	assert.Zero(t, m.StartupDuration)
+	assert.Zero(t, m.Workers.Workers)
+	assert.Zero(t, m.JobCount())
+}
+
+func TestAppMetrics_ReflectsSubsystems(t *testing.T) {
+	app := New()
+	require.NoError(t, For[*testWorker](app.Container()).Named("metrics-worker").
+		Instance(newTestWorker("metrics-worker")))
+	require.NoError(t, For[cron.CronJob](app.Container()).Named("metrics-job").Transient().
+		Provider(func(_ *Container) (cron.CronJob, error) {
+			return &TestCronJob{name: "metrics-job", schedule: "@hourly"}, nil
+		}))
+	require.NoError(t, app.Build())
+
+	delivered := make(chan struct{}, 1)
+	eventbus.Subscribe(app.EventBus(), func(_ context.Context, _ metricsTestEvent) {
+		delivered <- struct{}{}
+	})
+
+	err := app.RunOnce(context.Background(), func(ctx context.Context) error {
+		m := app.Metrics()
+		assert.Positive(t, m.StartupDuration)
+		assert.Equal(t, 2, m.Workers.Workers, "the worker and the cron scheduler")
+		assert.True(t, m.Workers.Running)
+		assert.Zero(t, m.Workers.Restarts)
+		require.Equal(t, 1, m.JobCount())
+		assert.Equal(t, "metrics-job", m.Jobs[0].Name)
+		assert.Zero(t, m.Jobs[0].Runs)
+		assert.Equal(t, 1, m.EventBus.Subscriptions)
+		before := m.EventBus
+
+		require.NoError(t, app.Scheduler().TriggerNow(ctx, "metrics-job"))
+		require.NoError(t, app.RestartWorker(ctx, "metrics-worker"))
+		eventbus.Publish(ctx, app.EventBus(), metricsTestEvent{}, "")
+		select {
+		case <-delivered:
+		case <-time.After(2 * time.Second):
+			t.Fatal("event not delivered")
+		}
+
+		m = app.Metrics()
+		assert.Equal(t, 2, m.Workers.Workers)
+		assert.Equal(t, int64(1), m.Workers.Restarts)
+		assert.Equal(t, int64(1), m.Jobs[0].Runs)
+		assert.Zero(t, m.Jobs[0].Failures)
+		assert.False(t, m.Jobs[0].LastRun.IsZero())
+		assert.Equal(t, before.Published+1, m.EventBus.Published)
+		assert.Eventually(t, func() bool {
+			return app.Metrics().EventBus.Delivered == before.Delivered+1
+		}, time.Second, 10*time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+}
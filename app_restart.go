@@ -0,0 +1,83 @@
+package gaz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/petabytecl/gaz/worker"
+)
+
+// Restart performs an in-process graceful restart of the application.
+// It stops workers, then stops services in reverse dependency order, then
+// starts services in dependency order and starts workers again.
+//
+// Singleton instances are kept; only lifecycle hooks (OnStop, then OnStart)
+// re-run. This is useful for long-running processes that need to re-apply
+// state, for example after a SIGHUP config reload (see WithReloadHandler).
+//
+// Restart resets the Stop guard, so a subsequent Stop performs a full
+// shutdown again. Restart must be called after Build and is safe to call
+// repeatedly.
+func (a *App) Restart(ctx context.Context) error {
+	a.mu.Lock()
+	built := a.built
+	a.mu.Unlock()
+
+	if !built {
+		return errors.New("gaz: cannot restart before Build()")
+	}
+
+	services, startupOrder, err := a.lifecycleServices()
+	if err != nil {
+		return err
+	}
+
+	log := a.getLogger()
+	log.InfoContext(ctx, "restarting application", "services_count", len(services))
+
+	// Stop workers first (they may depend on services), then services
+	if workerStopErr := a.workerMgr.StopContext(ctx); workerStopErr != nil {
+		return fmt.Errorf("stopping workers: %w", workerStopErr)
+	}
+	if stopErr := a.stopServices(ctx, ComputeShutdownOrder(startupOrder), services); stopErr != nil {
+		return stopErr
+	}
+
+	if _, startErr := a.startServices(ctx, startupOrder, services); startErr != nil {
+		return startErr
+	}
+
+	// Workers outlive the restart call, so detach them from ctx cancellation
+	if workerErr := a.workerMgr.Start(context.WithoutCancel(ctx)); workerErr != nil {
+		return fmt.Errorf("starting workers: %w", workerErr)
+	}
+
+	a.mu.Lock()
+	a.stopOnce = sync.Once{}
+	a.stopErr = nil
+	a.mu.Unlock()
+
+	log.InfoContext(ctx, "application restarted")
+	return nil
+}
+
+// RestartWorker restarts a single worker without restarting the rest of the
+// application. The worker's backoff and circuit breaker state are reset.
+// See worker.Manager.RestartWorker for the errors returned; pass
+// worker.ForceRestart() to restart a worker whose circuit breaker has tripped.
+func (a *App) RestartWorker(ctx context.Context, name string, opts ...worker.RestartOption) error {
+	a.mu.Lock()
+	built := a.built
+	a.mu.Unlock()
+
+	if !built {
+		return errors.New("gaz: cannot restart worker before Build()")
+	}
+
+	if err := a.workerMgr.RestartWorker(ctx, name, opts...); err != nil {
+		return fmt.Errorf("restart worker: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package gaz
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+func (s *AppTestSuite) TestRestart_RerunsLifecycleHooksInOrder() {
+	app := New()
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+
+	err := For[*AppTestServiceA](app.Container()).Named("A").Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{
+				onStart: func() { record("start A") },
+				onStop:  func() { record("stop A") },
+			}, nil
+		})
+	s.Require().NoError(err)
+
+	err = For[*AppTestServiceB](app.Container()).Named("B").Eager().
+		Provider(func(c *Container) (*AppTestServiceB, error) {
+			a, resolveErr := Resolve[*AppTestServiceA](c, Named("A"))
+			if resolveErr != nil {
+				return nil, resolveErr
+			}
+			return &AppTestServiceB{
+				A:       a,
+				onStart: func() { record("start B") },
+				onStop:  func() { record("stop B") },
+			}, nil
+		})
+	s.Require().NoError(err)
+
+	testW := newTestWorker("restart-worker")
+	s.Require().NoError(For[*testWorker](app.Container()).Instance(testW))
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	instanceBefore, err := Resolve[*AppTestServiceA](app.Container(), Named("A"))
+	s.Require().NoError(err)
+
+	// Workers start asynchronously; a worker stopped before its first run is
+	// never started, so wait for it.
+	s.Require().Eventually(func() bool {
+		return testW.getStartCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	events = nil
+	mu.Unlock()
+
+	s.Require().NoError(app.Restart(context.Background()))
+
+	mu.Lock()
+	s.Equal([]string{"stop B", "stop A", "start A", "start B"}, events)
+	mu.Unlock()
+
+	// Singletons keep their instances
+	instanceAfter, err := Resolve[*AppTestServiceA](app.Container(), Named("A"))
+	s.Require().NoError(err)
+	s.Same(instanceBefore, instanceAfter)
+
+	// Workers are restarted too
+	s.Require().Eventually(func() bool {
+		return testW.getStartCount() == 2
+	}, time.Second, 10*time.Millisecond)
+	s.Equal(1, testW.getStopCount())
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
+
+func (s *AppTestSuite) TestRestart_Repeated() {
+	app := New()
+
+	var starts, stops int
+	var mu sync.Mutex
+	err := For[*AppTestServiceA](app.Container()).Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{
+				onStart: func() { mu.Lock(); starts++; mu.Unlock() },
+				onStop:  func() { mu.Lock(); stops++; mu.Unlock() },
+			}, nil
+		})
+	s.Require().NoError(err)
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	for range 3 {
+		s.Require().NoError(app.Restart(context.Background()))
+	}
+
+	mu.Lock()
+	s.Equal(4, starts)
+	s.Equal(3, stops)
+	mu.Unlock()
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+
+	mu.Lock()
+	s.Equal(4, stops)
+	mu.Unlock()
+}
+
+func (s *AppTestSuite) TestRestart_BeforeBuild() {
+	app := New()
+	s.Require().Error(app.Restart(context.Background()))
+}
+
+func (s *AppTestSuite) TestRestartWorker() {
+	app := New()
+
+	target := newTestWorker("target-worker")
+	other := newTestWorker("other-worker")
+	s.Require().NoError(For[*testWorker](app.Container()).Named("target").Instance(target))
+	s.Require().NoError(For[*testWorker](app.Container()).Named("other").Instance(other))
+
+	s.Require().Error(app.RestartWorker(context.Background(), "target-worker"))
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	s.Require().Eventually(func() bool {
+		return target.getStartCount() == 1 && other.getStartCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	s.Require().NoError(app.RestartWorker(context.Background(), "target-worker"))
+	s.Require().Eventually(func() bool {
+		return target.getStartCount() == 2
+	}, time.Second, 10*time.Millisecond)
+	s.Equal(1, target.getStopCount())
+	s.Equal(1, other.getStartCount())
+
+	s.Require().ErrorIs(app.RestartWorker(context.Background(), "missing"), ErrWorkerNotFound)
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
@@ -11,13 +11,44 @@ import (
 	"time"
 
 	"github.com/petabytecl/gaz/di"
+	"github.com/petabytecl/gaz/health"
 	"github.com/petabytecl/gaz/worker"
 )
 
+// startupGatePollInterval is how often WithStartupGate re-runs startup checks.
+const startupGatePollInterval = 50 * time.Millisecond
+
 // Run executes the application lifecycle.
 // It builds the container, starts services in order, and waits for a signal or stop call.
 func (a *App) Run(ctx context.Context) error {
-	if err := a.Build(); err != nil {
+	return a.run(ctx, a.waitForShutdownSignal)
+}
+
+// RunOnce executes a one-shot task with the full application lifecycle.
+// It builds the container, starts services and workers like Run, calls fn,
+// and then shuts down gracefully instead of waiting for a signal. OnStop
+// hooks run whether or not fn fails. The error from fn is returned joined
+// with any shutdown error.
+//
+// Example:
+//
+//	err := app.RunOnce(ctx, func(ctx context.Context) error {
+//	    return migrate(ctx, gaz.MustResolve[*sql.DB](app.Container()))
+//	})
+func (a *App) RunOnce(ctx context.Context, fn func(ctx context.Context) error) error {
+	return a.run(ctx, func(ctx context.Context) error {
+		fnErr := fn(ctx)
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
+		defer cancel()
+		return errors.Join(fnErr, a.Stop(shutdownCtx))
+	})
+}
+
+// run builds and starts the application, then calls body once everything is
+// up. body is responsible for shutting the application down.
+func (a *App) run(ctx context.Context, body func(ctx context.Context) error) error {
+	if err := a.BuildWithContext(ctx); err != nil {
 		return err
 	}
 
@@ -36,7 +67,65 @@ func (a *App) Run(ctx context.Context) error {
 		a.mu.Unlock()
 	}()
 
-	// Compute startup order
+	startedAt := time.Now()
+	services, startupOrder, err := a.lifecycleServices()
+	if err != nil {
+		return err
+	}
+
+	// The EventBus is closed by Stop, after services; reopen it in case a
+	// previous Run was stopped.
+	if busErr := a.eventBus.OnStart(ctx); busErr != nil {
+		return fmt.Errorf("starting eventbus: %w", busErr)
+	}
+
+	a.Logger.InfoContext(ctx, "starting application", "services_count", len(services))
+	publishLifecycle(ctx, a, AppStarting{})
+
+	if started, startupErr := a.startServices(ctx, startupOrder, services); startupErr != nil {
+		// Rollback: stop only the services whose OnStart ran, newest first.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
+		defer cancel()
+		stopErr := a.rollback(shutdownCtx, started)
+		return errors.Join(startupErr, stopErr)
+	}
+
+	// Hold workers until startup checks pass (WithStartupGate)
+	if gateErr := a.waitForStartupGate(ctx); gateErr != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
+		defer cancel()
+		stopErr := a.Stop(shutdownCtx)
+		return errors.Join(gateErr, stopErr)
+	}
+
+	// Start workers after all services started
+	a.Logger.InfoContext(ctx, "starting workers")
+	if workerErr := a.workerMgr.Start(ctx); workerErr != nil {
+		// Rollback
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
+		defer cancel()
+		stopErr := a.Stop(shutdownCtx)
+		return errors.Join(fmt.Errorf("starting workers: %w", workerErr), stopErr)
+	}
+
+	// Notify OnStarted callbacks now that everything is up
+	publishLifecycle(ctx, a, AppStarted{})
+	a.mu.Lock()
+	a.startupDuration = time.Since(startedAt)
+	onStarted := a.onStarted
+	a.mu.Unlock()
+	for _, fn := range onStarted {
+		fn(ctx)
+	}
+	a.readyOnce.Do(func() { close(a.ready) })
+
+	return body(ctx)
+}
+
+// lifecycleServices returns the services whose lifecycle hooks are managed by
+// the App, along with their startup order. Workers are excluded because they
+// are started and stopped by the WorkerManager.
+func (a *App) lifecycleServices() (map[string]di.ServiceWrapper, [][]string, error) {
 	graph := a.container.GetGraph()
 	services := make(map[string]di.ServiceWrapper)
 	a.container.ForEachService(func(name string, svc di.ServiceWrapper) {
@@ -55,13 +144,25 @@ func (a *App) Run(ctx context.Context) error {
 
 	startupOrder, err := ComputeStartupOrder(graph, services)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
+	return services, startupOrder, nil
+}
 
-	a.Logger.InfoContext(ctx, "starting application", "services_count", len(services))
+// startServices starts services layer by layer. Services within a layer start
+// in parallel. It returns the names of the services that started successfully,
+// in the order their OnStart hooks completed, and the joined errors of the
+// first layer that fails; the caller is responsible for rolling back the
+// started services.
+func (a *App) startServices(
+	ctx context.Context,
+	order [][]string,
+	services map[string]di.ServiceWrapper,
+) ([]string, error) {
+	started := make([]string, 0, len(services))
+	var startedMu sync.Mutex
 
-	// Start services layer by layer
-	for _, layer := range startupOrder {
+	for _, layer := range order {
 		var wg sync.WaitGroup
 		errCh := make(chan error, len(layer))
 
@@ -71,7 +172,7 @@ func (a *App) Run(ctx context.Context) error {
 			go func() {
 				defer wg.Done()
 				start := time.Now()
-				if startErr := svc.Start(ctx); startErr != nil {
+				if startErr := a.startService(ctx, name, svc); startErr != nil {
 					a.Logger.ErrorContext(
 						ctx,
 						"failed to start service",
@@ -80,6 +181,10 @@ func (a *App) Run(ctx context.Context) error {
 					)
 					errCh <- fmt.Errorf("starting service %s: %w", name, startErr)
 				} else {
+					startedMu.Lock()
+					started = append(started, name)
+					startedMu.Unlock()
+					publishLifecycle(ctx, a, ServiceStarted{Name: name})
 					a.Logger.InfoContext(
 						ctx,
 						"service started",
@@ -98,54 +203,127 @@ func (a *App) Run(ctx context.Context) error {
 			startupErrors = append(startupErrors, e)
 		}
 		if len(startupErrors) > 0 {
-			startupErr := errors.Join(startupErrors...)
-			// Rollback: stop everything we started.
-			shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
-			defer cancel()
-			stopErr := a.Stop(shutdownCtx)
-			return errors.Join(startupErr, stopErr)
+			return started, errors.Join(startupErrors...)
 		}
 	}
+	return started, nil
+}
 
-	// Start workers after all services started
-	a.Logger.InfoContext(ctx, "starting workers")
-	if workerErr := a.workerMgr.Start(ctx); workerErr != nil {
-		// Rollback
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
-		defer cancel()
-		stopErr := a.Stop(shutdownCtx)
-		return errors.Join(fmt.Errorf("starting workers: %w", workerErr), stopErr)
+// startService runs a service's OnStart hook, bounded by PerStartTimeout when
+// one is set. On timeout the hook's context is cancelled and the blame is
+// logged; the hook is not waited for.
+func (a *App) startService(ctx context.Context, name string, svc di.ServiceWrapper) error {
+	timeout := a.opts.PerStartTimeout
+	if timeout <= 0 {
+		return svc.Start(ctx)
 	}
 
-	return a.waitForShutdownSignal(ctx)
+	hookCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- svc.Start(hookCtx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-hookCtx.Done():
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		a.logBlame("startup", name, timeout, time.Since(start))
+		return fmt.Errorf("%w after %s", ErrStartTimeout, timeout)
+	}
+}
+
+// waitForStartupGate blocks until all health startup checks pass, polling
+// every startupGatePollInterval. Returns ErrStartupGateFailed if the checks do
+// not pass within the gate timeout. No-op unless WithStartupGate is set
+// and the health module is registered.
+func (a *App) waitForStartupGate(ctx context.Context) error {
+	if !a.startupGate {
+		return nil
+	}
+	if a.healthMgr == nil {
+		a.Logger.WarnContext(ctx, "startup gate enabled without health module, starting workers immediately")
+		return nil
+	}
+
+	gateCtx, cancel := context.WithTimeout(ctx, a.startupGateTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(startupGatePollInterval)
+	defer ticker.Stop()
+
+	a.Logger.InfoContext(ctx, "waiting for startup checks before starting workers")
+	checker := a.healthMgr.StartupChecker()
+	for {
+		result := checker.Check(gateCtx)
+		// Degraded passes, as it does for the startup probe. No startup
+		// checks registered means there is nothing to wait for
+		if result.Status == health.StatusUp || result.Status == health.StatusDegraded ||
+			len(result.Details) == 0 {
+			return nil
+		}
+
+		select {
+		case <-gateCtx.Done():
+			return fmt.Errorf("%w: %w", ErrStartupGateFailed, gateCtx.Err())
+		case <-ticker.C:
+		}
+	}
 }
 
 // waitForShutdownSignal blocks until a shutdown trigger (signal, context cancel, or Stop call).
 // Returns the result of graceful shutdown.
 func (a *App) waitForShutdownSignal(ctx context.Context) error {
 	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(sigCh, a.notifySignals()...)
 	defer signal.Stop(sigCh)
 
-	select {
-	case <-ctx.Done():
-		// Context cancelled, treat like SIGTERM (graceful, no double-signal)
-		a.Logger.InfoContext(ctx, "Shutting down gracefully...", "reason", "context cancelled")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
-		defer cancel()
-		return a.Stop(shutdownCtx)
+	for {
+		select {
+		case <-ctx.Done():
+			// Context cancelled, treat like SIGTERM (graceful, no double-signal)
+			a.Logger.InfoContext(ctx, "Shutting down gracefully...", "reason", "context cancelled")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.ShutdownTimeout)
+			defer cancel()
+			return a.Stop(shutdownCtx)
 
-	case sig := <-sigCh:
-		return a.handleSignalShutdown(ctx, sig, sigCh)
+		case sig := <-sigCh:
+			if sig == syscall.SIGHUP && a.reloadFn != nil {
+				a.Logger.InfoContext(ctx, "Reloading...", "signal", sig.String())
+				a.reloadFn(ctx)
+				continue
+			}
+			return a.handleSignalShutdown(ctx, sig, sigCh)
 
-	case <-a.stopCh:
-		// Stopped externally (Stop() called)
-		return nil
+		case <-a.stopCh:
+			// Stopped externally (Stop() called)
+			return nil
+		}
 	}
 }
 
+// notifySignals returns the signals to subscribe to: the shutdown signals
+// (WithSignals, default SIGINT and SIGTERM) plus SIGHUP if a reload handler is set.
+func (a *App) notifySignals() []os.Signal {
+	sigs := []os.Signal{os.Interrupt, syscall.SIGTERM}
+	if len(a.signals) > 0 {
+		sigs = append([]os.Signal(nil), a.signals...)
+	}
+	if a.reloadFn != nil {
+		sigs = append(sigs, syscall.SIGHUP)
+	}
+	return sigs
+}
+
 // handleSignalShutdown handles graceful shutdown triggered by a signal.
-// For SIGINT, it spawns a force-exit watcher that exits immediately on second SIGINT.
+// For SIGINT, it spawns a force-exit watcher that exits immediately on a second
+// SIGINT; other signals received meanwhile (SIGTERM, SIGHUP) are ignored.
 // For SIGTERM, it performs graceful shutdown without double-signal behavior.
 func (a *App) handleSignalShutdown(
 	ctx context.Context,
@@ -161,6 +339,8 @@ func (a *App) handleSignalShutdown(
 
 	// Channel to receive shutdown result
 	shutdownDone := make(chan error, 1)
+	stopped := make(chan struct{})
+	defer close(stopped)
 
 	// Start graceful shutdown in goroutine so we can continue listening for signals
 	go func() {
@@ -170,13 +350,20 @@ func (a *App) handleSignalShutdown(
 	// If SIGINT, spawn force-exit watcher goroutine
 	if sig == os.Interrupt {
 		go func() {
-			select {
-			case <-sigCh:
-				// Second SIGINT received - force exit immediately
-				a.Logger.ErrorContext(ctx, "Received second interrupt, forcing exit")
-				callExitFunc(1)
-			case <-shutdownDone:
-				// Normal completion, watcher exits
+			for {
+				select {
+				case next := <-sigCh:
+					if next != os.Interrupt {
+						continue
+					}
+					// Second SIGINT received - force exit immediately
+					a.Logger.ErrorContext(ctx, "Received second interrupt, forcing exit")
+					callExitFunc(1)
+					return
+				case <-stopped:
+					// Normal completion, watcher exits
+					return
+				}
 			}
 		}()
 	}
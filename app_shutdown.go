@@ -5,10 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/petabytecl/gaz/di"
-	"github.com/petabytecl/gaz/worker"
 )
 
 // Stop initiates graceful shutdown of the application.
@@ -17,16 +17,33 @@ import (
 // Stop is idempotent - calling it multiple times returns the same result.
 func (a *App) Stop(ctx context.Context) error {
 	a.stopOnce.Do(func() {
-		a.stopErr = a.doStop(ctx)
+		a.stopErr = a.doStop(ctx, nil)
+	})
+	return a.stopErr
+}
+
+// rollback shuts the application down after a failed startup. It behaves
+// like Stop, but only runs OnStop for the services in started, in reverse
+// start order, so services whose OnStart never ran are not stopped.
+func (a *App) rollback(ctx context.Context, started []string) error {
+	order := make([][]string, 0, len(started))
+	for _, name := range slices.Backward(started) {
+		order = append(order, []string{name})
+	}
+	a.stopOnce.Do(func() {
+		a.stopErr = a.doStop(ctx, order)
 	})
 	return a.stopErr
 }
 
 // doStop performs the actual shutdown. Called only once via stopOnce.
-func (a *App) doStop(ctx context.Context) error {
+// Services are stopped in shutdownOrder, or in reverse dependency order
+// when shutdownOrder is nil.
+func (a *App) doStop(ctx context.Context, shutdownOrder [][]string) error {
 	a.mu.Lock()
 	wasRunning := a.running
 	wasBuilt := a.built
+	onStopping := a.onStopping
 	a.mu.Unlock()
 
 	// If app was never built, there's nothing to stop
@@ -34,6 +51,12 @@ func (a *App) doStop(ctx context.Context) error {
 		return nil
 	}
 
+	// Notify OnStopping callbacks before anything is torn down
+	publishLifecycle(ctx, a, AppStopping{})
+	for _, fn := range onStopping {
+		fn(ctx)
+	}
+
 	// Cancel the cron scheduler context
 	if a.cronCancel != nil {
 		a.cronCancel()
@@ -60,29 +83,15 @@ func (a *App) doStop(ctx context.Context) error {
 
 	// Compute shutdown order (reverse of startup)
 	// We need to re-compute because we don't store it.
-	graph := a.container.GetGraph()
-	services := make(map[string]di.ServiceWrapper)
-	a.container.ForEachService(func(name string, svc di.ServiceWrapper) {
-		// Skip workers - they have their own lifecycle via WorkerManager
-		// Workers implement OnStart/OnStop which looks like di.Starter/di.Stopper,
-		// but they should only be started/stopped by WorkerManager, not the DI layer.
-		if !svc.IsTransient() {
-			if instance, err := a.container.ResolveByName(name, nil); err == nil {
-				if _, isWorker := instance.(worker.Worker); isWorker {
-					return
-				}
-			}
-		}
-		services[name] = svc
-	})
-
-	startupOrder, err := ComputeStartupOrder(graph, services)
+	services, startupOrder, err := a.lifecycleServices()
 	if err != nil {
 		close(done)
 		// Should not happen if Build passed, unless graph changed (impossible after Build)
 		return err
 	}
-	shutdownOrder := ComputeShutdownOrder(startupOrder)
+	if shutdownOrder == nil {
+		shutdownOrder = ComputeShutdownOrder(startupOrder)
+	}
 
 	var errs []error
 
@@ -92,7 +101,7 @@ func (a *App) doStop(ctx context.Context) error {
 	// Stop workers first (they may depend on services)
 	log.InfoContext(ctx, "stopping workers")
 	if a.workerMgr != nil {
-		if workerStopErr := a.workerMgr.Stop(); workerStopErr != nil {
+		if workerStopErr := a.workerMgr.StopContext(ctx); workerStopErr != nil {
 			errs = append(errs, fmt.Errorf("stopping workers: %w", workerStopErr))
 		}
 	}
@@ -101,6 +110,16 @@ func (a *App) doStop(ctx context.Context) error {
 		errs = append(errs, serviceStopErr)
 	}
 
+	// Close the EventBus last so lifecycle events published while stopping
+	// services are still delivered. Draining in-flight handlers is bounded
+	// like any other shutdown hook.
+	publishLifecycle(ctx, a, AppStopped{})
+	drainCtx, cancelDrain := context.WithTimeout(ctx, a.opts.PerHookTimeout)
+	if drainErr := a.eventBus.CloseContext(drainCtx); drainErr != nil {
+		errs = append(errs, fmt.Errorf("closing eventbus: %w", drainErr))
+	}
+	cancelDrain()
+
 	// Close logger file handle (if any) — after all services stopped, before exit
 	if a.logCloser != nil {
 		if closeErr := a.logCloser.Close(); closeErr != nil {
@@ -158,6 +177,7 @@ func (a *App) stopServices(
 			case stopErr := <-errCh:
 				cancel()
 				elapsed := time.Since(start)
+				publishLifecycle(ctx, a, ServiceStopped{Name: name})
 				if stopErr != nil {
 					a.Logger.ErrorContext(
 						ctx,
@@ -179,7 +199,7 @@ func (a *App) stopServices(
 				cancel()
 				elapsed := time.Since(start)
 				// Blame logging: hook exceeded timeout
-				a.logBlame(name, timeout, elapsed)
+				a.logBlame("shutdown", name, timeout, elapsed)
 				errs = append(
 					errs,
 					fmt.Errorf("stopping service %s: %w", name, context.DeadlineExceeded),
@@ -196,9 +216,10 @@ func (a *App) stopServices(
 }
 
 // logBlame logs blame information when a hook exceeds its timeout.
+// phase ("startup" or "shutdown") prefixes the message.
 // Uses Logger first, falls back to stderr if Logger fails.
-func (a *App) logBlame(hookName string, timeout, elapsed time.Duration) {
-	msg := fmt.Sprintf("shutdown: %s exceeded %s timeout (elapsed: %s)", hookName, timeout, elapsed)
+func (a *App) logBlame(phase, hookName string, timeout, elapsed time.Duration) {
+	msg := fmt.Sprintf("%s: %s exceeded %s timeout (elapsed: %s)", phase, hookName, timeout, elapsed)
 
 	// Try structured logger first
 	if a.Logger != nil {
@@ -3,9 +3,12 @@ package gaz
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"testing"
@@ -14,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/petabytecl/gaz/config"
 	"github.com/petabytecl/gaz/cron"
 	"github.com/petabytecl/gaz/di"
 	"github.com/petabytecl/gaz/eventbus"
@@ -126,15 +130,14 @@ func (s *AppTestSuite) TestRunAndStop() {
 		runErr <- app.Run(context.Background())
 	}()
 
-	// Wait a bit for startup
-	// Ideally we need a way to know it started.
-	// We can check startOrder length?
-	// Poll for len(startOrder) == 2
-	s.Eventually(func() bool {
-		mu.Lock()
-		defer mu.Unlock()
-		return len(startOrder) == 2
-	}, 1*time.Second, 10*time.Millisecond)
+	select {
+	case <-app.WaitReady():
+	case <-time.After(time.Second):
+		s.Fail("app did not become ready")
+	}
+	mu.Lock()
+	s.Len(startOrder, 2)
+	mu.Unlock()
 
 	// Stop the app
 	err = app.Stop(context.Background())
@@ -179,6 +182,132 @@ func (s *AppTestSuite) TestSignalHandling() {
 	}
 }
 
+func (s *AppTestSuite) TestWithSignalsTriggersShutdown() {
+	// Keep SIGUSR1 from terminating the test process if it arrives
+	// before the app subscribes.
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGUSR1)
+	defer signal.Stop(guard)
+
+	app := New(WithSignals(syscall.SIGUSR1))
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	var result error
+	s.Require().Eventually(func() bool {
+		s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+		select {
+		case result = <-runErr:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 20*time.Millisecond)
+	s.Require().NoError(result)
+}
+
+func (s *AppTestSuite) TestWithSignalsIgnoresOtherSignals() {
+	// SIGUSR2 is not in the app's signal set; the guard keeps it from
+	// terminating the test process.
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGUSR2)
+	defer signal.Stop(guard)
+
+	app := New(WithSignals(syscall.SIGUSR1))
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGUSR2))
+	select {
+	case <-guard:
+	case <-time.After(time.Second):
+		s.Fail("SIGUSR2 was not delivered")
+	}
+
+	s.Never(func() bool {
+		return len(runErr) > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
+
+func (s *AppTestSuite) TestWithReloadHandlerDoesNotShutdown() {
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGHUP)
+	defer signal.Stop(guard)
+
+	reloaded := make(chan struct{}, 1)
+	app := New(WithReloadHandler(func(_ context.Context) {
+		select {
+		case reloaded <- struct{}{}:
+		default:
+		}
+	}))
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	s.Require().Eventually(func() bool {
+		s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+		select {
+		case <-reloaded:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 20*time.Millisecond)
+	s.Empty(runErr, "app should keep running after reload")
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
+
 func (s *AppTestSuite) TestWithShutdownTimeout() {
 	timeout := 5 * time.Second
 	app := New(WithShutdownTimeout(timeout))
@@ -329,6 +458,31 @@ func (s *AppTestSuite) TestNewWithShutdownTimeout() {
 	s.Equal(timeout, app.opts.ShutdownTimeout, "shutdown timeout should be set")
 }
 
+type replayTestEvent struct{ N int }
+
+func (e replayTestEvent) EventName() string { return "replayTestEvent" }
+
+func (s *AppTestSuite) TestWithEventBusReplay() {
+	app := New(WithEventBusReplay(10))
+	s.Require().NoError(app.Build())
+
+	bus := app.EventBus()
+	eventbus.Publish(context.Background(), bus, replayTestEvent{N: 1}, "")
+
+	received := make(chan replayTestEvent, 1)
+	eventbus.Subscribe(bus, func(_ context.Context, e replayTestEvent) {
+		received <- e
+	})
+
+	select {
+	case e := <-received:
+		s.Equal(1, e.N)
+	case <-time.After(time.Second):
+		s.Fail("event published before subscribe was not replayed")
+	}
+	bus.Close()
+}
+
 type FluentTestDB struct{ connected bool }
 
 type FluentTestCache struct{ db *FluentTestDB }
@@ -452,6 +606,85 @@ func (s *AppTestSuite) TestBuildIsIdempotent() {
 	s.Require().NoError(app.Build()) // And third
 }
 
+func (s *AppTestSuite) TestBuildWithContextAbortsSlowEagerProvider() {
+	app := New()
+	release := make(chan struct{})
+	defer close(release)
+	err := For[*FluentTestDB](app.Container()).Eager().Provider(func(_ *Container) (*FluentTestDB, error) {
+		<-release
+		return &FluentTestDB{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = app.BuildWithContext(ctx)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Less(time.Since(start), 2*time.Second, "Build should not wait for the provider")
+}
+
+func (s *AppTestSuite) TestBuildWithContextAbortReleasesApp() {
+	app := New()
+	release := make(chan struct{})
+	defer close(release)
+	err := For[*FluentTestDB](app.Container()).Eager().Provider(func(_ *Container) (*FluentTestDB, error) {
+		<-release
+		return &FluentTestDB{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	s.Require().ErrorIs(app.BuildWithContext(ctx), context.DeadlineExceeded)
+
+	// The abandoned provider is still running; nothing may wait for it.
+	returned := make(chan error, 2)
+	go func() { returned <- app.Build() }()
+	go func() { returned <- app.Stop(context.Background()) }()
+	for range 2 {
+		select {
+		case err := <-returned:
+			if err != nil {
+				s.ErrorIs(err, context.DeadlineExceeded)
+			}
+		case <-time.After(2 * time.Second):
+			s.FailNow("Build or Stop blocked on the abandoned build")
+		}
+	}
+}
+
+func (s *AppTestSuite) TestBuildWithContextPanicKeepsStack() {
+	app := New()
+	app.Use(NewModule("base").Build())
+	app.Use(NewModule("exploding").DependsOn("base").Provide(func(_ *Container) error {
+		panic("module exploded")
+	}).Build())
+
+	defer func() {
+		pe, ok := recover().(*di.PanicError)
+		s.Require().True(ok, "panic should be re-raised as *di.PanicError")
+		s.Equal("module exploded", pe.Value)
+		s.Contains(string(pe.Stack), "app_test.go", "stack should point at the panicking code")
+	}()
+	_ = app.BuildWithContext(context.Background())
+}
+
+func (s *AppTestSuite) TestRunAbortsBuildWhenContextCancelled() {
+	app := New()
+	err := For[*FluentTestDB](app.Container()).Eager().Provider(func(_ *Container) (*FluentTestDB, error) {
+		s.Fail("provider must not run with a cancelled context")
+		return &FluentTestDB{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Require().ErrorIs(app.Run(ctx), context.Canceled)
+}
+
 func (s *AppTestSuite) TestContainerAccessor() {
 	app := New()
 	container := app.Container()
@@ -497,6 +730,14 @@ func (s *AppTestSuite) TestEventBus() {
 	s.Same(eventBusAfter, resolvedEventBus, "Resolved EventBus should be the same as accessor")
 }
 
+func (s *AppTestSuite) TestEventBus_NotDiscoveredAsWorker() {
+	app := New()
+	s.Require().NoError(app.Build())
+
+	// The App starts and closes the EventBus itself
+	s.Zero(app.workerMgr.Stats().Workers)
+}
+
 func (s *AppTestSuite) TestWithLoggerConfig() {
 	// Test with custom logger config
 	customConfig := &logger.Config{
@@ -713,6 +954,64 @@ func (s *AppTestSuite) TestDiscoverCronJobs_NonTransient() {
 	s.Require().NoError(app.Build())
 }
 
+// denyLocker is a cron.Locker that never grants the lock.
+type denyLocker struct{}
+
+func (denyLocker) Acquire(context.Context, string) (func(), bool, error) {
+	return nil, false, nil
+}
+
+func (s *AppTestSuite) TestDiscoverCronJobs_UsesRegisteredLocker() {
+	app := New()
+
+	s.Require().NoError(For[cron.Locker](app.Container()).Instance(cron.Locker(denyLocker{})))
+	err := For[cron.CronJob](app.Container()).Named("locked-job").Transient().
+		Provider(func(_ *Container) (cron.CronJob, error) {
+			return &TestCronJob{name: "locked-job", schedule: "@hourly"}, nil
+		})
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	err = app.Scheduler().TriggerNow(context.Background(), "locked-job")
+	s.Require().ErrorIs(err, ErrCronJobLocked)
+}
+
+// jitteredCronJob is a TestCronJob with its own jitter bound.
+type jitteredCronJob struct {
+	TestCronJob
+	jitter time.Duration
+}
+
+func (j *jitteredCronJob) Jitter() time.Duration { return j.jitter }
+
+func (s *AppTestSuite) TestDiscoverCronJobs_Jitter() {
+	app := New(WithCronJitter(time.Minute))
+
+	err := For[cron.CronJob](app.Container()).Named("default-jitter").Transient().
+		Provider(func(_ *Container) (cron.CronJob, error) {
+			return &TestCronJob{name: "default-jitter", schedule: "@hourly"}, nil
+		})
+	s.Require().NoError(err)
+	err = For[cron.CronJob](app.Container()).Named("own-jitter").Transient().
+		Provider(func(_ *Container) (cron.CronJob, error) {
+			return &jitteredCronJob{
+				TestCronJob: TestCronJob{name: "own-jitter", schedule: "@hourly"},
+				jitter:      5 * time.Second,
+			}, nil
+		})
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	jitters := make(map[string]time.Duration)
+	for _, job := range app.Scheduler().Jobs() {
+		jitters[job.Name()] = job.Jitter()
+	}
+	s.Equal(map[string]time.Duration{
+		"default-jitter": time.Minute,
+		"own-jitter":     5 * time.Second,
+	}, jitters)
+}
+
 // =============================================================================
 // Tests for WithStrictConfig
 // =============================================================================
@@ -1063,6 +1362,38 @@ func (s *AppTestSuite) TestGetArgs_WithCommandArgs() {
 	s.Equal([]string{"arg1", "arg2"}, args)
 }
 
+func (s *AppTestSuite) TestConfigManagerReflectsLoadedValues() {
+	dir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, "config.yaml"),
+		[]byte("server:\n  port: 8080\n"), 0o600))
+
+	app := New().WithConfig(nil, config.WithSearchPaths(dir))
+	s.Require().NoError(app.Build())
+
+	mgr := app.ConfigManager()
+	s.Require().NotNil(mgr)
+	s.Equal(8080, mgr.Backend().GetInt("server.port"))
+	s.Contains(mgr.AllSettings(), "server")
+}
+
+func (s *AppTestSuite) TestConfigManagerChangesVisibleToProviderValues() {
+	app := New()
+	s.Require().NoError(app.MergeConfigMap(map[string]any{"db": map[string]any{"host": "a"}}))
+	s.Require().NoError(app.Build())
+
+	pv, err := Resolve[*ProviderValues](app.Container())
+	s.Require().NoError(err)
+	s.Equal("a", pv.GetString("db.host"))
+
+	app.ConfigManager().Backend().Set("db.host", "b")
+	s.Equal("b", pv.GetString("db.host"))
+}
+
+func (s *AppTestSuite) TestConfigManagerNilApp() {
+	var app *App
+	s.Nil(app.ConfigManager())
+}
+
 // Tests for MergeConfigMap error cases
 
 func (s *AppTestSuite) TestMergeConfigMap_NoConfigManager() {
@@ -1160,6 +1491,117 @@ func (s *AppTestSuite) TestShutdownErrorJoinsStartupAndStopErrors() {
 	s.Contains(err.Error(), "starting service", "should contain startup error")
 }
 
+// rollbackTestService records its lifecycle hooks and optionally fails OnStart.
+type rollbackTestService struct {
+	name      string
+	failStart bool
+	record    func(event string)
+}
+
+func (r *rollbackTestService) OnStart(_ context.Context) error {
+	if r.failStart {
+		return fmt.Errorf("%s start failed", r.name)
+	}
+	r.record("start " + r.name)
+	return nil
+}
+
+func (r *rollbackTestService) OnStop(_ context.Context) error {
+	r.record("stop " + r.name)
+	return nil
+}
+
+// TestStartupFailureStopsOnlyStartedServices verifies that when a layer fails
+// to start, rollback runs OnStop only for services whose OnStart succeeded,
+// in reverse start order.
+func (s *AppTestSuite) TestStartupFailureStopsOnlyStartedServices() {
+	app := New()
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+
+	register := func(name string, failStart bool, deps ...string) {
+		err := For[*rollbackTestService](app.Container()).Named(name).Eager().
+			Provider(func(c *Container) (*rollbackTestService, error) {
+				for _, dep := range deps {
+					if _, err := Resolve[*rollbackTestService](c, Named(dep)); err != nil {
+						return nil, err
+					}
+				}
+				return &rollbackTestService{name: name, failStart: failStart, record: record}, nil
+			})
+		s.Require().NoError(err)
+	}
+
+	// Layer 1: base. Layer 2: sibling and failing. Layer 3: late.
+	register("base", false)
+	register("sibling", false, "base")
+	register("failing", true, "base")
+	register("late", false, "sibling")
+
+	err := app.Run(context.Background())
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failing start failed")
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"start base", "start sibling", "stop sibling", "stop base"}, events)
+}
+
+// slowStartService blocks in OnStart for delay or until its context ends.
+type slowStartService struct {
+	delay time.Duration
+}
+
+func (r *slowStartService) OnStart(ctx context.Context) error {
+	select {
+	case <-time.After(r.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TestPerStartTimeoutFailsSlowStart verifies that an OnStart hook exceeding
+// WithPerStartTimeout fails startup with an error naming the service.
+func (s *AppTestSuite) TestPerStartTimeoutFailsSlowStart() {
+	app := New(WithPerStartTimeout(50 * time.Millisecond))
+
+	err := For[*slowStartService](app.Container()).Named("slow").Eager().
+		ProviderFunc(func(_ *Container) *slowStartService {
+			return &slowStartService{delay: 5 * time.Second}
+		})
+	s.Require().NoError(err)
+
+	start := time.Now()
+	err = app.Run(context.Background())
+	s.Require().ErrorIs(err, ErrStartTimeout)
+	s.Contains(err.Error(), "starting service slow")
+	s.Less(time.Since(start), 2*time.Second)
+}
+
+// TestPerStartTimeoutAllowsFastStart verifies that starts within the timeout
+// are unaffected.
+func (s *AppTestSuite) TestPerStartTimeoutAllowsFastStart() {
+	app := New(WithPerStartTimeout(time.Second))
+
+	err := For[*slowStartService](app.Container()).Named("fast").Eager().
+		ProviderFunc(func(_ *Container) *slowStartService {
+			return &slowStartService{delay: time.Millisecond}
+		})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	app.OnStarted(func(_ context.Context) { cancel() })
+
+	s.Require().NoError(app.Run(ctx))
+}
+
 // TestTimerLeakFixInShutdown verifies that doStop uses time.NewTimer
 // instead of time.After, which would leak timers.
 // This is a code-level verification - the actual behavior is tested by
@@ -1189,3 +1631,314 @@ func (s *AppTestSuite) TestTimerLeakFixInShutdown() {
 		s.Fail("Run did not return after Stop")
 	}
 }
+
+func (s *AppTestSuite) TestOnStartedFiresOnceAfterStartup() {
+	app := New()
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+
+	err := For[*AppTestServiceA](app.Container()).Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{onStart: func() { record("service started") }}, nil
+		})
+	s.Require().NoError(err)
+
+	app.OnStarted(func(_ context.Context) { record("first callback") }).
+		OnStarted(func(_ context.Context) { record("second callback") })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	s.Require().Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"service started", "first callback", "second callback"}, events)
+}
+
+func (s *AppTestSuite) TestOnStoppingFiresBeforeServiceStops() {
+	app := New()
+
+	var events []string
+	var mu sync.Mutex
+	record := func(event string) {
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}
+
+	err := For[*AppTestServiceA](app.Container()).Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{onStop: func() { record("service stopped") }}, nil
+		})
+	s.Require().NoError(err)
+
+	started := make(chan struct{})
+	app.OnStarted(func(_ context.Context) { close(started) })
+	app.OnStopping(func(_ context.Context) { record("stopping") })
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		s.Fail("app did not start")
+	}
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"stopping", "service stopped"}, events)
+}
+
+func (s *AppTestSuite) TestLifecycleCallbacksSkippedOnBuildFailure() {
+	app := New()
+
+	err := For[*AppTestServiceA](app.Container()).Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return nil, errors.New("provider failed")
+		})
+	s.Require().NoError(err)
+
+	var startedCalls, stoppingCalls int
+	app.OnStarted(func(_ context.Context) { startedCalls++ })
+	app.OnStopping(func(_ context.Context) { stoppingCalls++ })
+
+	s.Require().Error(app.Run(context.Background()))
+	s.Require().NoError(app.Stop(context.Background()))
+
+	s.Zero(startedCalls)
+	s.Zero(stoppingCalls)
+}
+
+func (s *AppTestSuite) TestWaitReadyClosesAfterStartup() {
+	app := New()
+
+	var events []string
+	var mu sync.Mutex
+	s.Require().NoError(registerRecordingService(app, &events, &mu))
+	app.OnStarted(func(_ context.Context) {
+		mu.Lock()
+		events = append(events, "started callback")
+		mu.Unlock()
+	})
+
+	select {
+	case <-app.WaitReady():
+		s.Fail("WaitReady closed before Run")
+	default:
+	}
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	select {
+	case <-app.WaitReady():
+	case <-time.After(time.Second):
+		s.Fail("app did not become ready")
+	}
+	mu.Lock()
+	s.Equal([]string{"start", "started callback"}, events)
+	mu.Unlock()
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case err := <-runErr:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
+
+func (s *AppTestSuite) TestWaitReadyStaysOpenOnFailure() {
+	s.Run("build failure", func() {
+		app := New()
+		err := For[*AppTestServiceA](app.Container()).Eager().
+			Provider(func(_ *Container) (*AppTestServiceA, error) {
+				return nil, errors.New("provider failed")
+			})
+		s.Require().NoError(err)
+
+		s.Require().Error(app.Run(context.Background()))
+		select {
+		case <-app.WaitReady():
+			s.Fail("WaitReady closed after Build failed")
+		default:
+		}
+	})
+
+	s.Run("start failure", func() {
+		app := New()
+		err := For[*FailingStartService](app.Container()).Eager().
+			ProviderFunc(func(_ *Container) *FailingStartService {
+				return &FailingStartService{}
+			})
+		s.Require().NoError(err)
+
+		s.Require().Error(app.Run(context.Background()))
+		select {
+		case <-app.WaitReady():
+			s.Fail("WaitReady closed after startup failed")
+		default:
+		}
+	})
+}
+
+// registerRecordingService registers an eager AppTestServiceA that appends
+// "start" and "stop" to events.
+func registerRecordingService(app *App, events *[]string, mu *sync.Mutex) error {
+	return For[*AppTestServiceA](app.Container()).Eager().
+		Provider(func(_ *Container) (*AppTestServiceA, error) {
+			return &AppTestServiceA{
+				onStart: func() { mu.Lock(); *events = append(*events, "start"); mu.Unlock() },
+				onStop:  func() { mu.Lock(); *events = append(*events, "stop"); mu.Unlock() },
+			}, nil
+		})
+}
+
+func (s *AppTestSuite) TestRunOnceLifecycleOrder() {
+	app := New()
+	var events []string
+	var mu sync.Mutex
+	s.Require().NoError(registerRecordingService(app, &events, &mu))
+
+	err := app.RunOnce(context.Background(), func(_ context.Context) error {
+		mu.Lock()
+		events = append(events, "task")
+		mu.Unlock()
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{"start", "task", "stop"}, events)
+}
+
+func (s *AppTestSuite) TestRunOnceReturnsTaskErrorAndStillStops() {
+	app := New()
+	var events []string
+	var mu sync.Mutex
+	s.Require().NoError(registerRecordingService(app, &events, &mu))
+
+	taskErr := errors.New("task failed")
+	err := app.RunOnce(context.Background(), func(_ context.Context) error {
+		return taskErr
+	})
+	s.Require().ErrorIs(err, taskErr)
+	s.Equal([]string{"start", "stop"}, events)
+}
+
+func (s *AppTestSuite) TestRunOnceJoinsStopError() {
+	app := New()
+	s.Require().NoError(For[*FailingStopService](app.Container()).Eager().
+		Provider(func(_ *Container) (*FailingStopService, error) {
+			return &FailingStopService{}, nil
+		}))
+
+	taskErr := errors.New("task failed")
+	err := app.RunOnce(context.Background(), func(_ context.Context) error {
+		return taskErr
+	})
+	s.Require().ErrorIs(err, taskErr)
+	s.Contains(err.Error(), "stop failed")
+}
+
+func (s *AppTestSuite) TestRunOnceSkipsTaskOnStartupFailure() {
+	app := New()
+	s.Require().NoError(For[*FailingStartService](app.Container()).Eager().
+		Provider(func(_ *Container) (*FailingStartService, error) {
+			return &FailingStartService{}, nil
+		}))
+
+	called := false
+	err := app.RunOnce(context.Background(), func(_ context.Context) error {
+		called = true
+		return nil
+	})
+	s.Require().Error(err)
+	s.False(called, "task must not run when startup fails")
+}
+
+type panickingEagerService struct{}
+
+func (s *AppTestSuite) TestBuildReturnsEagerProviderPanic() {
+	app := New()
+	s.Require().NoError(For[*panickingEagerService](app.Container()).Eager().
+		Provider(func(_ *Container) (*panickingEagerService, error) {
+			panic("boom")
+		}))
+
+	var err error
+	s.Require().NotPanics(func() { err = app.Build() })
+	s.Require().ErrorIs(err, ErrDIProviderPanic)
+	s.Contains(err.Error(), "boom")
+}
+
+type panickingLazyService struct{}
+
+func (s *AppTestSuite) TestBuildReturnsDiscoveryPanic() {
+	app := New()
+	s.Require().NoError(For[*panickingLazyService](app.Container()).
+		Provider(func(_ *Container) (*panickingLazyService, error) {
+			panic("lazy boom")
+		}))
+
+	var err error
+	s.Require().NotPanics(func() { err = app.Build() })
+	s.Require().ErrorIs(err, ErrDIProviderPanic)
+	s.Contains(err.Error(), "lazy boom")
+}
+
+func (s *AppTestSuite) TestBuildReturnsCronJobDiscoveryPanic() {
+	app := New()
+	s.Require().NoError(For[cron.CronJob](app.Container()).Named("exploding-job").Transient().
+		Provider(func(_ *Container) (cron.CronJob, error) {
+			panic("job boom")
+		}))
+
+	err := app.Build()
+	s.Require().ErrorIs(err, ErrDIProviderPanic)
+	s.Contains(err.Error(), "job boom")
+}
+
+func (s *AppTestSuite) TestBuildReportsEagerPanicOnce() {
+	app := New()
+	s.Require().NoError(For[*panickingEagerService](app.Container()).Eager().
+		Provider(func(_ *Container) (*panickingEagerService, error) {
+			panic("boom")
+		}))
+
+	err := app.Build()
+	s.Require().ErrorIs(err, ErrDIProviderPanic)
+	s.Equal(1, strings.Count(err.Error(), "provider panic"))
+}
@@ -1,7 +1,10 @@
 package gaz
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/petabytecl/gaz/di"
 )
@@ -22,7 +25,11 @@ import (
 // has a Cobra command attached (via WithCobra), the flags are registered
 // on the command's PersistentFlags.
 //
+// Modules that declare dependencies (via ModuleBuilder.DependsOn()) are
+// applied during Build(), after the modules they depend on.
+//
 // Returns error on duplicate module name (collected during Build()).
+// Use [App.TryUse] to have registration errors returned immediately.
 // Panics if called after Build().
 //
 // Example:
@@ -39,27 +46,65 @@ import (
 //	    Use(cacheModule).
 //	    Build()
 func (a *App) Use(m Module) *App {
+	if err := a.use(m); err != nil {
+		a.buildErrors = append(a.buildErrors, err)
+	}
+	return a
+}
+
+// TryUse is the strict variant of [App.Use]. Instead of deferring
+// registration problems to Build(), it returns them immediately: a nil
+// module, a duplicate module name, a nil provider, or any error from
+// applying the module. Errors returned by TryUse are not collected for
+// Build().
+//
+// Modules that declare dependencies are still applied during Build(), so
+// only their name is checked eagerly.
+//
+// Panics if called after Build().
+//
+// Example:
+//
+//	if err := app.TryUse(databaseModule); err != nil {
+//	    log.Fatal(err)
+//	}
+func (a *App) TryUse(m Module) error {
+	return a.use(m)
+}
+
+// use registers and applies m, returning any registration error.
+func (a *App) use(m Module) error {
 	if a.built {
 		panic("gaz: cannot add modules after Build()")
 	}
+	if m == nil {
+		return ErrModuleNil
+	}
 
 	name := m.Name()
 
 	// Check for duplicate module name
 	if a.modules[name] {
-		a.buildErrors = append(a.buildErrors,
-			fmt.Errorf("%w: %s", ErrModuleDuplicate, name))
-		return a
+		return fmt.Errorf("%w: %s", ErrModuleDuplicate, name)
 	}
 	a.modules[name] = true
 
+	// Modules with declared dependencies are applied in dependency order
+	// during Build(). Flags are registered now so CLI parsing sees them.
+	if len(moduleDependencies(m)) > 0 {
+		if bm, ok := m.(*builtModule); ok {
+			bm.registerFlags(a)
+		}
+		a.pending = append(a.pending, m)
+		return nil
+	}
+
 	// Apply the module (which applies child modules first, then providers)
 	if err := m.Apply(a); err != nil {
-		a.buildErrors = append(a.buildErrors,
-			fmt.Errorf("module %s: %w", name, err))
+		return fmt.Errorf("module %s: %w", name, err)
 	}
 
-	return a
+	return nil
 }
 
 // UseDI applies a di.Module to the app's container.
@@ -97,3 +142,81 @@ func (a *App) UseDI(m di.Module) *App {
 
 	return a
 }
+
+// moduleDependencies returns the module names m declares as dependencies,
+// or nil if m does not declare any.
+func moduleDependencies(m Module) []string {
+	if d, ok := m.(interface{ DependsOn() []string }); ok {
+		return d.DependsOn()
+	}
+	return nil
+}
+
+// applyPendingModules applies modules with declared dependencies in
+// dependency order. Dependencies may be any registered module, whether
+// applied immediately or pending. Called at the start of Build().
+func (a *App) applyPendingModules() error {
+	if len(a.pending) == 0 {
+		return nil
+	}
+
+	pending := make(map[string]Module, len(a.pending))
+	for _, m := range a.pending {
+		pending[m.Name()] = m
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(pending))
+	order := make([]Module, 0, len(pending))
+	var path []string
+
+	var visit func(m Module) error
+	visit = func(m Module) error {
+		name := m.Name()
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append(path[slices.Index(path, name):], name)
+			return fmt.Errorf("%w: %s", ErrModuleDependencyCycle, strings.Join(cycle, " -> "))
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range moduleDependencies(m) {
+			if depModule, ok := pending[dep]; ok {
+				if err := visit(depModule); err != nil {
+					return err
+				}
+				continue
+			}
+			if !a.modules[dep] {
+				return fmt.Errorf("%w: module %s depends on %s", ErrModuleDependencyMissing, name, dep)
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		order = append(order, m)
+		return nil
+	}
+
+	// Visit in registration order for deterministic application
+	for _, m := range a.pending {
+		if err := visit(m); err != nil {
+			return err
+		}
+	}
+	a.pending = nil
+
+	var errs []error
+	for _, m := range order {
+		if err := m.Apply(a); err != nil {
+			errs = append(errs, fmt.Errorf("module %s: %w", m.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
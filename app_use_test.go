@@ -139,3 +139,60 @@ func (s *AppUseSuite) TestApp_Use_ModuleErrorCollected() {
 	s.Contains(buildErr.Error(), "error-module")
 	s.Contains(buildErr.Error(), "registration failed")
 }
+
+func (s *AppUseSuite) TestApp_TryUse_DuplicateModuleReturnsErrorImmediately() {
+	m := NewModule("test").Build()
+	app := New()
+
+	s.Require().NoError(app.TryUse(m))
+
+	err := app.TryUse(m)
+	s.Require().ErrorIs(err, ErrModuleDuplicate)
+	s.Contains(err.Error(), "test")
+
+	// Errors returned eagerly are not collected for Build()
+	s.Require().NoError(app.Build())
+}
+
+func (s *AppUseSuite) TestApp_TryUse_NilModule() {
+	app := New()
+	s.Require().ErrorIs(app.TryUse(nil), ErrModuleNil)
+}
+
+func (s *AppUseSuite) TestApp_TryUse_NilProvider() {
+	applied := false
+	m := NewModule("test").
+		Provide(func(_ *Container) error {
+			applied = true
+			return nil
+		}, nil).
+		Build()
+
+	err := New().TryUse(m)
+	s.Require().ErrorIs(err, ErrModuleNilProvider)
+	s.False(applied, "no provider should run when one is nil")
+}
+
+func (s *AppUseSuite) TestApp_TryUse_ApplyError() {
+	m := NewModule("test").
+		Provide(func(_ *Container) error {
+			return errors.New("provider failed")
+		}).
+		Build()
+
+	err := New().TryUse(m)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "module test: provider failed")
+}
+
+func (s *AppUseSuite) TestApp_Use_DeferredErrors() {
+	m := NewModule("test").
+		Provide(nil).
+		Build()
+
+	app := New().Use(m).Use(m)
+
+	err := app.Build()
+	s.Require().ErrorIs(err, ErrModuleNilProvider)
+	s.Require().ErrorIs(err, ErrModuleDuplicate)
+}
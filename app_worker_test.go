@@ -136,6 +136,42 @@ func (s *AppTestSuite) TestApp_WorkerAutoDiscovery() {
 	s.Equal(1, testW.getStopCount(), "worker should have been stopped once")
 }
 
+// optionsTestWorker is a testWorker that chooses its own registration options.
+type optionsTestWorker struct {
+	*testWorker
+	opts []worker.WorkerOption
+}
+
+func (w *optionsTestWorker) WorkerOptions() []worker.WorkerOption { return w.opts }
+
+func (s *AppTestSuite) TestApp_WorkerDiscoveryAppliesWorkerOptions() {
+	app := New()
+
+	testW := &optionsTestWorker{
+		testWorker: newTestWorker("pooled-worker"),
+		opts:       []worker.WorkerOption{worker.WithPoolSize(2)},
+	}
+	err := For[*optionsTestWorker](app.Container()).Instance(testW)
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	// A pool of two starts the worker twice
+	s.Eventually(func() bool { return testW.getStartCount() == 2 }, 2*time.Second, 10*time.Millisecond)
+
+	s.Require().NoError(app.Stop(context.Background()))
+	select {
+	case runResult := <-runErr:
+		s.Require().NoError(runResult)
+	case <-time.After(2 * time.Second):
+		s.Fail("Run did not return after Stop")
+	}
+}
+
 func (s *AppTestSuite) TestApp_WorkerStartsAfterServices() {
 	app := New()
 
@@ -287,6 +323,70 @@ func (s *AppTestSuite) TestApp_WorkerStopsBeforeServices() {
 	s.Less(workerIdx, serviceIdx, "worker should stop before service")
 }
 
+func (s *AppTestSuite) TestApp_WorkersStopInReverseDependencyOrder() {
+	app := New()
+
+	var mu sync.Mutex
+	var order []string
+	track := func(event string) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, event)
+			mu.Unlock()
+		}
+	}
+
+	consumerW := newTestWorker("consumer")
+	err := For[*orderTrackingWorker](app.Container()).Named("consumer").Instance(&orderTrackingWorker{
+		Worker: consumerW,
+		onStop: track("consumer-stopped"),
+	})
+	s.Require().NoError(err)
+
+	// The producer feeds the consumer, so it must stop first. Its slow OnStop
+	// would let the consumer stop first if workers stopped concurrently.
+	producerW := newTestWorker("producer")
+	err = For[*orderTrackingWorker](app.Container()).Named("producer").
+		Provider(func(c *Container) (*orderTrackingWorker, error) {
+			if _, resolveErr := Resolve[*orderTrackingWorker](c, Named("consumer")); resolveErr != nil {
+				return nil, resolveErr
+			}
+			return &orderTrackingWorker{
+				Worker: producerW,
+				onStop: func() {
+					time.Sleep(50 * time.Millisecond)
+					track("producer-stopped")()
+				},
+			}, nil
+		})
+	s.Require().NoError(err)
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background())
+	}()
+
+	for _, w := range []*testWorker{consumerW, producerW} {
+		select {
+		case <-w.started:
+		case <-time.After(2 * time.Second):
+			s.Fail("worker did not start", w.name)
+		}
+	}
+
+	s.Require().NoError(app.Stop(context.Background()))
+
+	select {
+	case <-runErr:
+	case <-time.After(2 * time.Second):
+		s.Fail("Run did not return")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"producer-stopped", "consumer-stopped"}, order)
+}
+
 func (s *AppTestSuite) TestApp_WorkerPanicRecovery() {
 	// This test expects panic recovery for workers, but with the new OnStart interface,
 	// workers that implement OnStart also implement di.Starter, which is called during
@@ -71,6 +71,9 @@ func WithCobra(cmd *cobra.Command) Option {
 		cmd.PersistentPreRunE = a.makePreRunE(originalPreRunE)
 		cmd.PersistentPostRunE = a.makePostRunE(originalPostRunE)
 
+		// List AddFlagsGroup flags in labeled --help sections
+		cmd.SetUsageFunc(a.groupedUsageFunc(cmd.UsageFunc()))
+
 		// Inject default RunE if no Run/RunE is defined
 		if cmd.Run == nil && cmd.RunE == nil {
 			cmd.RunE = func(c *cobra.Command, _ []string) error {
@@ -137,6 +140,10 @@ func (a *App) bootstrap(ctx context.Context, cmd *cobra.Command, args []string)
 		if err := a.configMgr.BindFlags(cmd.Flags()); err != nil {
 			return fmt.Errorf("failed to bind flags: %w", err)
 		}
+		// Flags are parsed now; resolve keys set by both a flag and an env var
+		if err := a.configMgr.ApplyPrecedence(); err != nil {
+			return fmt.Errorf("failed to apply config precedence: %w", err)
+		}
 	}
 
 	// Initialize run state similar to App.Run
@@ -160,7 +167,7 @@ func (a *App) bootstrap(ctx context.Context, cmd *cobra.Command, args []string)
 	}()
 
 	// Build the app (validates registrations)
-	if err := a.Build(); err != nil {
+	if err := a.BuildWithContext(ctx); err != nil {
 		return fmt.Errorf("app build failed: %w", err)
 	}
 
@@ -7,8 +7,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-
-	"github.com/petabytecl/gaz/config"
 )
 
 // RegisterCobraFlags registers ConfigProvider flags as persistent pflags on the command.
@@ -29,6 +27,12 @@ import (
 //	app.WithCobra(rootCmd)
 //	rootCmd.Execute()
 func (a *App) RegisterCobraFlags(cmd *cobra.Command) error {
+	// Apply modules with declared dependencies (idempotent) - their providers
+	// must be registered before provider configs are collected
+	if err := a.applyPendingModules(); err != nil {
+		return fmt.Errorf("applying modules: %w", err)
+	}
+
 	// Load config (idempotent) - needed for defaults
 	if err := a.loadConfig(); err != nil {
 		return fmt.Errorf("loading config for flag registration: %w", err)
@@ -52,28 +56,20 @@ func (a *App) RegisterCobraFlags(cmd *cobra.Command) error {
 func (a *App) registerPFlags(cmd *cobra.Command) error {
 	fs := cmd.PersistentFlags()
 
-	// Get FlagBinder from backend
-	fb, ok := a.configMgr.Backend().(config.FlagBinder)
-	if !ok {
-		// Backend doesn't support individual flag binding, skip
-		return nil
-	}
-
 	for _, entry := range a.providerConfigs {
 		for _, flag := range entry.flags {
-			fullKey := entry.namespace + "." + flag.Key
+			fullKey := fullConfigKey(entry.namespace, flag)
 			flagName := configKeyToFlagName(fullKey)
 
-			// Skip if already registered (collision prevention)
-			if fs.Lookup(flagName) != nil {
-				continue
+			// Keep an already registered flag (collision prevention), but
+			// still bind it: an unbound flag would lose to env vars.
+			if fs.Lookup(flagName) == nil {
+				// Register typed flag with default and description
+				registerTypedFlag(fs, flag, flagName)
 			}
 
-			// Register typed flag with default and description
-			registerTypedFlag(fs, flag, flagName)
-
-			// Bind to viper with ORIGINAL dot-notation key
-			if err := fb.BindPFlag(fullKey, fs.Lookup(flagName)); err != nil {
+			// Bind with ORIGINAL dot-notation key
+			if err := a.configMgr.BindPFlag(fullKey, fs.Lookup(flagName)); err != nil {
 				return fmt.Errorf("binding flag %s to key %s: %w", flagName, fullKey, err)
 			}
 		}
@@ -111,3 +107,74 @@ func registerTypedFlag(fs *pflag.FlagSet, flag ConfigFlag, name string) {
 		fs.String(name, def, flag.Description)
 	}
 }
+
+// FlagGroupAnnotation is the pflag annotation key holding the group name of
+// flags registered with App.AddFlagsGroup.
+const FlagGroupAnnotation = "gaz_flag_group"
+
+// FlagGroupName returns the group a flag was registered under with
+// App.AddFlagsGroup, or "" for ungrouped flags.
+func FlagGroupName(f *pflag.Flag) string {
+	if group := f.Annotations[FlagGroupAnnotation]; len(group) > 0 {
+		return group[0]
+	}
+	return ""
+}
+
+// groupedUsageFunc wraps a Cobra usage function so flags registered with
+// AddFlagsGroup are listed in a labeled section per group, in registration
+// order, after the usage fallback renders the ungrouped flags.
+func (a *App) groupedUsageFunc(fallback func(*cobra.Command) error) func(*cobra.Command) error {
+	return func(c *cobra.Command) error {
+		sections := a.commandFlagGroups(c)
+
+		// Hide grouped flags while the fallback renders the flat lists
+		var hidden []*pflag.Flag
+		for _, fs := range sections {
+			fs.VisitAll(func(f *pflag.Flag) {
+				f.Hidden = true
+				hidden = append(hidden, f)
+			})
+		}
+		err := fallback(c)
+		for _, f := range hidden {
+			f.Hidden = false
+		}
+		if err != nil {
+			return err
+		}
+
+		w := c.OutOrStderr()
+		for _, fs := range sections {
+			fmt.Fprintf(w, "\n%s Flags:\n%s", fs.Name(), fs.FlagUsages())
+		}
+		return nil
+	}
+}
+
+// commandFlagGroups returns one flag set per group with the visible grouped
+// flags available to c, skipping groups with none.
+func (a *App) commandFlagGroups(c *cobra.Command) []*pflag.FlagSet {
+	var available []*pflag.Flag
+	for _, fs := range []*pflag.FlagSet{c.LocalFlags(), c.InheritedFlags()} {
+		fs.VisitAll(func(f *pflag.Flag) {
+			if !f.Hidden && FlagGroupName(f) != "" {
+				available = append(available, f)
+			}
+		})
+	}
+
+	var sections []*pflag.FlagSet
+	for _, group := range a.flagGroups {
+		section := pflag.NewFlagSet(group.Name(), pflag.ContinueOnError)
+		for _, f := range available {
+			if FlagGroupName(f) == group.Name() {
+				section.AddFlag(f)
+			}
+		}
+		if section.HasFlags() {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
@@ -1,10 +1,12 @@
 package gaz
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -293,6 +295,38 @@ func (s *CobraFlagsSuite) TestRegisterCobraFlagsCliOverride() {
 	s.Equal(9090, capturedPort)
 }
 
+func (s *CobraFlagsSuite) TestRegisterCobraFlagsPreexistingFlagBeatsEnv() {
+	s.T().Setenv("SERVER_PORT", "7070")
+	var capturedPort int
+
+	rootCmd := &cobra.Command{
+		Use: "test",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			pv := MustResolve[*ProviderValues](FromContext(cmd.Context()).Container())
+			capturedPort = pv.GetInt("server.port")
+			return nil
+		},
+	}
+	// A flag with the provider's name is already registered (e.g. by a module)
+	rootCmd.PersistentFlags().Int("server-port", 0, "Server port")
+
+	app := New(WithCobra(rootCmd))
+	provider := &testConfigProvider{
+		namespace: "server",
+		flags: []ConfigFlag{
+			{Key: "port", Type: ConfigFlagTypeInt, Default: 8080, Description: "Server port"},
+		},
+	}
+	s.Require().NoError(For[*testConfigProvider](app.Container()).Instance(provider))
+	s.Require().NoError(app.RegisterCobraFlags(rootCmd))
+
+	rootCmd.SetArgs([]string{"--server-port=9090"})
+	s.Require().NoError(rootCmd.Execute())
+
+	// The flag must still be bound, so it overrides the env var
+	s.Equal(9090, capturedPort)
+}
+
 func (s *CobraFlagsSuite) TestConfigKeyToFlagName() {
 	// Test the key transformation function
 	s.Equal("server-host", configKeyToFlagName("server.host"))
@@ -517,3 +551,81 @@ func (s *CobraFlagsSuite) TestRegisterCobraFlagsFloatOverride() {
 
 	s.InDelta(2.5, capturedRate, 0.001)
 }
+
+func (s *CobraFlagsSuite) TestAddFlagsGroupCollectsFlagsInNamedSet() {
+	app := New()
+	app.AddFlagsGroup("grpc", func(fs *pflag.FlagSet) {
+		fs.Int("grpc-port", 50051, "gRPC listen port")
+	})
+	app.AddFlagsGroup("grpc", func(fs *pflag.FlagSet) {
+		fs.Bool("grpc-reflection", false, "Enable gRPC reflection")
+	})
+
+	group := app.FlagGroup("grpc")
+	s.Require().NotNil(group)
+	s.Equal("grpc", group.Name())
+	s.NotNil(group.Lookup("grpc-port"))
+	s.NotNil(group.Lookup("grpc-reflection"))
+	s.Nil(app.FlagGroup("http"))
+
+	rootCmd := &cobra.Command{Use: "myapp"}
+	WithCobra(rootCmd)(app)
+
+	port := rootCmd.PersistentFlags().Lookup("grpc-port")
+	s.Require().NotNil(port)
+	s.Equal("grpc", FlagGroupName(port))
+}
+
+func (s *CobraFlagsSuite) TestAddFlagsGroupAfterWithCobraAppliesImmediately() {
+	rootCmd := &cobra.Command{Use: "myapp"}
+	app := New(WithCobra(rootCmd))
+	app.AddFlagsFn(func(fs *pflag.FlagSet) {
+		fs.String("name", "", "Plain flag")
+	})
+	app.AddFlagsGroup("http", func(fs *pflag.FlagSet) {
+		fs.Int("http-port", 8080, "HTTP listen port")
+	})
+
+	s.Equal("http", FlagGroupName(rootCmd.PersistentFlags().Lookup("http-port")))
+	s.Empty(FlagGroupName(rootCmd.PersistentFlags().Lookup("name")))
+}
+
+func (s *CobraFlagsSuite) TestAddFlagsGroupRendersHelpSections() {
+	rootCmd := &cobra.Command{
+		Use:  "myapp",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	subCmd := &cobra.Command{
+		Use:  "serve",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(subCmd)
+
+	app := New(WithCobra(rootCmd))
+	app.AddFlagsFn(func(fs *pflag.FlagSet) {
+		fs.String("name", "", "Plain flag")
+	})
+	app.AddFlagsGroup("http", func(fs *pflag.FlagSet) {
+		fs.Int("http-port", 8080, "HTTP listen port")
+	})
+	app.AddFlagsGroup("grpc", func(fs *pflag.FlagSet) {
+		fs.Int("grpc-port", 50051, "gRPC listen port")
+	})
+
+	for _, cmd := range []*cobra.Command{rootCmd, subCmd} {
+		usage := cmd.UsageString()
+		httpSection := strings.Index(usage, "http Flags:")
+		grpcSection := strings.Index(usage, "grpc Flags:")
+		s.Require().Positive(httpSection, usage)
+		s.Require().Greater(grpcSection, httpSection, "groups render in registration order")
+
+		// Grouped flags appear only in their section; plain flags stay flat
+		s.Equal(1, strings.Count(usage, "--http-port"))
+		s.Greater(strings.Index(usage, "--http-port"), httpSection)
+		s.Greater(strings.Index(usage, "--grpc-port"), grpcSection)
+		s.Less(strings.Index(usage, "--name"), httpSection)
+	}
+
+	// Grouped flags are visible again after rendering
+	s.False(rootCmd.PersistentFlags().Lookup("http-port").Hidden)
+}
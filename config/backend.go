@@ -68,6 +68,24 @@ type Writer interface {
 	SafeWriteConfigAs(filename string) error
 }
 
+// RemoteBackend is implemented by backends that can load configuration from a
+// remote key/value store such as etcd or Consul.
+// This is an optional interface that extends Backend with remote capabilities.
+type RemoteBackend interface {
+	// AddRemoteProvider adds a remote configuration source. Provider names the
+	// store (e.g. "etcd3", "consul"), endpoint is its address, and path is the
+	// key holding the configuration document.
+	AddRemoteProvider(provider, endpoint, path string) error
+
+	// ReadRemoteConfig loads configuration from the registered remote providers.
+	ReadRemoteConfig() error
+
+	// WatchRemoteConfig starts watching the remote providers in the background.
+	// Each change is loaded and reported to the OnConfigChange callbacks when
+	// the backend also implements Watcher.
+	WatchRemoteConfig() error
+}
+
 // EnvBinder is implemented by backends that support environment variable binding.
 // This is an optional interface that extends Backend with env binding capabilities.
 type EnvBinder interface {
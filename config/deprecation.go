@@ -0,0 +1,134 @@
+package config
+
+import (
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Deprecation describes a deprecated config key for [WithDeprecatedKeys].
+type Deprecation struct {
+	// Replacement is the key that supersedes the deprecated one. Leave it
+	// empty for a key that was removed without a replacement.
+	Replacement string
+	// Message is an optional note logged with the warning, such as
+	// "no longer used; remove it".
+	Message string
+}
+
+// WithDeprecatedKeys marks config keys as deprecated, keyed by the old key.
+//
+// When Load finds a deprecated key set, it logs a warning (see WithLogger).
+// If the entry names a replacement key that is not set itself by a config
+// file, environment variable, or flag (a default does not count), the old
+// value is copied to it at config file precedence, so existing configs keep
+// working while they migrate. When both are set, the replacement wins.
+//
+// Example:
+//
+//	config.WithDeprecatedKeys(map[string]config.Deprecation{
+//	    "db.url":       {Replacement: "database.dsn"},
+//	    "cache.legacy": {Message: "no longer used; remove it"},
+//	})
+func WithDeprecatedKeys(keys map[string]Deprecation) Option {
+	return func(m *Manager) {
+		if m.deprecated == nil {
+			m.deprecated = make(map[string]Deprecation, len(keys))
+		}
+		maps.Copy(m.deprecated, keys)
+	}
+}
+
+// WithLogger sets the logger for Manager warnings, such as deprecated keys.
+// Default is slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(m *Manager) {
+		m.logger = logger
+	}
+}
+
+// log returns the Manager's logger.
+func (m *Manager) log() *slog.Logger {
+	if m.logger != nil {
+		return m.logger
+	}
+	return slog.Default()
+}
+
+// applyDeprecatedKeys warns about deprecated keys that are set and copies
+// their values to unset replacement keys.
+func (m *Manager) applyDeprecatedKeys() error {
+	for _, old := range slices.Sorted(maps.Keys(m.deprecated)) {
+		if !m.isExplicitlySet(old) {
+			continue
+		}
+		dep := m.deprecated[old]
+		attrs := []any{slog.String("key", old)}
+		if dep.Replacement != "" {
+			attrs = append(attrs, slog.String("replacement", dep.Replacement))
+		}
+		if dep.Message != "" {
+			attrs = append(attrs, slog.String("message", dep.Message))
+		}
+		if dep.Replacement == "" {
+			m.log().Warn("deprecated config key", attrs...)
+			continue
+		}
+		if m.isExplicitlySet(dep.Replacement) {
+			m.log().Warn("deprecated config key is ignored; replacement is set", attrs...)
+			continue
+		}
+
+		m.log().Warn("deprecated config key; use the replacement", attrs...)
+		if err := m.remapKey(dep.Replacement, m.backend.Get(old)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isExplicitlySet reports whether key is set by a config file, an
+// environment variable, or a changed flag. Defaults do not count, so a
+// replacement key with a default still receives the deprecated value.
+// Backends that cannot report the config file layer fall back to IsSet.
+func (m *Manager) isExplicitlySet(key string) bool {
+	checker, ok := m.backend.(configKeyChecker)
+	if !ok {
+		return m.backend.IsSet(key)
+	}
+	if checker.InConfig(key) {
+		return true
+	}
+	if flag, bound := m.flags[key]; bound && flag.Changed {
+		return true
+	}
+	envVar, bound := m.envVars[key]
+	if !bound && m.envPrefix != "" {
+		envVar, bound = structEnvVar(m.envPrefix, key), true
+	}
+	if bound {
+		if _, set := os.LookupEnv(envVar); set {
+			return true
+		}
+	}
+	return false
+}
+
+// remapKey sets key to value at config file precedence when the backend can
+// merge maps, or with Set otherwise.
+func (m *Manager) remapKey(key string, value any) error {
+	merger, ok := m.backend.(configMapMerger)
+	if !ok {
+		m.backend.Set(key, value)
+		return nil
+	}
+
+	parts := strings.Split(key, ".")
+	nested := map[string]any{parts[len(parts)-1]: value}
+	for i := len(parts) - 2; i >= 0; i-- {
+		nested = map[string]any{parts[i]: nested}
+	}
+	return merger.MergeConfigMap(nested)
+}
@@ -0,0 +1,141 @@
+package config_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz/config"
+	cfgviper "github.com/petabytecl/gaz/config/viper"
+)
+
+// newDeprecationManager writes content to config.yaml in a temp dir and
+// returns a Manager reading it with the given deprecated keys, plus the
+// buffer its warnings are logged to.
+func newDeprecationManager(t *testing.T, content string, keys map[string]config.Deprecation, opts ...config.Option) (*config.Manager, *bytes.Buffer, string) {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	var logs bytes.Buffer
+	mgr := config.NewWithBackend(cfgviper.New(), append([]config.Option{
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+		config.WithDeprecatedKeys(keys),
+		config.WithLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	}, opts...)...)
+	return mgr, &logs, path
+}
+
+type deprecationConfig struct {
+	Database struct {
+		DSN string `mapstructure:"dsn"`
+	} `mapstructure:"database"`
+}
+
+func TestDeprecatedKeys_RemapsToReplacement(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t, "db:\n  url: postgres://old\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn"}})
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://old", cfg.Database.DSN)
+	assert.Contains(t, logs.String(), "level=WARN")
+	assert.Contains(t, logs.String(), "key=db.url")
+	assert.Contains(t, logs.String(), "replacement=database.dsn")
+}
+
+func TestDeprecatedKeys_ReplacementWins(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t,
+		"db:\n  url: postgres://old\ndatabase:\n  dsn: postgres://new\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn"}})
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://new", cfg.Database.DSN)
+	assert.Contains(t, logs.String(), "ignored")
+}
+
+func TestDeprecatedKeys_RemapsOverReplacementDefault(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t, "db:\n  url: postgres://old\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn"}},
+		config.WithDefaults(map[string]any{"database.dsn": "postgres://default"}))
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://old", cfg.Database.DSN)
+	assert.Contains(t, logs.String(), "replacement=database.dsn")
+	assert.NotContains(t, logs.String(), "ignored")
+}
+
+func TestDeprecatedKeys_ReplacementEnvWins(t *testing.T) {
+	t.Setenv("APP_DATABASE__DSN", "postgres://env")
+	mgr, logs, _ := newDeprecationManager(t, "db:\n  url: postgres://old\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn"}},
+		config.WithEnvPrefix("APP"))
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://env", cfg.Database.DSN)
+	assert.Contains(t, logs.String(), "ignored")
+}
+
+func TestDeprecatedKeys_MessageOnly(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t, "cache:\n  legacy: true\n",
+		map[string]config.Deprecation{"cache.legacy": {Message: "no longer used; remove it"}})
+
+	require.NoError(t, mgr.Load())
+
+	assert.Contains(t, logs.String(), "key=cache.legacy")
+	assert.Contains(t, logs.String(), `message="no longer used; remove it"`)
+	assert.NotContains(t, logs.String(), "replacement")
+}
+
+func TestDeprecatedKeys_ReplacementWithMessage(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t, "db:\n  url: postgres://old\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn", Message: "removed in v2"}})
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://old", cfg.Database.DSN)
+	assert.Contains(t, logs.String(), "replacement=database.dsn")
+	assert.Contains(t, logs.String(), `message="removed in v2"`)
+}
+
+func TestDeprecatedKeys_AbsentKeyNoWarning(t *testing.T) {
+	mgr, logs, _ := newDeprecationManager(t, "database:\n  dsn: postgres://new\n",
+		map[string]config.Deprecation{
+			"db.url":       {Replacement: "database.dsn"},
+			"cache.legacy": {Message: "no longer used"},
+		})
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+
+	assert.Equal(t, "postgres://new", cfg.Database.DSN)
+	assert.Empty(t, logs.String())
+}
+
+func TestDeprecatedKeys_RemapFollowsReload(t *testing.T) {
+	mgr, _, path := newDeprecationManager(t, "db:\n  url: postgres://one\n",
+		map[string]config.Deprecation{"db.url": {Replacement: "database.dsn"}})
+
+	var cfg deprecationConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+	require.Equal(t, "postgres://one", cfg.Database.DSN)
+
+	require.NoError(t, os.WriteFile(path, []byte("db:\n  url: postgres://two\n"), 0o600))
+	require.NoError(t, mgr.Reload(&cfg))
+	assert.Equal(t, "postgres://two", cfg.Database.DSN)
+}
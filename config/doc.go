@@ -36,4 +36,61 @@
 //	if err := mgr.LoadInto(cfg); err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// Subsystems can instead own independent structs for their part of the config
+// with [Manager.LoadIntoSection], which unmarshals one subtree (such as
+// "database") and applies the same defaulting and validation.
+//
+// Load failures are returned as a [*LoadError] recording the [LoadStage]
+// that failed, the config file(s) involved, and, for validation failures,
+// the first failing key.
+//
+// [Manager.Reload] reloads into the same struct later, for example on SIGHUP.
+// If the new config fails to load or validate, it restores the previous
+// config file and Set values and leaves the struct unchanged; env vars,
+// flags, and defaults are never snapshotted and keep applying live. Tests
+// can do the same by hand with [Manager.Snapshot] and [Manager.Restore]:
+//
+//	snap := mgr.Snapshot()
+//	t.Cleanup(func() { _ = mgr.Restore(snap) })
+//
+// To rename keys without breaking existing files, list the old keys with
+// [WithDeprecatedKeys]. Load logs a warning for each one that is set and
+// copies its value to the replacement key:
+//
+//	config.WithDeprecatedKeys(map[string]config.Deprecation{
+//	    "db.url": {Replacement: "database.dsn"},
+//	})
+//
+// # Precedence
+//
+// Values resolve as CLI flags > environment variables > config file > defaults.
+// [Manager.Load] enforces this order for keys bound through both
+// [Manager.BindPFlag] and [Manager.BindEnv], so an explicitly set flag always
+// beats its env var. Use [WithPrecedence] to let environment variables win:
+//
+//	mgr := config.New(config.WithPrecedence(
+//	    config.SourceEnv, config.SourceFlag, config.SourceFile, config.SourceDefault,
+//	))
+//
+// Without cobra, [Manager.BindFlagSet] binds a plain pflag.FlagSet, matching
+// each known key to a flag by name ("server.port" to --server-port):
+//
+//	_ = mgr.Load()
+//	_ = mgr.BindFlagSet(fs)
+//	_ = fs.Parse(os.Args[1:])
+//	_ = mgr.ApplyPrecedence()
+//
+// # JSON Schema
+//
+// [GenerateSchema] reflects a config struct into a JSON Schema built from its
+// config and validate tags, so editors can check config files while they are
+// written.
+//
+// # Environment Variables
+//
+// [DocumentEnvVars] lists the environment variables bound for provider flags
+// (redis.host is read from REDIS_HOST) with their type, default, and
+// description. [DocumentStructEnvVars] does the same for a config struct
+// loaded with an env prefix (APP_DATABASE__HOST).
 package config
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnvVarDoc describes one environment variable the application reads.
+type EnvVarDoc struct {
+	// Name is the environment variable, e.g. "REDIS_HOST".
+	Name string
+
+	// Key is the config key it sets, e.g. "redis.host".
+	Key string
+
+	// Type is the value type, e.g. "string", "int", "bool", or "duration".
+	Type string
+
+	// Default is the value used when the variable is unset, or nil.
+	Default any
+
+	// Required reports whether the application fails to start without it.
+	Required bool
+
+	// Description is the help text, if any.
+	Description string
+}
+
+// ProviderFlags is the namespace and flags declared by one config provider.
+type ProviderFlags struct {
+	Namespace string
+	Flags     []ConfigFlag
+}
+
+// providerEnvVar returns the environment variable bound to a provider key by
+// RegisterProviderFlags: "redis.host" becomes "REDIS_HOST".
+func providerEnvVar(fullKey string) string {
+	return strings.ToUpper(strings.ReplaceAll(fullKey, ".", "_"))
+}
+
+// structEnvVar returns the environment variable AutomaticEnv reads for a
+// struct key under prefix: "server.port" with prefix "APP" becomes
+// "APP_SERVER__PORT".
+func structEnvVar(prefix, key string) string {
+	return strings.ToUpper(prefix + "_" + strings.ReplaceAll(key, ".", "__"))
+}
+
+// DocumentEnvVars lists the environment variables bound for the given
+// provider flags, sorted by name. Names use the same translation as
+// RegisterProviderFlags, so namespace "redis" with key "host" is documented
+// as REDIS_HOST.
+//
+// Example:
+//
+//	for _, v := range config.DocumentEnvVars(providers...) {
+//	    fmt.Printf("%s (%s, default %v): %s\n", v.Name, v.Type, v.Default, v.Description)
+//	}
+func DocumentEnvVars(providers ...ProviderFlags) []EnvVarDoc {
+	var docs []EnvVarDoc
+	for _, p := range providers {
+		for _, flag := range p.Flags {
+			fullKey := p.Namespace + "." + flag.Key
+			docs = append(docs, EnvVarDoc{
+				Name:        providerEnvVar(fullKey),
+				Key:         fullKey,
+				Type:        flag.Type,
+				Default:     flag.Default,
+				Required:    flag.Required,
+				Description: flag.Description,
+			})
+		}
+	}
+	sortEnvVarDocs(docs)
+	return docs
+}
+
+// DocumentStructEnvVars lists the environment variables a config struct is
+// read from when the Manager is created with WithEnvPrefix(prefix), sorted by
+// name. Nested keys are joined with "__": field Port of field Server becomes
+// PREFIX_SERVER__PORT. Non-zero field values of cfg are reported as
+// defaults, and fields with a "required" validate rule are marked required.
+//
+// Without a prefix, struct fields are not read from the environment and nil
+// is returned. It returns ErrInvalidSchemaTarget if cfg is not a struct or a
+// pointer to one.
+func DocumentStructEnvVars(prefix string, cfg any) ([]EnvVarDoc, error) {
+	v := reflect.ValueOf(cfg)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v = reflect.New(v.Type().Elem()).Elem()
+			continue
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: got %v", ErrInvalidSchemaTarget, reflect.TypeOf(cfg))
+	}
+	if prefix == "" {
+		return nil, nil
+	}
+
+	var docs []EnvVarDoc
+	documentStruct(&docs, prefix, "", v)
+	sortEnvVarDocs(docs)
+	return docs, nil
+}
+
+// documentStruct appends a doc for every leaf field of struct value v.
+func documentStruct(docs *[]EnvVarDoc, prefix, keyPrefix string, v reflect.Value) {
+	t := v.Type()
+	for i := range t.NumField() {
+		fld := t.Field(i)
+		if !fld.IsExported() || isSkippedField(fld) {
+			continue
+		}
+		fv := v.Field(i)
+		for fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				fv = reflect.New(fv.Type().Elem()).Elem()
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		if fld.Anonymous && isSquashed(fld) && fv.Kind() == reflect.Struct {
+			documentStruct(docs, prefix, keyPrefix, fv)
+			continue
+		}
+
+		key := envKeyName(fld)
+		if keyPrefix != "" {
+			key = keyPrefix + "." + key
+		}
+		// time.Duration has kind Int64 and so is documented as a leaf.
+		if fv.Kind() == reflect.Struct {
+			documentStruct(docs, prefix, key, fv)
+			continue
+		}
+
+		doc := EnvVarDoc{
+			Name:     structEnvVar(prefix, key),
+			Key:      strings.ToLower(key),
+			Type:     envVarType(fv.Type()),
+			Required: hasRequiredRule(fld.Tag.Get("validate")),
+		}
+		if !fv.IsZero() {
+			doc.Default = fv.Interface()
+		}
+		*docs = append(*docs, doc)
+	}
+}
+
+// envKeyName returns the key bindStructEnv binds for fld: its mapstructure
+// tag name, or the field name.
+func envKeyName(fld reflect.StructField) string {
+	if name, _, _ := strings.Cut(fld.Tag.Get("mapstructure"), ","); name != "" {
+		return name
+	}
+	return fld.Name
+}
+
+// hasRequiredRule reports whether a validate tag marks the field itself
+// required. Rules after "dive" apply to elements and are ignored.
+func hasRequiredRule(tag string) bool {
+	for rule := range strings.SplitSeq(tag, ",") {
+		switch rule {
+		case "dive":
+			return false
+		case "required":
+			return true
+		}
+	}
+	return false
+}
+
+// envVarType names t using the provider flag type names where they apply.
+func envVarType(t reflect.Type) string {
+	if t == durationType {
+		return "duration"
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	default:
+		return t.String()
+	}
+}
+
+func sortEnvVarDocs(docs []EnvVarDoc) {
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].Name < docs[j].Name
+	})
+}
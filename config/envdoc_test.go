@@ -0,0 +1,111 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz/config"
+	cfgviper "github.com/petabytecl/gaz/config/viper"
+)
+
+func TestDocumentEnvVars(t *testing.T) {
+	docs := config.DocumentEnvVars(
+		config.ProviderFlags{Namespace: "redis", Flags: []config.ConfigFlag{
+			{Key: "port", Type: "int", Default: 6379, Description: "Redis server port"},
+			{Key: "host", Type: "string", Default: "localhost", Description: "Redis server host"},
+		}},
+		config.ProviderFlags{Namespace: "auth", Flags: []config.ConfigFlag{
+			{Key: "api_key", Type: "string", Required: true, Description: "API key"},
+		}},
+	)
+
+	require.Len(t, docs, 3)
+	assert.Equal(t, config.EnvVarDoc{
+		Name: "AUTH_API_KEY", Key: "auth.api_key", Type: "string", Required: true, Description: "API key",
+	}, docs[0])
+	assert.Equal(t, config.EnvVarDoc{
+		Name: "REDIS_HOST", Key: "redis.host", Type: "string", Default: "localhost", Description: "Redis server host",
+	}, docs[1])
+	assert.Equal(t, "REDIS_PORT", docs[2].Name)
+	assert.Equal(t, 6379, docs[2].Default)
+}
+
+func TestDocumentEnvVars_MatchesRegisteredBinding(t *testing.T) {
+	t.Setenv("REDIS_HOST", "redis.internal")
+
+	flags := []config.ConfigFlag{{Key: "host", Default: "localhost"}}
+	mgr := config.NewWithBackend(cfgviper.New())
+	require.NoError(t, mgr.RegisterProviderFlags("redis", flags))
+
+	doc := config.DocumentEnvVars(config.ProviderFlags{Namespace: "redis", Flags: flags})[0]
+	assert.Equal(t, "REDIS_HOST", doc.Name)
+	assert.Equal(t, "redis.internal", mgr.Backend().GetString(doc.Key))
+}
+
+type envDocDatabase struct {
+	Host    string        `mapstructure:"host" validate:"required"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type envDocConfig struct {
+	Port     int            `mapstructure:"port"`
+	Debug    bool           `mapstructure:"debug"`
+	Database envDocDatabase `mapstructure:"database"`
+	Internal string         `mapstructure:"-"`
+}
+
+func TestDocumentStructEnvVars(t *testing.T) {
+	docs, err := config.DocumentStructEnvVars("APP", &envDocConfig{
+		Port:     8080,
+		Database: envDocDatabase{Timeout: 5 * time.Second},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []config.EnvVarDoc{
+		{Name: "APP_DATABASE__HOST", Key: "database.host", Type: "string", Required: true},
+		{Name: "APP_DATABASE__TIMEOUT", Key: "database.timeout", Type: "duration", Default: 5 * time.Second},
+		{Name: "APP_DEBUG", Key: "debug", Type: "bool"},
+		{Name: "APP_PORT", Key: "port", Type: "int", Default: 8080},
+	}, docs)
+}
+
+func TestDocumentStructEnvVars_MatchesEnvBinding(t *testing.T) {
+	t.Setenv("APP_DATABASE__HOST", "db.internal")
+
+	var cfg envDocConfig
+	mgr := config.NewWithBackend(cfgviper.New(), config.WithEnvPrefix("APP"), config.WithSearchPaths(t.TempDir()))
+	require.NoError(t, mgr.LoadInto(&cfg))
+	assert.Equal(t, "db.internal", cfg.Database.Host)
+
+	docs, err := config.DocumentStructEnvVars("APP", &cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "APP_DATABASE__HOST", docs[0].Name)
+}
+
+type envDocRules struct {
+	Hosts []string `mapstructure:"hosts" validate:"dive,required"`
+	Name  string   `mapstructure:"name" validate:"min=1,required"`
+}
+
+func TestDocumentStructEnvVars_RequiredRule(t *testing.T) {
+	docs, err := config.DocumentStructEnvVars("APP", &envDocRules{})
+	require.NoError(t, err)
+
+	require.Len(t, docs, 2)
+	assert.Equal(t, "APP_HOSTS", docs[0].Name)
+	assert.False(t, docs[0].Required, "required after dive applies to elements")
+	assert.Equal(t, "APP_NAME", docs[1].Name)
+	assert.True(t, docs[1].Required)
+}
+
+func TestDocumentStructEnvVars_Errors(t *testing.T) {
+	_, err := config.DocumentStructEnvVars("APP", 42)
+	require.ErrorIs(t, err, config.ErrInvalidSchemaTarget)
+
+	docs, err := config.DocumentStructEnvVars("", &envDocConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, docs)
+}
@@ -14,6 +14,73 @@ var ErrConfigValidation = errors.New("config: validation failed")
 // Use errors.Is(err, ErrKeyNotFound) to check for missing keys.
 var ErrKeyNotFound = errors.New("config: key not found")
 
+// ErrInvalidSchemaTarget is returned by GenerateSchema when the value is not
+// a struct or a pointer to one.
+var ErrInvalidSchemaTarget = errors.New("config: schema target must be a struct")
+
+// ErrRestoreUnsupported is returned by Manager.Restore when the backend
+// cannot replace its configuration values.
+var ErrRestoreUnsupported = errors.New("config: backend does not support restore")
+
+// LoadStage identifies the step of config loading that failed.
+type LoadStage string
+
+const (
+	// LoadStageRead means the config file could not be read or parsed.
+	LoadStageRead LoadStage = "read"
+
+	// LoadStageUnmarshal means the loaded values could not be decoded
+	// into the target struct.
+	LoadStageUnmarshal LoadStage = "unmarshal"
+
+	// LoadStageValidate means the decoded struct failed validation.
+	LoadStageValidate LoadStage = "validate"
+)
+
+// LoadError is returned by the Manager's Load methods when loading fails.
+// It records which files were involved and which step failed, and wraps
+// the underlying cause, so errors.Is(err, ErrConfigValidation) and
+// errors.As on a ValidationError keep working.
+//
+// Example:
+//
+//	if le, ok := errors.AsType[*config.LoadError](err); ok {
+//	    log.Printf("config %s failed in %v: %v", le.Stage, le.Files, le.Err)
+//	}
+type LoadError struct {
+	// Stage is the step of loading that failed.
+	Stage LoadStage
+
+	// Files are the config file paths that were read or searched for.
+	// It holds the file actually used when one was found.
+	Files []string
+
+	// Backend is the type of the config backend (e.g. "*viper.Backend").
+	Backend string
+
+	// Key is the config key of the first failing field for validation
+	// failures, or empty when no single key is to blame.
+	Key string
+
+	// Err is the underlying cause.
+	Err error
+}
+
+// Error implements the error interface. The cause's own "config: " prefix
+// is dropped so the message carries it once.
+func (e *LoadError) Error() string {
+	if len(e.Files) == 0 {
+		return e.Err.Error()
+	}
+	cause := strings.TrimPrefix(e.Err.Error(), "config: ")
+	return fmt.Sprintf("config: %s %s: %s", e.Stage, strings.Join(e.Files, ", "), cause)
+}
+
+// Unwrap returns the underlying cause.
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
 // ValidationError holds multiple validation errors.
 // It implements the error interface and provides access to individual field errors.
 type ValidationError struct {
@@ -44,6 +111,11 @@ type FieldError struct {
 	// Namespace is the full path to the field (e.g., "Config.database.host").
 	Namespace string
 
+	// FieldPath is the config key of the field (e.g., "database.host").
+	// Unlike Namespace, it omits the root struct name and uses the gaz or
+	// mapstructure tag names, so it matches the key in the config file.
+	FieldPath string
+
 	// Tag is the validation tag that failed (e.g., "required", "min").
 	Tag string
 
@@ -57,7 +129,11 @@ type FieldError struct {
 // String returns a formatted string representation of the field error.
 func (fe FieldError) String() string {
 	var sb strings.Builder
-	sb.WriteString(fe.Namespace)
+	if fe.FieldPath != "" {
+		sb.WriteString(fe.FieldPath)
+	} else {
+		sb.WriteString(fe.Namespace)
+	}
 	sb.WriteString(": ")
 	sb.WriteString(fe.Message)
 	if fe.Tag != "" {
@@ -78,6 +154,25 @@ func NewFieldError(namespace, tag, param, message string) FieldError {
 	}
 }
 
+// WithPrefix returns a copy of the error with prefix prepended to every
+// FieldPath. Use it when the validated struct is bound to a config namespace,
+// so paths read "server.port" rather than "port".
+func (ve ValidationError) WithPrefix(prefix string) ValidationError {
+	if prefix == "" {
+		return ve
+	}
+	errs := make([]FieldError, len(ve.Errors))
+	for i, e := range ve.Errors {
+		if e.FieldPath != "" {
+			e.FieldPath = prefix + "." + e.FieldPath
+		} else {
+			e.FieldPath = prefix
+		}
+		errs[i] = e
+	}
+	return ValidationError{Errors: errs}
+}
+
 // NewValidationError creates a ValidationError from a slice of FieldErrors.
 func NewValidationError(errs []FieldError) ValidationError {
 	return ValidationError{Errors: errs}
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"encoding"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 
@@ -21,6 +25,13 @@ type Manager struct {
 	profileEnv  string
 	defaults    map[string]any
 	configFile  string // explicit config file path (if set, ignores search paths)
+	precedence  []Source
+	deprecated  map[string]Deprecation // old key -> replacement and message
+	logger      *slog.Logger
+
+	// flags and envVars track bound sources per key for ApplyPrecedence.
+	flags   map[string]*pflag.Flag
+	envVars map[string]string
 }
 
 // New creates a new Manager with the given options.
@@ -103,6 +114,12 @@ func NewWithBackend(backend Backend, opts ...Option) *Manager {
 // This method configures the backend and reads the config file, but does not
 // unmarshal into a target struct. Use LoadInto for combined load + unmarshal.
 func (m *Manager) Load() error {
+	if m.precedence != nil {
+		if err := validatePrecedence(m.precedence); err != nil {
+			return err
+		}
+	}
+
 	// Handle explicit config file path vs search paths
 	if m.configFile != "" {
 		// Use explicit config file path if backend supports it
@@ -140,7 +157,7 @@ func (m *Manager) Load() error {
 	if cr, ok := m.backend.(configReader); ok {
 		if err := cr.ReadInConfig(); err != nil {
 			if !isConfigFileNotFoundError(cr, err) {
-				return fmt.Errorf("config: failed to read config file: %w", err)
+				return m.loadError(LoadStageRead, fmt.Errorf("config: failed to read config file: %w", err))
 			}
 			// Config file not found is OK - can use defaults and env vars
 		}
@@ -151,7 +168,11 @@ func (m *Manager) Load() error {
 		}
 	}
 
-	return nil
+	if err := m.applyDeprecatedKeys(); err != nil {
+		return m.loadError(LoadStageRead, fmt.Errorf("config: remap deprecated keys: %w", err))
+	}
+
+	return m.ApplyPrecedence()
 }
 
 // LoadInto loads configuration from all sources and unmarshals into target.
@@ -187,7 +208,7 @@ func (m *Manager) LoadInto(target any) error {
 
 	// Unmarshal into target
 	if err := m.backend.Unmarshal(target); err != nil {
-		return fmt.Errorf("config: failed to unmarshal: %w", err)
+		return m.loadError(LoadStageUnmarshal, fmt.Errorf("config: failed to unmarshal: %w", err))
 	}
 
 	// Apply Defaulter interface
@@ -197,13 +218,13 @@ func (m *Manager) LoadInto(target any) error {
 
 	// Validate using struct tags
 	if err := ValidateStruct(target); err != nil {
-		return err // Already wrapped with ErrConfigValidation
+		return m.loadError(LoadStageValidate, err) // ValidationError keyed by config path
 	}
 
 	// Validate using Validator interface
 	if v, ok := target.(Validator); ok {
 		if err := v.Validate(); err != nil {
-			return fmt.Errorf("config: custom validation failed: %w", err)
+			return m.loadError(LoadStageValidate, fmt.Errorf("config: custom validation failed: %w", err))
 		}
 	}
 
@@ -241,12 +262,12 @@ func (m *Manager) LoadIntoStrict(target any) error {
 	// Use strict unmarshal if backend supports it
 	if su, ok := m.backend.(StrictUnmarshaler); ok {
 		if err := su.UnmarshalStrict(target); err != nil {
-			return fmt.Errorf("config: strict validation failed: %w", err)
+			return m.loadError(LoadStageUnmarshal, fmt.Errorf("config: strict validation failed: %w", err))
 		}
 	} else {
 		// Fallback to normal unmarshal
 		if err := m.backend.Unmarshal(target); err != nil {
-			return fmt.Errorf("config: failed to unmarshal: %w", err)
+			return m.loadError(LoadStageUnmarshal, fmt.Errorf("config: failed to unmarshal: %w", err))
 		}
 	}
 
@@ -257,25 +278,169 @@ func (m *Manager) LoadIntoStrict(target any) error {
 
 	// Validate using struct tags
 	if err := ValidateStruct(target); err != nil {
-		return err // Already wrapped with ErrConfigValidation
+		return m.loadError(LoadStageValidate, err) // ValidationError keyed by config path
 	}
 
 	// Validate using Validator interface
 	if v, ok := target.(Validator); ok {
 		if err := v.Validate(); err != nil {
-			return fmt.Errorf("config: custom validation failed: %w", err)
+			return m.loadError(LoadStageValidate, fmt.Errorf("config: custom validation failed: %w", err))
 		}
 	}
 
 	return nil
 }
 
+// LoadIntoSection loads configuration and unmarshals only the subtree under
+// key (e.g. "database") into target. This lets each subsystem own a small
+// config struct instead of sharing one large one. An empty key behaves like
+// LoadInto.
+//
+// Defaulter and Validator are applied to target as in LoadInto. Struct tag
+// validation errors report full config paths including the section, so a
+// failing "host" field in the "database" section is reported as
+// "database.host".
+//
+// Example:
+//
+//	var db DatabaseConfig
+//	if err := mgr.LoadIntoSection("database", &db); err != nil {
+//	    return err
+//	}
+func (m *Manager) LoadIntoSection(key string, target any) error {
+	if key == "" {
+		return m.LoadInto(target)
+	}
+	if target == nil {
+		return nil
+	}
+
+	// Bind struct env vars under the section before loading
+	if m.envPrefix != "" {
+		if eb, ok := m.backend.(EnvBinder); ok {
+			m.bindStructEnv(eb, target, key)
+		}
+	}
+
+	// Load from files/env
+	if err := m.Load(); err != nil {
+		return err
+	}
+
+	// Unmarshal the subtree into target
+	if err := m.backend.UnmarshalKey(key, target); err != nil {
+		return m.loadError(LoadStageUnmarshal, fmt.Errorf("config: failed to unmarshal section %s: %w", key, err))
+	}
+
+	// Apply Defaulter interface
+	if d, ok := target.(Defaulter); ok {
+		d.Default()
+	}
+
+	// Validate using struct tags, reporting paths from the config root
+	if err := ValidateStruct(target); err != nil {
+		if ve, ok := errors.AsType[ValidationError](err); ok {
+			return m.loadError(LoadStageValidate, prefixFieldPaths(ve, key))
+		}
+		return m.loadError(LoadStageValidate, err)
+	}
+
+	// Validate using Validator interface
+	if v, ok := target.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return m.loadError(LoadStageValidate, fmt.Errorf("config: custom validation failed for section %s: %w", key, err))
+		}
+	}
+
+	return nil
+}
+
+// loadError wraps err in a LoadError describing the files and backend
+// involved in the failed load.
+func (m *Manager) loadError(stage LoadStage, err error) *LoadError {
+	le := &LoadError{
+		Stage:   stage,
+		Files:   m.configFiles(),
+		Backend: fmt.Sprintf("%T", m.backend),
+		Err:     err,
+	}
+	if ve, ok := errors.AsType[ValidationError](err); ok && len(ve.Errors) > 0 {
+		le.Key = ve.Errors[0].FieldPath
+	}
+	return le
+}
+
+// configFiles returns the config file used by the backend, or the files
+// that were searched for when none was found.
+func (m *Manager) configFiles() []string {
+	if cu, ok := m.backend.(interface{ ConfigFileUsed() string }); ok {
+		if used := cu.ConfigFileUsed(); used != "" {
+			return []string{used}
+		}
+	}
+	if m.configFile != "" {
+		return []string{m.configFile}
+	}
+	files := make([]string, len(m.searchPaths))
+	for i, path := range m.searchPaths {
+		files[i] = filepath.Join(path, m.fileName+"."+m.fileType)
+	}
+	return files
+}
+
+// profileFiles returns the profile config file used by the backend, or the
+// profile files that were searched for when it is not known.
+func (m *Manager) profileFiles(profile string) []string {
+	name := m.fileName + "." + profile
+	if cu, ok := m.backend.(interface{ ConfigFileUsed() string }); ok {
+		used := cu.ConfigFileUsed()
+		if strings.TrimSuffix(filepath.Base(used), filepath.Ext(used)) == name {
+			return []string{used}
+		}
+	}
+	files := make([]string, len(m.searchPaths))
+	for i, path := range m.searchPaths {
+		files[i] = filepath.Join(path, name+"."+m.fileType)
+	}
+	return files
+}
+
+// prefixFieldPaths returns a copy of ve with section prepended to each
+// field's config path.
+func prefixFieldPaths(ve ValidationError, section string) ValidationError {
+	fieldErrors := make([]FieldError, len(ve.Errors))
+	for i, fe := range ve.Errors {
+		if fe.FieldPath != "" {
+			fe.FieldPath = strings.ToLower(section) + "." + fe.FieldPath
+		}
+		fieldErrors[i] = fe
+	}
+	return NewValidationError(fieldErrors)
+}
+
 // Backend returns the underlying Backend for direct access.
 // This is useful for advanced operations not covered by the Manager API.
 func (m *Manager) Backend() Backend {
 	return m.backend
 }
 
+// SetDefault registers a default value for a key. Defaults have the lowest
+// precedence and are overridden by config files, env vars, and flags.
+// It may be called before or after Load.
+func (m *Manager) SetDefault(key string, value any) {
+	m.backend.SetDefault(key, value)
+}
+
+// AllSettings returns the effective configuration as a nested map, merging
+// defaults, config files, env vars, and flags. It returns an empty map if the
+// backend cannot enumerate its keys.
+func (m *Manager) AllSettings() map[string]any {
+	if sl, ok := m.backend.(settingsLister); ok {
+		return sl.AllSettings()
+	}
+	return map[string]any{}
+}
+
 // BindFlags binds command line flags to the configuration.
 // This allows flag values to override config file and environment values.
 func (m *Manager) BindFlags(fs *pflag.FlagSet) error {
@@ -283,7 +448,24 @@ func (m *Manager) BindFlags(fs *pflag.FlagSet) error {
 		if err := fb.BindPFlags(fs); err != nil {
 			return fmt.Errorf("config: failed to bind flags: %w", err)
 		}
+		fs.VisitAll(func(f *pflag.Flag) {
+			m.trackFlag(f.Name, f)
+		})
+	}
+	return nil
+}
+
+// BindEnv binds an environment variable to a configuration key and tracks it
+// for precedence enforcement. It is a no-op if the backend cannot bind env vars.
+func (m *Manager) BindEnv(key, envVar string) error {
+	eb, ok := m.backend.(EnvBinder)
+	if !ok {
+		return nil
+	}
+	if err := eb.BindEnv(key, envVar); err != nil {
+		return fmt.Errorf("config: failed to bind env var %s for key %s: %w", envVar, key, err)
 	}
+	m.trackEnv(key, envVar)
 	return nil
 }
 
@@ -306,7 +488,9 @@ func (m *Manager) loadProfileConfig(cr configReader) error {
 	if mc, ok := cr.(configMerger); ok {
 		if err := mc.MergeInConfig(); err != nil {
 			if !isConfigFileNotFoundError(cr, err) {
-				return fmt.Errorf("config: failed to merge profile config: %w", err)
+				le := m.loadError(LoadStageRead, fmt.Errorf("config: failed to merge profile config: %w", err))
+				le.Files = m.profileFiles(profile)
+				return le
 			}
 		}
 	}
@@ -354,6 +538,7 @@ func (m *Manager) bindStructEnv(eb EnvBinder, target any, prefix string) {
 		} else {
 			// Bind the key so AutomaticEnv can find it
 			_ = eb.BindEnv(key)
+			m.trackEnv(key, structEnvVar(m.envPrefix, key))
 		}
 	}
 }
@@ -370,16 +555,74 @@ func (m *Manager) RegisterProviderFlags(namespace string, flags []ConfigFlag) er
 		}
 
 		// Bind env var with explicit name
-		if eb, ok := m.backend.(EnvBinder); ok {
-			envKey := strings.ToUpper(strings.ReplaceAll(fullKey, ".", "_"))
-			if err := eb.BindEnv(fullKey, envKey); err != nil {
-				return fmt.Errorf("config: failed to bind env var %s for key %s: %w", envKey, fullKey, err)
+		if err := m.BindEnv(fullKey, providerEnvVar(fullKey)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BindNamespaceEnv binds an environment variable for every field of the
+// struct target points to, under namespace, using the same names as
+// RegisterProviderFlags ("server.port" becomes SERVER_PORT). Binding makes
+// the keys visible to namespace unmarshaling even when no config file sets
+// them. Nested structs are walked; time.Time and other values decoded from
+// text are bound as a single key.
+func (m *Manager) BindNamespaceEnv(namespace string, target any) error {
+	t := reflect.TypeOf(target)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || !isNestedConfigStruct(t) {
+		return nil
+	}
+	return m.bindNamespaceEnv(t, namespace)
+}
+
+// bindNamespaceEnv binds the fields of struct type t under prefix.
+func (m *Manager) bindNamespaceEnv(t reflect.Type, prefix string) error {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("gaz"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		key := prefix + "." + name
+
+		if isNestedConfigStruct(field.Type) {
+			if err := m.bindNamespaceEnv(field.Type, key); err != nil {
+				return err
 			}
+			continue
+		}
+		if err := m.BindEnv(key, providerEnvVar(key)); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// textUnmarshalerType is the encoding.TextUnmarshaler interface type.
+//
+//nolint:gochecknoglobals // Cached reflect type
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// isNestedConfigStruct reports whether t is a struct whose fields are config
+// keys of their own, rather than a value such as time.Time that is decoded
+// from text as a whole.
+func isNestedConfigStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!t.Implements(textUnmarshalerType) &&
+		!reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
 // ValidateProviderFlags validates that required provider config flags are set.
 // Returns a slice of errors for all missing required fields (not fail-fast).
 func (m *Manager) ValidateProviderFlags(namespace string, flags []ConfigFlag) []error {
@@ -402,10 +645,13 @@ func (m *Manager) ValidateProviderFlags(namespace string, flags []ConfigFlag) []
 }
 
 // ConfigFlag represents a configuration flag for provider registration.
+// Type and Description are only used by DocumentEnvVars.
 type ConfigFlag struct {
-	Key      string
-	Default  any
-	Required bool
+	Key         string
+	Default     any
+	Required    bool
+	Type        string
+	Description string
 }
 
 // =============================================================================
@@ -434,6 +680,18 @@ type configMerger interface {
 	MergeInConfig() error
 }
 
+// configMapMerger is implemented by backends that can merge config maps at
+// config file precedence.
+type configMapMerger interface {
+	MergeConfigMap(cfg map[string]any) error
+}
+
+// configKeyChecker is implemented by backends that can tell whether a key
+// comes from the config file layer.
+type configKeyChecker interface {
+	InConfig(key string) bool
+}
+
 // flagBinder is implemented by backends that can bind pflags.
 type flagBinder interface {
 	BindPFlags(fs *pflag.FlagSet) error
@@ -446,6 +704,21 @@ type FlagBinder interface {
 	BindPFlag(key string, flag *pflag.Flag) error
 }
 
+// settingsLister is implemented by backends that can enumerate all settings.
+type settingsLister interface {
+	AllSettings() map[string]any
+}
+
+// configSnapshotter is implemented by backends that can read and replace
+// their config file values and the values set with Set, for Snapshot and
+// Restore.
+type configSnapshotter interface {
+	ConfigSettings() map[string]any
+	Overrides() map[string]any
+	ReplaceConfig(settings map[string]any) error
+	ReplaceOverrides(overrides map[string]any)
+}
+
 // configFileNotFoundChecker is implemented by backends that can check for file not found errors.
 type configFileNotFoundChecker interface {
 	IsConfigFileNotFoundError(err error) bool
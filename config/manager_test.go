@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -333,6 +334,102 @@ func TestLoadInto_ValidatesStructTags(t *testing.T) {
 	assert.ErrorIs(t, err, config.ErrConfigValidation)
 }
 
+func TestLoadInto_ValidationErrorFieldPath(t *testing.T) {
+	backend := cfgviper.New()
+	backend.Set("server.host", "localhost")
+	backend.Set("server.port", 0)
+
+	mgr := config.NewWithBackend(backend,
+		config.WithName("nonexistent"),
+		config.WithSearchPaths(t.TempDir()),
+	)
+
+	var cfg struct {
+		Server struct {
+			Host string `mapstructure:"host" validate:"required"`
+			Port int    `mapstructure:"port" validate:"min=1"`
+		} `mapstructure:"server"`
+	}
+	err := mgr.LoadInto(&cfg)
+	require.Error(t, err)
+
+	var ve config.ValidationError
+	require.ErrorAs(t, err, &ve)
+	require.Len(t, ve.Errors, 1)
+	assert.Equal(t, "server.port", ve.Errors[0].FieldPath)
+	assert.Equal(t, "must be at least 1", ve.Errors[0].Message)
+}
+
+func TestLoadInto_MalformedFileReturnsLoadError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("server:\n  host: [unclosed\n"), 0o600))
+
+	mgr := config.NewWithBackend(cfgviper.New(),
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+	)
+
+	var cfg struct{}
+	err := mgr.LoadInto(&cfg)
+	require.Error(t, err)
+
+	le, ok := errors.AsType[*config.LoadError](err)
+	require.True(t, ok, "expected *config.LoadError, got %T", err)
+	assert.Equal(t, config.LoadStageRead, le.Stage)
+	assert.Equal(t, []string{path}, le.Files)
+	assert.Equal(t, "*viper.Backend", le.Backend)
+	assert.Empty(t, le.Key)
+	assert.Contains(t, le.Err.Error(), "failed to read config file")
+	assert.Contains(t, err.Error(), path)
+}
+
+func TestLoad_MalformedProfileReturnsLoadError(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("host: basehost\n"), 0o600))
+	profilePath := filepath.Join(dir, "config.bad.yaml")
+	require.NoError(t, os.WriteFile(profilePath, []byte("host: [unclosed\n"), 0o600))
+	t.Setenv("CFG_PROFILE", "bad")
+
+	mgr := config.NewWithBackend(cfgviper.New(),
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+		config.WithProfileEnv("CFG_PROFILE"),
+	)
+
+	err := mgr.Load()
+	le, ok := errors.AsType[*config.LoadError](err)
+	require.True(t, ok, "expected *config.LoadError, got %T", err)
+	assert.Equal(t, []string{profilePath}, le.Files)
+	assert.True(t, strings.HasPrefix(err.Error(), "config: read "+profilePath+": failed to merge profile config"),
+		"unexpected message %q", err.Error())
+	assert.NotContains(t, err.Error(), "config: failed")
+}
+
+func TestLoadInto_ValidationFailureReturnsLoadError(t *testing.T) {
+	backend := cfgviper.New()
+	backend.Set("server.port", 0)
+
+	mgr := config.NewWithBackend(backend,
+		config.WithName("nonexistent"),
+		config.WithSearchPaths(t.TempDir()),
+	)
+
+	var cfg struct {
+		Server struct {
+			Port int `mapstructure:"port" validate:"min=1"`
+		} `mapstructure:"server"`
+	}
+	err := mgr.LoadInto(&cfg)
+	require.Error(t, err)
+	require.ErrorIs(t, err, config.ErrConfigValidation)
+
+	le, ok := errors.AsType[*config.LoadError](err)
+	require.True(t, ok, "expected *config.LoadError, got %T", err)
+	assert.Equal(t, config.LoadStageValidate, le.Stage)
+	assert.Equal(t, "server.port", le.Key)
+}
+
 func TestLoadInto_CallsCustomValidator(t *testing.T) {
 	backend := cfgviper.New()
 	backend.Set("port", -1)
@@ -376,6 +473,108 @@ func TestLoadInto_WithEnvVars_BindsToStruct(t *testing.T) {
 	assert.Equal(t, 9999, cfg.Port)
 }
 
+// =============================================================================
+// Test LoadIntoSection()
+// =============================================================================
+
+type sectionDatabaseConfig struct {
+	Host    string `mapstructure:"host" validate:"required"`
+	Port    int    `mapstructure:"port" validate:"min=1"`
+	Replica struct {
+		Host string `mapstructure:"host"`
+	} `mapstructure:"replica"`
+}
+
+func (c *sectionDatabaseConfig) Default() {
+	if c.Port == 0 {
+		c.Port = 5432
+	}
+}
+
+func newSectionManager(t *testing.T, content string, opts ...config.Option) *config.Manager {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return config.NewWithBackend(cfgviper.New(), append([]config.Option{config.WithConfigFile(path)}, opts...)...)
+}
+
+func TestLoadIntoSection_UnmarshalsSubtree(t *testing.T) {
+	mgr := newSectionManager(t, `
+server:
+  host: serverhost
+database:
+  host: dbhost
+  port: 6543
+  replica:
+    host: replicahost
+`)
+
+	var db sectionDatabaseConfig
+	require.NoError(t, mgr.LoadIntoSection("database", &db))
+
+	assert.Equal(t, "dbhost", db.Host)
+	assert.Equal(t, 6543, db.Port)
+	assert.Equal(t, "replicahost", db.Replica.Host)
+}
+
+func TestLoadIntoSection_AppliesDefaulter(t *testing.T) {
+	mgr := newSectionManager(t, "database:\n  host: dbhost\n")
+
+	var db sectionDatabaseConfig
+	require.NoError(t, mgr.LoadIntoSection("database", &db))
+
+	assert.Equal(t, "dbhost", db.Host)
+	assert.Equal(t, 5432, db.Port)
+}
+
+func TestLoadIntoSection_ValidationErrorReferencesSection(t *testing.T) {
+	mgr := newSectionManager(t, "database:\n  port: 5432\n")
+
+	var db sectionDatabaseConfig
+	err := mgr.LoadIntoSection("database", &db)
+	require.ErrorIs(t, err, config.ErrConfigValidation)
+
+	var ve config.ValidationError
+	require.ErrorAs(t, err, &ve)
+	require.Len(t, ve.Errors, 1)
+	assert.Equal(t, "database.host", ve.Errors[0].FieldPath)
+	assert.Contains(t, err.Error(), "database.host")
+}
+
+func TestLoadIntoSection_CustomValidatorErrorNamesSection(t *testing.T) {
+	mgr := newSectionManager(t, "limits:\n  port: -1\n")
+
+	var cfg customValidatorConfig
+	err := mgr.LoadIntoSection("limits", &cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "section limits")
+	assert.Contains(t, err.Error(), "port must be positive")
+}
+
+func TestLoadIntoSection_WithEnvVars_BindsUnderSection(t *testing.T) {
+	t.Setenv("SECTIONTEST_DATABASE__HOST", "envhost")
+
+	mgr := newSectionManager(t, "database:\n  host: filehost\n", config.WithEnvPrefix("SECTIONTEST"))
+
+	var db sectionDatabaseConfig
+	require.NoError(t, mgr.LoadIntoSection("database", &db))
+	assert.Equal(t, "envhost", db.Host)
+}
+
+func TestLoadIntoSection_EmptyKeyLoadsWholeConfig(t *testing.T) {
+	mgr := newSectionManager(t, "host: roothost\nport: 9000\n")
+
+	var cfg testConfig
+	require.NoError(t, mgr.LoadIntoSection("", &cfg))
+	assert.Equal(t, "roothost", cfg.Host)
+	assert.Equal(t, 9000, cfg.Port)
+}
+
+func TestLoadIntoSection_WithNilTarget_NoError(t *testing.T) {
+	mgr := config.NewWithBackend(cfgviper.New())
+	assert.NoError(t, mgr.LoadIntoSection("database", nil))
+}
+
 // =============================================================================
 // Test Backend()
 // =============================================================================
@@ -387,6 +586,74 @@ func TestBackend_ReturnsUnderlyingBackend(t *testing.T) {
 	assert.Same(t, backend, mgr.Backend())
 }
 
+// =============================================================================
+// Test SetDefault and AllSettings
+// =============================================================================
+
+func TestSetDefault_UsedWhenUnset(t *testing.T) {
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("nonexistent"),
+		config.WithSearchPaths(t.TempDir()),
+	)
+	mgr.SetDefault("server.port", 8080)
+
+	require.NoError(t, mgr.Load())
+	assert.Equal(t, 8080, backend.GetInt("server.port"))
+}
+
+func TestSetDefault_OverriddenByFileAndEnv(t *testing.T) {
+	t.Setenv("DEFTEST_SERVER__HOST", "envhost")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("server:\n  port: 9090\n"), 0o600))
+
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+		config.WithEnvPrefix("DEFTEST"),
+	)
+	mgr.SetDefault("server.port", 8080)
+	mgr.SetDefault("server.host", "localhost")
+
+	require.NoError(t, mgr.Load())
+	assert.Equal(t, 9090, backend.GetInt("server.port"))
+	assert.Equal(t, "envhost", backend.GetString("server.host"))
+}
+
+func TestAllSettings_IncludesAllSources(t *testing.T) {
+	t.Setenv("ALLTEST_LOG__LEVEL", "debug")
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("server:\n  port: 9090\n"), 0o600))
+
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+		config.WithEnvPrefix("ALLTEST"),
+	)
+	mgr.SetDefault("server.host", "localhost")
+	mgr.SetDefault("log.level", "info")
+
+	require.NoError(t, mgr.Load())
+
+	settings := mgr.AllSettings()
+	server, ok := settings["server"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "localhost", server["host"])
+	assert.Equal(t, 9090, server["port"])
+	logSettings, ok := settings["log"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "debug", logSettings["level"])
+}
+
+func TestAllSettings_UnsupportedBackend(t *testing.T) {
+	mgr := config.NewWithBackend(newMockBackend())
+	assert.Empty(t, mgr.AllSettings())
+}
+
 // =============================================================================
 // Test RegisterProviderFlags and ValidateProviderFlags
 // =============================================================================
@@ -696,3 +963,153 @@ func TestLoadIntoStrict_WithEnvPrefix_BindsEnvVars(t *testing.T) {
 
 	assert.Equal(t, "envhost", cfg.Host)
 }
+
+// =============================================================================
+// Snapshot / Restore / Reload
+// =============================================================================
+
+func TestSnapshotRestore_RevertsMergedValues(t *testing.T) {
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths("testdata"),
+	)
+	require.NoError(t, mgr.Load())
+
+	snap := mgr.Snapshot()
+	require.NoError(t, backend.MergeConfigMap(map[string]any{
+		"host":  "otherhost",
+		"extra": map[string]any{"enabled": true},
+	}))
+	require.Equal(t, "otherhost", backend.GetString("host"))
+
+	require.NoError(t, mgr.Restore(snap))
+	assert.Equal(t, "testhost", backend.GetString("host"))
+	assert.Equal(t, 9000, backend.GetInt("port"))
+	assert.Equal(t, 30*time.Second, backend.GetDuration("timeout"))
+	assert.False(t, backend.IsSet("extra.enabled"))
+}
+
+func TestSnapshotRestore_LeavesEnvAndDefaultsLive(t *testing.T) {
+	t.Setenv("SNAPTEST_HOST", "envhost")
+
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths("testdata"),
+		config.WithEnvPrefix("SNAPTEST"),
+		config.WithDefaults(map[string]any{"region": "eu"}),
+	)
+	require.NoError(t, mgr.Load())
+
+	snap := mgr.Snapshot()
+	assert.Equal(t, "testhost", snap.Settings()["host"])
+	assert.NotContains(t, snap.Settings(), "region")
+
+	require.NoError(t, backend.MergeConfigMap(map[string]any{"port": 9100}))
+	require.NoError(t, os.Unsetenv("SNAPTEST_HOST"))
+	require.NoError(t, mgr.Restore(snap))
+
+	// Neither the env value nor the default was frozen into the config layer.
+	assert.Equal(t, "testhost", backend.GetString("host"))
+	assert.Equal(t, 9000, backend.GetInt("port"))
+	assert.Equal(t, "eu", backend.GetString("region"))
+	assert.False(t, backend.InConfig("region"))
+}
+
+func TestSnapshotRestore_UnsetsLaterOverrides(t *testing.T) {
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths("testdata"),
+	)
+	require.NoError(t, mgr.Load())
+	backend.Set("port", 9100)
+
+	snap := mgr.Snapshot()
+	backend.Set("host", "sethost")
+	backend.Set("port", 9200)
+
+	require.NoError(t, mgr.Restore(snap))
+	assert.Equal(t, "testhost", backend.GetString("host"))
+	assert.Equal(t, 9100, backend.GetInt("port"))
+}
+
+func TestSnapshotRestore_WithoutConfigFile(t *testing.T) {
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend)
+	require.NoError(t, backend.MergeConfigMap(map[string]any{"a": 1}))
+
+	snap := mgr.Snapshot()
+	require.NoError(t, backend.MergeConfigMap(map[string]any{"a": 2, "b": 3}))
+
+	require.NoError(t, mgr.Restore(snap))
+	assert.Equal(t, 1, backend.GetInt("a"))
+	assert.False(t, backend.IsSet("b"))
+}
+
+func TestSnapshot_IsIndependentCopy(t *testing.T) {
+	backend := cfgviper.New()
+	backend.Set("server.host", "a")
+	mgr := config.NewWithBackend(backend)
+
+	snap := mgr.Snapshot()
+	backend.Set("server.host", "b")
+	settings := snap.Settings()
+	settings["server"].(map[string]any)["host"] = "c"
+
+	assert.Equal(t, map[string]any{"server": map[string]any{"host": "a"}}, snap.Settings())
+}
+
+func TestRestore_UnsupportedBackend(t *testing.T) {
+	mgr := config.NewWithBackend(newMockBackend())
+
+	err := mgr.Restore(mgr.Snapshot())
+	require.ErrorIs(t, err, config.ErrRestoreUnsupported)
+}
+
+type reloadConfig struct {
+	Port int `mapstructure:"port" validate:"min=1"`
+}
+
+func TestReload_KeepsLastGoodConfigOnFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("port: 8080\n")
+
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend,
+		config.WithName("config"),
+		config.WithSearchPaths(dir),
+	)
+	var cfg reloadConfig
+	require.NoError(t, mgr.LoadInto(&cfg))
+	require.Equal(t, 8080, cfg.Port)
+
+	// Invalid value: rejected, config and target roll back.
+	write("port: 0\n")
+	err := mgr.Reload(&cfg)
+	require.ErrorIs(t, err, config.ErrConfigValidation)
+	assert.Equal(t, 8080, cfg.Port)
+	assert.Equal(t, 8080, backend.GetInt("port"))
+
+	// Malformed file: rejected the same way.
+	write("port: [unclosed\n")
+	require.Error(t, mgr.Reload(&cfg))
+	assert.Equal(t, 8080, backend.GetInt("port"))
+
+	// A later valid file is still picked up after a restore.
+	write("port: 9090\n")
+	require.NoError(t, mgr.Reload(&cfg))
+	assert.Equal(t, 9090, cfg.Port)
+	assert.Equal(t, 9090, backend.GetInt("port"))
+}
+
+func TestReload_RequiresPointer(t *testing.T) {
+	mgr := config.NewWithBackend(cfgviper.New())
+	require.Error(t, mgr.Reload(reloadConfig{}))
+	require.Error(t, mgr.Reload((*reloadConfig)(nil)))
+}
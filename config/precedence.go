@@ -0,0 +1,208 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// ErrInvalidPrecedence is returned by Load when WithPrecedence was given an
+// order the Manager cannot enforce.
+var ErrInvalidPrecedence = errors.New("config: invalid precedence")
+
+// Source identifies a configuration layer.
+type Source int
+
+const (
+	// SourceFlag is a command line flag set explicitly by the user.
+	SourceFlag Source = iota
+	// SourceEnv is an environment variable.
+	SourceEnv
+	// SourceFile is a config file, including profile overlays.
+	SourceFile
+	// SourceDefault is a default value (WithDefaults, provider flag defaults).
+	SourceDefault
+)
+
+// String returns the name of the source.
+func (s Source) String() string {
+	switch s {
+	case SourceFlag:
+		return "flag"
+	case SourceEnv:
+		return "env"
+	case SourceFile:
+		return "file"
+	case SourceDefault:
+		return "default"
+	default:
+		return fmt.Sprintf("Source(%d)", int(s))
+	}
+}
+
+// DefaultPrecedence is the order used unless WithPrecedence is given:
+// flags beat environment variables, which beat config files, which beat defaults.
+var DefaultPrecedence = []Source{SourceFlag, SourceEnv, SourceFile, SourceDefault}
+
+// WithPrecedence sets the order in which configuration sources win, highest
+// first. Flags and environment variables may be ordered either way, but both
+// must rank above SourceFile, which must rank above SourceDefault:
+//
+//	config.WithPrecedence(config.SourceEnv, config.SourceFlag, config.SourceFile, config.SourceDefault)
+//
+// Load returns ErrInvalidPrecedence for any other order.
+func WithPrecedence(order ...Source) Option {
+	return func(m *Manager) {
+		m.precedence = slices.Clone(order)
+	}
+}
+
+// validatePrecedence checks that order is an enforceable permutation.
+func validatePrecedence(order []Source) error {
+	valid := len(order) == len(DefaultPrecedence) &&
+		order[2] == SourceFile && order[3] == SourceDefault &&
+		slices.Contains(order[:2], SourceFlag) && slices.Contains(order[:2], SourceEnv)
+	if !valid {
+		return fmt.Errorf("%w: %v: flag and env must come first, then file, then default", ErrInvalidPrecedence, order)
+	}
+	return nil
+}
+
+// BindPFlag binds a single flag to a configuration key and tracks it for
+// precedence enforcement.
+func (m *Manager) BindPFlag(key string, flag *pflag.Flag) error {
+	if flag == nil {
+		return nil
+	}
+	fb, ok := m.backend.(FlagBinder)
+	if !ok {
+		return nil
+	}
+	if err := fb.BindPFlag(key, flag); err != nil {
+		return fmt.Errorf("config: failed to bind flag %s: %w", flag.Name, err)
+	}
+	m.trackFlag(key, flag)
+	return nil
+}
+
+// BindFlagSet binds every known configuration key to the flag in fs with the
+// matching name, so explicitly set flags override config files and environment
+// variables without requiring cobra. A key matches a flag named after it with
+// dots and underscores replaced by dashes ("server.read_timeout" matches
+// --server-read-timeout), or a flag named exactly like the key.
+//
+// Known keys are those registered with RegisterProviderFlags or BindEnv, set
+// as defaults, or loaded from a config file. Flags that are not set on the
+// command line never override existing values. Call BindFlagSet after keys are
+// registered and, for file keys, after Load; then call ApplyPrecedence once
+// flags are parsed.
+func (m *Manager) BindFlagSet(fs *pflag.FlagSet) error {
+	for _, key := range m.knownKeys() {
+		flag := fs.Lookup(flagNameForKey(key))
+		if flag == nil {
+			flag = fs.Lookup(key)
+		}
+		if err := m.BindPFlag(key, flag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// knownKeys returns the sorted keys with a registered env var or a value in
+// the backend's settings.
+func (m *Manager) knownKeys() []string {
+	seen := make(map[string]struct{}, len(m.envVars))
+	for key := range m.envVars {
+		seen[key] = struct{}{}
+	}
+	collectKeys(m.AllSettings(), "", seen)
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// collectKeys adds the dotted leaf keys of settings to seen.
+func collectKeys(settings map[string]any, prefix string, seen map[string]struct{}) {
+	for k, v := range settings {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			collectKeys(nested, key, seen)
+			continue
+		}
+		seen[key] = struct{}{}
+	}
+}
+
+// flagNameForKey returns the conventional flag name for a config key.
+func flagNameForKey(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
+}
+
+// trackFlag records the flag bound to key.
+func (m *Manager) trackFlag(key string, flag *pflag.Flag) {
+	if m.flags == nil {
+		m.flags = make(map[string]*pflag.Flag)
+	}
+	m.flags[key] = flag
+}
+
+// trackEnv records the environment variable bound to key.
+func (m *Manager) trackEnv(key, envVar string) {
+	if m.envVars == nil {
+		m.envVars = make(map[string]string)
+	}
+	m.envVars[key] = envVar
+}
+
+// ApplyPrecedence resolves keys that are set both by an explicitly changed
+// flag and by an environment variable, writing the winner according to the
+// configured precedence. Call it after flags are parsed; Load and the gaz
+// cobra integration call it automatically.
+//
+// Keys set by only one of the two follow the backend's native order, which
+// already ranks both above files and defaults.
+func (m *Manager) ApplyPrecedence() error {
+	order := m.precedence
+	if order == nil {
+		order = DefaultPrecedence
+	}
+	if err := validatePrecedence(order); err != nil {
+		return err
+	}
+	envFirst := order[0] == SourceEnv
+
+	for key, flag := range m.flags {
+		if !flag.Changed {
+			continue
+		}
+		envVar, ok := m.envVars[key]
+		if !ok {
+			continue
+		}
+		envValue, ok := os.LookupEnv(envVar)
+		if !ok {
+			continue
+		}
+		if envFirst {
+			m.backend.Set(key, envValue)
+		} else {
+			m.backend.Set(key, flagValue(flag))
+		}
+	}
+	return nil
+}
+
+// flagValue returns the typed value of a flag where pflag exposes one.
+func flagValue(flag *pflag.Flag) any {
+	if sv, ok := flag.Value.(pflag.SliceValue); ok {
+		return sv.GetSlice()
+	}
+	return flag.Value.String()
+}
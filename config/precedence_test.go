@@ -0,0 +1,169 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz/config"
+	cfgviper "github.com/petabytecl/gaz/config/viper"
+)
+
+// precedenceFixture sets up one key ("server.port") that each layer may set.
+type precedenceFixture struct {
+	file, env, flag bool
+	order           []config.Source
+}
+
+func (f precedenceFixture) load(t *testing.T) (*cfgviper.Backend, error) {
+	t.Helper()
+
+	dir := t.TempDir()
+	if f.file {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("server:\n  port: 2000\n"), 0o600))
+	}
+	if f.env {
+		t.Setenv("SERVER_PORT", "3000")
+	}
+
+	opts := []config.Option{config.WithName("config"), config.WithSearchPaths(dir)}
+	if f.order != nil {
+		opts = append(opts, config.WithPrecedence(f.order...))
+	}
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend, opts...)
+
+	if err := mgr.RegisterProviderFlags("server", []config.ConfigFlag{{Key: "port", Default: 1000}}); err != nil {
+		return nil, err
+	}
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Int("server-port", 1000, "port")
+	if err := mgr.BindPFlag("server.port", fs.Lookup("server-port")); err != nil {
+		return nil, err
+	}
+	if f.flag {
+		require.NoError(t, fs.Parse([]string{"--server-port=4000"}))
+	}
+
+	return backend, mgr.Load()
+}
+
+func TestPrecedence_DefaultOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture precedenceFixture
+		want    int
+	}{
+		{name: "default", fixture: precedenceFixture{}, want: 1000},
+		{name: "file beats default", fixture: precedenceFixture{file: true}, want: 2000},
+		{name: "env beats file", fixture: precedenceFixture{file: true, env: true}, want: 3000},
+		{name: "flag beats env", fixture: precedenceFixture{file: true, env: true, flag: true}, want: 4000},
+		{name: "flag beats file", fixture: precedenceFixture{file: true, flag: true}, want: 4000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := tt.fixture.load(t)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, backend.GetInt("server.port"))
+		})
+	}
+}
+
+func TestPrecedence_EnvBeforeFlag(t *testing.T) {
+	order := []config.Source{config.SourceEnv, config.SourceFlag, config.SourceFile, config.SourceDefault}
+
+	tests := []struct {
+		name    string
+		fixture precedenceFixture
+		want    int
+	}{
+		{name: "env beats flag", fixture: precedenceFixture{file: true, env: true, flag: true, order: order}, want: 3000},
+		{name: "flag still beats file", fixture: precedenceFixture{file: true, flag: true, order: order}, want: 4000},
+		{name: "env beats file", fixture: precedenceFixture{file: true, env: true, order: order}, want: 3000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := tt.fixture.load(t)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, backend.GetInt("server.port"))
+		})
+	}
+}
+
+func TestPrecedence_Invalid(t *testing.T) {
+	fixture := precedenceFixture{order: []config.Source{config.SourceFile, config.SourceFlag, config.SourceEnv, config.SourceDefault}}
+
+	_, err := fixture.load(t)
+	require.ErrorIs(t, err, config.ErrInvalidPrecedence)
+}
+
+func TestSource_String(t *testing.T) {
+	assert.Equal(t, "flag", config.SourceFlag.String())
+	assert.Equal(t, "env", config.SourceEnv.String())
+	assert.Equal(t, "file", config.SourceFile.String())
+	assert.Equal(t, "default", config.SourceDefault.String())
+	assert.Equal(t, "Source(9)", config.Source(9).String())
+}
+
+// bindFlagSetFixture loads a file setting server.port and server.host, with
+// SERVER_PORT bound as an env var, then binds fs and parses args.
+func bindFlagSetFixture(t *testing.T, env bool, args ...string) *cfgviper.Backend {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"),
+		[]byte("server:\n  port: 2000\n  host: filehost\n  read_timeout: 5s\n"), 0o600))
+	if env {
+		t.Setenv("SERVER_PORT", "3000")
+	}
+
+	backend := cfgviper.New()
+	mgr := config.NewWithBackend(backend, config.WithName("config"), config.WithSearchPaths(dir))
+	require.NoError(t, mgr.RegisterProviderFlags("server", []config.ConfigFlag{{Key: "port", Default: 1000}}))
+	require.NoError(t, mgr.Load())
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Int("server-port", 1000, "port")
+	fs.String("server-host", "flaghost", "host")
+	fs.String("server-read-timeout", "1s", "read timeout")
+	require.NoError(t, mgr.BindFlagSet(fs))
+	require.NoError(t, fs.Parse(args))
+	require.NoError(t, mgr.ApplyPrecedence())
+
+	return backend
+}
+
+func TestBindFlagSet_FlagOverridesFile(t *testing.T) {
+	backend := bindFlagSetFixture(t, false, "--server-port=4000", "--server-read-timeout=9s")
+
+	assert.Equal(t, 4000, backend.GetInt("server.port"))
+	assert.Equal(t, "9s", backend.GetString("server.read_timeout"))
+}
+
+func TestBindFlagSet_FlagOverridesEnv(t *testing.T) {
+	backend := bindFlagSetFixture(t, true, "--server-port=4000")
+
+	assert.Equal(t, 4000, backend.GetInt("server.port"))
+}
+
+func TestBindFlagSet_UnsetFlagsKeepExistingValues(t *testing.T) {
+	backend := bindFlagSetFixture(t, true)
+
+	assert.Equal(t, 3000, backend.GetInt("server.port"), "env must win over an unset flag")
+	assert.Equal(t, "filehost", backend.GetString("server.host"), "file must win over an unset flag")
+	assert.Equal(t, "5s", backend.GetString("server.read_timeout"))
+}
+
+func TestBindFlagSet_IgnoresUnmatchedFlags(t *testing.T) {
+	mgr := config.NewWithBackend(cfgviper.New())
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Bool("verbose", false, "verbose")
+
+	require.NoError(t, mgr.BindFlagSet(fs))
+}
@@ -0,0 +1,267 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema draft emitted by GenerateSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is a JSON Schema document describing a config struct.
+// Only the keywords GenerateSchema emits are modeled.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64               `json:"exclusiveMaximum,omitempty"`
+	MinLength            *uint64                `json:"minLength,omitempty"`
+	MaxLength            *uint64                `json:"maxLength,omitempty"`
+	MinItems             *uint64                `json:"minItems,omitempty"`
+	MaxItems             *uint64                `json:"maxItems,omitempty"`
+}
+
+// GenerateSchema reflects a config struct into a JSON Schema so editors can
+// validate config files against it. Keys follow the same gaz -> mapstructure
+// -> json tag priority used for validation error paths, lowercased to match
+// viper's key normalization. Validate tags map onto schema keywords:
+//
+//   - required: the key is listed in the parent's "required"
+//   - min, max, len, gte, lte: minimum/maximum for numbers, minLength/maxLength
+//     for strings, minItems/maxItems for slices
+//   - gt, lt: exclusiveMinimum/exclusiveMaximum for numbers
+//   - oneof: enum
+//   - email, url, ipv4, ipv6: format
+//
+// Nested structs produce nested object schemas; time.Duration is a string
+// such as "5s". cfg may be a struct or a pointer to one.
+//
+// Example:
+//
+//	schema, err := config.GenerateSchema(&AppConfig{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	os.WriteFile("config.schema.json", schema, 0o644)
+func GenerateSchema(cfg any) ([]byte, error) {
+	t := reflect.TypeOf(cfg)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: got %v", ErrInvalidSchemaTarget, reflect.TypeOf(cfg))
+	}
+
+	root := schemaFor(t, map[reflect.Type]bool{})
+	root.Schema = jsonSchemaDialect
+
+	out, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("config: encode schema: %w", err)
+	}
+	return out, nil
+}
+
+// durationType is special-cased because viper decodes durations from strings.
+//
+//nolint:gochecknoglobals // Cached reflect type
+var durationType = reflect.TypeFor[time.Duration]()
+
+// schemaFor builds the schema for a Go type. visiting guards against
+// recursive struct types, which are emitted as an untyped object.
+func schemaFor(t reflect.Type, visiting map[reflect.Type]bool) *jsonSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == durationType {
+		return &jsonSchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &jsonSchema{Type: "array", Items: schemaFor(t.Elem(), visiting)}
+	case reflect.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: schemaFor(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return &jsonSchema{Type: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		s := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}}
+		addFields(s, t, visiting)
+		return s
+	default:
+		// Interfaces and other kinds accept any value.
+		return &jsonSchema{}
+	}
+}
+
+// addFields adds the exported fields of struct type t to s, inlining
+// embedded structs tagged with ",squash" as mapstructure does.
+func addFields(s *jsonSchema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i := range t.NumField() {
+		fld := t.Field(i)
+		if !fld.IsExported() || isSkippedField(fld) {
+			continue
+		}
+		if fld.Anonymous && isSquashed(fld) {
+			ft := fld.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addFields(s, ft, visiting)
+				continue
+			}
+		}
+
+		name := strings.ToLower(configKeyName(fld))
+		prop := schemaFor(fld.Type, visiting)
+		if applyValidateTag(prop, fld.Tag.Get("validate")) {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = prop
+	}
+}
+
+// isSkippedField reports whether a field is excluded with a "-" config tag.
+func isSkippedField(fld reflect.StructField) bool {
+	for _, key := range []string{"gaz", "mapstructure"} {
+		if name, _, _ := strings.Cut(fld.Tag.Get(key), ","); name != "" {
+			return name == "-"
+		}
+	}
+	return false
+}
+
+// isSquashed reports whether an embedded field's config tag has ",squash".
+func isSquashed(fld reflect.StructField) bool {
+	for _, key := range []string{"gaz", "mapstructure"} {
+		_, opts, _ := strings.Cut(fld.Tag.Get(key), ",")
+		if opts == "squash" {
+			return true
+		}
+	}
+	return false
+}
+
+// applyValidateTag maps validate tag rules onto s and reports whether the
+// field is required. Rules after "dive" apply to elements and are ignored.
+func applyValidateTag(s *jsonSchema, tag string) bool {
+	required := false
+	for rule := range strings.SplitSeq(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "dive":
+			return required
+		case "required":
+			required = true
+		case "min", "gte":
+			setLowerBound(s, param, false)
+		case "max", "lte":
+			setUpperBound(s, param, false)
+		case "gt":
+			setLowerBound(s, param, true)
+		case "lt":
+			setUpperBound(s, param, true)
+		case "len":
+			setLowerBound(s, param, false)
+			setUpperBound(s, param, false)
+		case "oneof":
+			s.Enum = enumValues(s.Type, param)
+		case "email":
+			s.Format = "email"
+		case "url":
+			s.Format = "uri"
+		case "ipv4", "ipv6":
+			s.Format = name
+		}
+	}
+	return required
+}
+
+// setLowerBound applies a min-style rule. Numbers get a (possibly exclusive)
+// minimum; strings and arrays get a length bound.
+func setLowerBound(s *jsonSchema, param string, exclusive bool) {
+	switch s.Type {
+	case "integer", "number":
+		if v, err := strconv.ParseFloat(param, 64); err == nil {
+			if exclusive {
+				s.ExclusiveMinimum = &v
+			} else {
+				s.Minimum = &v
+			}
+		}
+	case "string":
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil && !exclusive {
+			s.MinLength = &n
+		}
+	case "array":
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil && !exclusive {
+			s.MinItems = &n
+		}
+	}
+}
+
+// setUpperBound applies a max-style rule, mirroring setLowerBound.
+func setUpperBound(s *jsonSchema, param string, exclusive bool) {
+	switch s.Type {
+	case "integer", "number":
+		if v, err := strconv.ParseFloat(param, 64); err == nil {
+			if exclusive {
+				s.ExclusiveMaximum = &v
+			} else {
+				s.Maximum = &v
+			}
+		}
+	case "string":
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil && !exclusive {
+			s.MaxLength = &n
+		}
+	case "array":
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil && !exclusive {
+			s.MaxItems = &n
+		}
+	}
+}
+
+// enumValues converts a space-separated oneof parameter into typed values,
+// so numeric enums compare correctly against numeric config values.
+func enumValues(typ, param string) []any {
+	fields := strings.Fields(param)
+	values := make([]any, 0, len(fields))
+	for _, f := range fields {
+		switch typ {
+		case "integer", "number":
+			if v, err := strconv.ParseFloat(f, 64); err == nil {
+				values = append(values, v)
+				continue
+			}
+		}
+		values = append(values, f)
+	}
+	return values
+}
@@ -0,0 +1,96 @@
+package config_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz/config"
+)
+
+type schemaDatabaseConfig struct {
+	Host    string        `mapstructure:"host" validate:"required"`
+	Pool    int           `mapstructure:"pool" validate:"min=1,max=100"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type schemaAppConfig struct {
+	Name     string               `gaz:"name" validate:"required,min=3,max=32"`
+	Mode     string               `gaz:"mode" validate:"oneof=dev prod"`
+	Ratio    float64              `gaz:"ratio" validate:"gt=0,lt=1"`
+	Tags     []string             `gaz:"tags" validate:"max=5,dive,required"`
+	Labels   map[string]string    `gaz:"labels"`
+	Debug    bool                 `gaz:"debug"`
+	Database schemaDatabaseConfig `gaz:"database" validate:"required"`
+	Internal string               `gaz:"-"`
+}
+
+// generateSchema decodes the generated schema into a generic map.
+func generateSchema(t *testing.T, cfg any) map[string]any {
+	t.Helper()
+	raw, err := config.GenerateSchema(cfg)
+	require.NoError(t, err)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(raw, &schema))
+	return schema
+}
+
+func TestGenerateSchema_Required(t *testing.T) {
+	schema := generateSchema(t, &schemaAppConfig{})
+
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+	assert.Equal(t, "object", schema["type"])
+	assert.ElementsMatch(t, []any{"name", "database"}, schema["required"])
+
+	props := schema["properties"].(map[string]any)
+	assert.NotContains(t, props, "internal")
+	assert.Equal(t, "boolean", props["debug"].(map[string]any)["type"])
+	assert.Equal(t, "object", props["labels"].(map[string]any)["type"])
+}
+
+func TestGenerateSchema_Constraints(t *testing.T) {
+	props := generateSchema(t, schemaAppConfig{})["properties"].(map[string]any)
+
+	name := props["name"].(map[string]any)
+	assert.Equal(t, "string", name["type"])
+	assert.InDelta(t, 3, name["minLength"], 0)
+	assert.InDelta(t, 32, name["maxLength"], 0)
+
+	assert.Equal(t, []any{"dev", "prod"}, props["mode"].(map[string]any)["enum"])
+
+	ratio := props["ratio"].(map[string]any)
+	assert.InDelta(t, 0, ratio["exclusiveMinimum"], 0)
+	assert.InDelta(t, 1, ratio["exclusiveMaximum"], 0)
+
+	tags := props["tags"].(map[string]any)
+	assert.Equal(t, "array", tags["type"])
+	assert.InDelta(t, 5, tags["maxItems"], 0)
+	assert.Equal(t, "string", tags["items"].(map[string]any)["type"])
+}
+
+func TestGenerateSchema_NestedStruct(t *testing.T) {
+	props := generateSchema(t, &schemaAppConfig{})["properties"].(map[string]any)
+
+	db := props["database"].(map[string]any)
+	assert.Equal(t, "object", db["type"])
+	assert.Equal(t, []any{"host"}, db["required"])
+
+	dbProps := db["properties"].(map[string]any)
+	pool := dbProps["pool"].(map[string]any)
+	assert.Equal(t, "integer", pool["type"])
+	assert.InDelta(t, 1, pool["minimum"], 0)
+	assert.InDelta(t, 100, pool["maximum"], 0)
+	assert.Equal(t, "string", dbProps["timeout"].(map[string]any)["type"])
+}
+
+func TestGenerateSchema_RejectsNonStruct(t *testing.T) {
+	_, err := config.GenerateSchema("not a struct")
+	require.ErrorIs(t, err, config.ErrInvalidSchemaTarget)
+
+	_, err = config.GenerateSchema(nil)
+	require.ErrorIs(t, err, config.ErrInvalidSchemaTarget)
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// ConfigSnapshot is a copy of a Manager's config file values and values set
+// with Set, taken by Manager.Snapshot. The zero value holds no settings.
+type ConfigSnapshot struct {
+	config    map[string]any
+	overrides map[string]any
+}
+
+// Settings returns a copy of the snapshotted settings as a nested map, with
+// values set with Set taking precedence over config file values.
+func (s ConfigSnapshot) Settings() map[string]any {
+	out := copySettings(s.config)
+	for key, value := range s.overrides {
+		setNested(out, strings.Split(key, "."), copySetting(value))
+	}
+	return out
+}
+
+// Snapshot captures the values read from config files, merged with
+// MergeConfigMap, and set with Set, so they can be put back with Restore.
+// Defaults, environment variables, and flags are not captured: they keep
+// applying live, so an env var changed after the snapshot still takes
+// effect after a Restore. The snapshot is a deep copy; later changes to the
+// Manager do not affect it.
+//
+// If the backend does not support snapshots, the snapshot is empty and
+// Restore returns ErrRestoreUnsupported.
+//
+// Example:
+//
+//	snap := mgr.Snapshot()
+//	defer mgr.Restore(snap)
+func (m *Manager) Snapshot() ConfigSnapshot {
+	s, ok := m.backend.(configSnapshotter)
+	if !ok {
+		return ConfigSnapshot{}
+	}
+	return ConfigSnapshot{
+		config:    copySettings(s.ConfigSettings()),
+		overrides: copySettings(s.Overrides()),
+	}
+}
+
+// Restore puts back the config file values and values set with Set captured
+// in snap, undoing config files read, maps merged with MergeConfigMap, and
+// values set since the snapshot. Keys set with Set after the snapshot are
+// unset, so their config file, env var, flag, or default value applies
+// again.
+//
+// It returns ErrRestoreUnsupported if the backend cannot replace its values.
+func (m *Manager) Restore(snap ConfigSnapshot) error {
+	s, ok := m.backend.(configSnapshotter)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrRestoreUnsupported, m.backend)
+	}
+	if err := s.ReplaceConfig(copySettings(snap.config)); err != nil {
+		return err
+	}
+	s.ReplaceOverrides(copySettings(snap.overrides))
+	return nil
+}
+
+// Reload reloads configuration into target like LoadInto, for example from a
+// SIGHUP handler or a config file watcher. If loading, unmarshaling, or
+// validation fails, the Manager is restored to its settings before the call
+// and target keeps its previous value, so the application keeps the
+// last-good config. target must be a non-nil pointer.
+//
+// Example:
+//
+//	gaz.WithReloadHandler(func(ctx context.Context) {
+//	    if err := mgr.Reload(&cfg); err != nil {
+//	        logger.ErrorContext(ctx, "config reload rejected", "error", err)
+//	    }
+//	})
+func (m *Manager) Reload(target any) error {
+	tv := reflect.ValueOf(target)
+	if tv.Kind() != reflect.Pointer || tv.IsNil() {
+		return fmt.Errorf("config: Reload target must be a non-nil pointer, got %T", target)
+	}
+
+	snap := m.Snapshot()
+	next := reflect.New(tv.Type().Elem())
+	next.Elem().Set(tv.Elem())
+	if err := m.LoadInto(next.Interface()); err != nil {
+		if restoreErr := m.Restore(snap); restoreErr != nil {
+			return errors.Join(err, restoreErr)
+		}
+		return err
+	}
+	tv.Elem().Set(next.Elem())
+	return nil
+}
+
+// setNested sets value at path in the nested settings map, replacing
+// non-map values along the way.
+func setNested(settings map[string]any, path []string, value any) {
+	for _, part := range path[:len(path)-1] {
+		next, ok := settings[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			settings[part] = next
+		}
+		settings = next
+	}
+	settings[path[len(path)-1]] = value
+}
+
+// copySettings deep-copies the nested maps and slices of a settings map.
+func copySettings(settings map[string]any) map[string]any {
+	out := make(map[string]any, len(settings))
+	for k, v := range settings {
+		out[k] = copySetting(v)
+	}
+	return out
+}
+
+func copySetting(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return copySettings(v)
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = copySetting(e)
+		}
+		return out
+	case []string:
+		return append([]string(nil), v...)
+	case map[string]string:
+		return maps.Clone(v)
+	default:
+		return v
+	}
+}
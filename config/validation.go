@@ -22,28 +22,32 @@ func newConfigValidator() *validator.Validate {
 	// Register tag name function to use gaz tags for field names in error messages.
 	// Priority: gaz -> mapstructure -> json -> Go field name.
 	// This ensures validation errors reference the config key name users expect.
-	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		// Try gaz tag first (our custom config tag)
-		name, _, _ := strings.Cut(fld.Tag.Get("gaz"), ",")
-		if name != "-" && name != "" {
-			return name
-		}
-		// Fall back to mapstructure tag (for compatibility with older code)
-		name, _, _ = strings.Cut(fld.Tag.Get("mapstructure"), ",")
-		if name != "-" && name != "" {
-			return name
-		}
-		// Fall back to json tag
-		name, _, _ = strings.Cut(fld.Tag.Get("json"), ",")
-		if name != "-" && name != "" {
-			return name
-		}
-		return fld.Name
-	})
+	v.RegisterTagNameFunc(configKeyName)
 
 	return v
 }
 
+// configKeyName returns the config key name for a struct field.
+// Priority: gaz -> mapstructure -> json -> Go field name.
+func configKeyName(fld reflect.StructField) string {
+	// Try gaz tag first (our custom config tag)
+	name, _, _ := strings.Cut(fld.Tag.Get("gaz"), ",")
+	if name != "-" && name != "" {
+		return name
+	}
+	// Fall back to mapstructure tag (for compatibility with older code)
+	name, _, _ = strings.Cut(fld.Tag.Get("mapstructure"), ",")
+	if name != "-" && name != "" {
+		return name
+	}
+	// Fall back to json tag
+	name, _, _ = strings.Cut(fld.Tag.Get("json"), ",")
+	if name != "-" && name != "" {
+		return name
+	}
+	return fld.Name
+}
+
 // ValidateStruct validates a config struct using validate tags.
 // Returns nil if validation passes, or a ValidationErrors if validation fails.
 //
@@ -77,7 +81,7 @@ func ValidateStruct(cfg any) error {
 
 	// Handle validation errors
 	if validationErrors, ok := errors.AsType[validator.ValidationErrors](err); ok {
-		return formatValidationErrors(validationErrors)
+		return formatValidationErrors(validationErrors, reflect.Indirect(reflect.ValueOf(cfg)).Type().Name())
 	}
 
 	// Wrap unknown errors from validator
@@ -85,11 +89,13 @@ func ValidateStruct(cfg any) error {
 }
 
 // formatValidationErrors converts validator.ValidationErrors into our ValidationError type.
-func formatValidationErrors(errs validator.ValidationErrors) error {
+// root is the name of the validated struct type, empty for anonymous structs.
+func formatValidationErrors(errs validator.ValidationErrors, root string) error {
 	fieldErrors := make([]FieldError, 0, len(errs))
 	for _, e := range errs {
 		fieldErrors = append(fieldErrors, FieldError{
 			Namespace: e.Namespace(),
+			FieldPath: fieldPath(e.Namespace(), root),
 			Tag:       e.Tag(),
 			Param:     e.Param(),
 			Message:   humanizeTag(e.Tag(), e.Param()),
@@ -99,6 +105,22 @@ func formatValidationErrors(errs validator.ValidationErrors) error {
 	return NewValidationError(fieldErrors)
 }
 
+// fieldPath converts a validator namespace (e.g. "AppConfig.server.port")
+// into a config key path ("server.port"). Field names already come from the
+// gaz/mapstructure tags via the registered tag name func; the root struct name
+// is dropped and the result is lowercased to match viper's key normalization.
+// Anonymous root structs have no name in the namespace, so nothing is dropped.
+func fieldPath(namespace, root string) string {
+	if root == "" {
+		return strings.ToLower(namespace)
+	}
+	path, found := strings.CutPrefix(namespace, root+".")
+	if !found {
+		return ""
+	}
+	return strings.ToLower(path)
+}
+
 // humanizeTag converts validation tag names to human-readable messages.
 func humanizeTag(tag, param string) string {
 	switch tag {
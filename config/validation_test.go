@@ -179,6 +179,62 @@ func TestFieldError_String_WithoutTag(t *testing.T) {
 	assert.NotContains(t, s, "validate:")
 }
 
+func TestValidationError_WithPrefix(t *testing.T) {
+	t.Parallel()
+	ve := config.NewValidationError([]config.FieldError{
+		{Namespace: "ServerConfig.port", FieldPath: "port", Tag: "min", Param: "1"},
+	})
+
+	prefixed := ve.WithPrefix("server")
+
+	assert.Equal(t, "server.port", prefixed.Errors[0].FieldPath)
+	assert.Equal(t, "port", ve.Errors[0].FieldPath) // original unchanged
+}
+
+// =============================================================================
+// Test field paths
+// =============================================================================
+
+type fieldPathConfig struct {
+	Server struct {
+		Host string `mapstructure:"host" validate:"required"`
+		Port int    `mapstructure:"port" validate:"min=1"`
+	} `mapstructure:"server"`
+	LogLevel string `gaz:"log_level" validate:"required"`
+}
+
+func TestValidateStruct_FieldPath_UsesConfigKeys(t *testing.T) {
+	t.Parallel()
+	var cfg fieldPathConfig
+
+	err := config.ValidateStruct(&cfg)
+	require.Error(t, err)
+
+	var ve config.ValidationError
+	require.ErrorAs(t, err, &ve)
+	require.Len(t, ve.Errors, 3)
+
+	byPath := make(map[string]config.FieldError, len(ve.Errors))
+	for _, fe := range ve.Errors {
+		byPath[fe.FieldPath] = fe
+	}
+
+	require.Contains(t, byPath, "server.host")
+	assert.Equal(t, "required", byPath["server.host"].Tag)
+	assert.Equal(t, "required field cannot be empty", byPath["server.host"].Message)
+
+	require.Contains(t, byPath, "server.port")
+	assert.Equal(t, "min", byPath["server.port"].Tag)
+	assert.Equal(t, "1", byPath["server.port"].Param)
+	assert.Equal(t, "must be at least 1", byPath["server.port"].Message)
+
+	require.Contains(t, byPath, "log_level")
+
+	// Error message leads with the config key, not the Go struct name
+	assert.Contains(t, err.Error(), "server.port: must be at least 1")
+	assert.NotContains(t, err.Error(), "fieldPathConfig")
+}
+
 // =============================================================================
 // Test mapstructure tag name in error messages
 // =============================================================================
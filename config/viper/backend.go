@@ -1,14 +1,24 @@
 package viper
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
 
 	"github.com/petabytecl/gaz/config"
 )
@@ -21,23 +31,73 @@ var (
 	_ config.EnvBinder         = (*Backend)(nil)
 	_ config.FlagBinder        = (*Backend)(nil)
 	_ config.StrictUnmarshaler = (*Backend)(nil)
+	_ config.RemoteBackend     = (*Backend)(nil)
 )
 
+// ErrUnknownConfigFormat is returned by ReadInConfig and MergeInConfig when a
+// config file's extension does not identify its format and its content does
+// not parse as any of the sniffed formats. ReplaceConfig returns it when no
+// format is known and the new values cannot be patched in.
+var ErrUnknownConfigFormat = errors.New("config/viper: unknown config file format")
+
+// ErrMultiDocumentYAML is returned by ReadInConfig and MergeInConfig when a
+// YAML config file contains more than one document. Viper would otherwise
+// use the first document and silently ignore the rest; use a profile file
+// (MergeInConfig) to layer settings instead.
+var ErrMultiDocumentYAML = errors.New("config/viper: multi-document YAML config file")
+
+// sniffedConfigTypes are the formats tried, in order, when a config file's
+// extension does not identify its format. JSON is tried before YAML because
+// every JSON document is also valid YAML.
+//
+//nolint:gochecknoglobals // Fixed lookup order
+var sniffedConfigTypes = []string{"json", "yaml", "toml"}
+
 // Backend implements config.Backend, config.Watcher, config.Writer, and config.EnvBinder
 // using spf13/viper as the underlying configuration provider.
 type Backend struct {
 	v *viper.Viper
+
+	configType string // set by SetConfigType; disables content sniffing
+
+	mu       sync.Mutex
+	onChange func(event any) // last callback passed to OnConfigChange
+
+	// Viper does not expose its layers, so the config file layer (files
+	// read and maps merged through the Backend) and the values set with Set
+	// are mirrored here for ConfigSettings and Overrides.
+	file      *viper.Viper
+	overrides map[string]any
+
+	// Remote watching (see WatchRemoteConfig)
+	remotes        []remoteProvider
+	remoteInterval time.Duration
+	stopRemote     chan struct{}
+	remoteDone     chan struct{} // closed when the polling goroutine exits
+}
+
+// remoteProvider is a provider registered with AddRemoteProvider.
+type remoteProvider struct {
+	provider, endpoint, path string
 }
 
+// DefaultRemoteWatchInterval is how often WatchRemoteConfig polls the remote
+// providers unless SetRemoteWatchInterval changes it.
+const DefaultRemoteWatchInterval = 10 * time.Second
+
 // New creates a new ViperBackend with a fresh viper instance.
 func New() *Backend {
-	return &Backend{v: viper.New()}
+	return NewWithViper(viper.New())
 }
 
 // NewWithViper creates a Backend wrapping an existing viper instance.
 // This is useful for integrating with existing viper configurations.
+//
+// Config values loaded directly on v rather than through the Backend are
+// not part of ConfigSettings, so Manager.Restore drops them; load config
+// files through the Backend when using snapshots.
 func NewWithViper(v *viper.Viper) *Backend {
-	return &Backend{v: v}
+	return &Backend{v: v, file: viper.New(), overrides: make(map[string]any)}
 }
 
 // =============================================================================
@@ -77,6 +137,9 @@ func (b *Backend) GetFloat64(key string) float64 {
 // Set explicitly sets a value for a key.
 func (b *Backend) Set(key string, value any) {
 	b.v.Set(key, value)
+	b.mu.Lock()
+	b.overrides[strings.ToLower(key)] = value
+	b.mu.Unlock()
 }
 
 // SetDefault sets a default value for a key.
@@ -89,19 +152,30 @@ func (b *Backend) IsSet(key string) bool {
 	return b.v.IsSet(key)
 }
 
+// InConfig reports whether key is set by a config file or merged config map,
+// ignoring defaults, environment variables, and flags.
+func (b *Backend) InConfig(key string) bool {
+	return b.v.InConfig(key)
+}
+
 // Unmarshal unmarshals the entire config into a struct.
 func (b *Backend) Unmarshal(target any) error {
 	return b.v.Unmarshal(target)
 }
 
 // UnmarshalKey unmarshals a specific key into a struct.
+// Like UnmarshalKeyWithGazTag, the key's children are merged across all
+// sources, so env vars and flags bound to nested keys take effect.
 func (b *Backend) UnmarshalKey(key string, target any) error {
-	return b.v.UnmarshalKey(key, target)
+	return b.unmarshalMergedKey(key, target)
 }
 
-// gazDecoderOption configures mapstructure to use "gaz" struct tags.
+// gazDecoderOption configures mapstructure to use "gaz" struct tags, and to
+// decode strings into fields implementing encoding.TextUnmarshaler (such as
+// time.Time), which gaz binds as single keys.
 func gazDecoderOption(dc *mapstructure.DecoderConfig) {
 	dc.TagName = "gaz"
+	dc.DecodeHook = mapstructure.ComposeDecodeHookFunc(dc.DecodeHook, mapstructure.TextUnmarshallerHookFunc())
 }
 
 // strictDecoderOption configures mapstructure to error on unused keys.
@@ -116,8 +190,32 @@ func (b *Backend) UnmarshalWithGazTag(target any) error {
 }
 
 // UnmarshalKeyWithGazTag unmarshals a specific key using gaz struct tags.
+// Values for the key's children are merged across all sources (flags, env,
+// config file, defaults), so an env var bound to "server.port" overrides the
+// file value even when unmarshaling the "server" namespace.
 func (b *Backend) UnmarshalKeyWithGazTag(key string, target any) error {
-	return b.v.UnmarshalKey(key, target, gazDecoderOption)
+	return b.unmarshalMergedKey(key, target, gazDecoderOption)
+}
+
+// unmarshalMergedKey unmarshals the merged settings under key into target.
+// Leaf values are unmarshaled directly. For namespaces, viper's own
+// UnmarshalKey only sees the highest-precedence map stored at key, so the
+// values of the nested keys are looked up one by one instead.
+func (b *Backend) unmarshalMergedKey(key string, target any, opts ...viper.DecoderConfigOption) error {
+	switch b.v.Get(key).(type) {
+	case map[string]any, nil:
+	default:
+		return b.v.UnmarshalKey(key, target, opts...)
+	}
+	settings := b.nestedSettings(key)
+	if settings == nil {
+		return b.v.UnmarshalKey(key, target, opts...)
+	}
+	sub := viper.New()
+	if err := sub.MergeConfigMap(settings); err != nil {
+		return err
+	}
+	return sub.Unmarshal(target, opts...)
 }
 
 // UnmarshalStrict unmarshals config into target, failing if config contains
@@ -131,8 +229,46 @@ func (b *Backend) HasKey(key string) bool {
 	if b.v.IsSet(key) {
 		return true
 	}
-	// Check if it's a namespace with children (viper.Sub returns non-nil)
-	return b.v.Sub(key) != nil
+	// A namespace whose children are only set by flags or env vars
+	prefix := strings.ToLower(key) + "."
+	return slices.ContainsFunc(b.v.AllKeys(), func(k string) bool {
+		return strings.HasPrefix(k, prefix) && b.v.IsSet(k)
+	})
+}
+
+// nestedSettings returns the merged values of the keys nested under key as a
+// nested map, or nil if key has no nested keys with a value.
+func (b *Backend) nestedSettings(key string) map[string]any {
+	prefix := strings.ToLower(key) + "."
+	var settings map[string]any
+	for _, k := range b.v.AllKeys() {
+		rest, ok := strings.CutPrefix(k, prefix)
+		if !ok {
+			continue
+		}
+		value := b.v.Get(k)
+		if value == nil {
+			continue
+		}
+		if settings == nil {
+			settings = make(map[string]any)
+		}
+		setNestedKey(settings, strings.Split(rest, "."), value)
+	}
+	return settings
+}
+
+// setNestedKey sets value at path in the nested settings map.
+func setNestedKey(settings map[string]any, path []string, value any) {
+	for _, part := range path[:len(path)-1] {
+		next, ok := settings[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			settings[part] = next
+		}
+		settings = next
+	}
+	settings[path[len(path)-1]] = value
 }
 
 // =============================================================================
@@ -141,15 +277,174 @@ func (b *Backend) HasKey(key string) bool {
 
 // WatchConfig starts watching the config file for changes.
 func (b *Backend) WatchConfig() {
+	b.v.OnConfigChange(b.configChanged)
 	b.v.WatchConfig()
 }
 
 // OnConfigChange registers a callback that is called when config changes.
 // The event parameter is an fsnotify.Event.
+// Remote changes are reported with a RemoteChangeEvent instead.
 func (b *Backend) OnConfigChange(callback func(event any)) {
-	b.v.OnConfigChange(func(e fsnotify.Event) {
+	b.mu.Lock()
+	b.onChange = callback
+	b.mu.Unlock()
+	b.v.OnConfigChange(b.configChanged)
+}
+
+// configChanged runs after viper re-reads a watched config file. Viper
+// replaces its config layer with the file, so the mirror is re-read too.
+func (b *Backend) configChanged(e fsnotify.Event) {
+	settings, err := b.readConfigFile()
+	b.mu.Lock()
+	if err == nil {
+		b.file = newLayer(settings)
+	}
+	callback := b.onChange
+	b.mu.Unlock()
+	if callback != nil {
 		callback(e)
-	})
+	}
+}
+
+// =============================================================================
+// config.RemoteBackend implementation
+// =============================================================================
+
+// RemoteChangeEvent is passed to OnConfigChange callbacks when a watched
+// remote provider reports a change. The new values are already loaded.
+type RemoteChangeEvent struct{}
+
+// AddRemoteProvider adds a remote configuration source, such as
+// AddRemoteProvider("etcd3", "http://127.0.0.1:2379", "/config/app.json").
+// Providers are tried in the order they were added.
+//
+// Remote documents have no file extension, so call SetConfigType before
+// reading them.
+func (b *Backend) AddRemoteProvider(provider, endpoint, path string) error {
+	if err := b.v.AddRemoteProvider(provider, endpoint, path); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.remotes = append(b.remotes, remoteProvider{provider: provider, endpoint: endpoint, path: path})
+	b.mu.Unlock()
+	return nil
+}
+
+// ReadRemoteConfig loads configuration from the first remote provider that
+// responds. Remote values have lower precedence than config files.
+//
+// The default build has no provider clients; build with the gaz_remote tag
+// to enable them (see the package documentation).
+func (b *Backend) ReadRemoteConfig() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.v.ReadRemoteConfig()
+}
+
+// SetRemoteWatchInterval sets how often WatchRemoteConfig polls the remote
+// providers. Non-positive values keep DefaultRemoteWatchInterval. Call it
+// before WatchRemoteConfig.
+func (b *Backend) SetRemoteWatchInterval(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if d > 0 {
+		b.remoteInterval = d
+	}
+}
+
+// WatchRemoteConfig polls the providers added with AddRemoteProvider in a
+// background goroutine, every DefaultRemoteWatchInterval or the interval set
+// with SetRemoteWatchInterval. When the remote document changes it is loaded
+// and reported to the OnConfigChange callback with a RemoteChangeEvent;
+// unchanged polls and failed reads are ignored. Calling it again restarts
+// the watch. Stop it with StopRemoteWatch.
+func (b *Backend) WatchRemoteConfig() error {
+	if viper.RemoteConfig == nil {
+		// Surface the missing-client error now rather than from the goroutine
+		return b.ReadRemoteConfig()
+	}
+	last, err := b.fetchRemote()
+	if err != nil {
+		return err
+	}
+
+	b.StopRemoteWatch()
+
+	b.mu.Lock()
+	stop, done := make(chan struct{}), make(chan struct{})
+	b.stopRemote, b.remoteDone = stop, done
+	interval := b.remoteInterval
+	b.mu.Unlock()
+	if interval <= 0 {
+		interval = DefaultRemoteWatchInterval
+	}
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			settings, fetchErr := b.fetchRemote()
+			if fetchErr != nil || reflect.DeepEqual(settings, last) {
+				continue
+			}
+			last = settings
+
+			// Load the change under mu, like ReadRemoteConfig, so the live
+			// viper is never written by two goroutines at once.
+			b.mu.Lock()
+			readErr := b.v.ReadRemoteConfig()
+			callback := b.onChange
+			b.mu.Unlock()
+			if readErr == nil && callback != nil {
+				callback(RemoteChangeEvent{})
+			}
+		}
+	}()
+	return nil
+}
+
+// StopRemoteWatch stops the polling started by WatchRemoteConfig and waits
+// for an in-flight poll to finish, so it must not be called from the
+// OnConfigChange callback. It is a no-op when no watch is running.
+func (b *Backend) StopRemoteWatch() {
+	b.mu.Lock()
+	stop, done := b.stopRemote, b.remoteDone
+	b.stopRemote, b.remoteDone = nil, nil
+	b.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// fetchRemote reads the remote providers into a separate viper instance, so
+// polling does not touch the backend's own state, and returns the settings.
+func (b *Backend) fetchRemote() (map[string]any, error) {
+	b.mu.Lock()
+	remotes := slices.Clone(b.remotes)
+	configType := b.configType
+	b.mu.Unlock()
+
+	probe := viper.New()
+	if configType != "" {
+		probe.SetConfigType(configType)
+	}
+	for _, r := range remotes {
+		if err := probe.AddRemoteProvider(r.provider, r.endpoint, r.path); err != nil {
+			return nil, err
+		}
+	}
+	if err := probe.ReadRemoteConfig(); err != nil {
+		return nil, err
+	}
+	return probe.AllSettings(), nil
 }
 
 // =============================================================================
@@ -223,6 +518,7 @@ func (b *Backend) SetConfigName(name string) {
 
 // SetConfigType sets the type of the config file (e.g., "yaml", "json").
 func (b *Backend) SetConfigType(t string) {
+	b.configType = t
 	b.v.SetConfigType(t)
 }
 
@@ -233,19 +529,181 @@ func (b *Backend) AddConfigPath(path string) {
 
 // SetConfigFile sets an explicit config file path.
 // Unlike SetConfigName + AddConfigPath, this uses the exact file path.
-// The file type is inferred from the extension.
+// The file type is inferred from the extension, or from the content when the
+// extension is missing or unknown (see ReadInConfig).
 func (b *Backend) SetConfigFile(path string) {
 	b.v.SetConfigFile(path)
 }
 
 // ReadInConfig reads the config file from disk.
+//
+// When the file's extension does not identify its format, such as a
+// Kubernetes ConfigMap mounted as "config", and no type was set with
+// SetConfigType, the content is parsed as JSON, YAML, and TOML in turn and
+// the first format that succeeds is used. If none does, the error matches
+// ErrUnknownConfigFormat. WatchConfig cannot re-detect the format on reload,
+// so set the type explicitly when watching such a file.
+//
+// YAML anchors, aliases, and merge keys ("<<: *base") are resolved. A YAML
+// file with more than one document ("---" separators) is rejected with
+// ErrMultiDocumentYAML rather than reading only the first document.
 func (b *Backend) ReadInConfig() error {
-	return b.v.ReadInConfig()
+	err := b.v.ReadInConfig()
+	if b.canSniff(err) {
+		return b.mergeSniffed()
+	}
+	if err != nil {
+		return err
+	}
+	if err := b.checkYAMLDocuments(); err != nil {
+		return err
+	}
+	settings, err := b.readConfigFile()
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.file = newLayer(settings)
+	b.mu.Unlock()
+	return nil
 }
 
 // MergeInConfig merges a new config file into the existing config.
+// The format is detected from the content like ReadInConfig, and
+// multi-document YAML files are rejected the same way.
 func (b *Backend) MergeInConfig() error {
-	return b.v.MergeInConfig()
+	err := b.v.MergeInConfig()
+	if b.canSniff(err) {
+		return b.mergeSniffed()
+	}
+	if err != nil {
+		return err
+	}
+	if err := b.checkYAMLDocuments(); err != nil {
+		return err
+	}
+	settings, err := b.readConfigFile()
+	if err != nil {
+		return err
+	}
+	return b.mergeLayer(settings)
+}
+
+// readConfigFile parses the config file in use on its own, the same way
+// viper read it, for the config layer mirror.
+func (b *Backend) readConfigFile() (map[string]any, error) {
+	probe := viper.New()
+	probe.SetConfigFile(b.v.ConfigFileUsed())
+	if b.configType != "" {
+		probe.SetConfigType(b.configType)
+	}
+	if err := probe.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("config/viper: read %s: %w", b.v.ConfigFileUsed(), err)
+	}
+	return probe.AllSettings(), nil
+}
+
+// mergeLayer merges settings into the config layer mirror.
+func (b *Backend) mergeLayer(settings map[string]any) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.file.MergeConfigMap(cloneSettings(settings))
+}
+
+// newLayer returns a config layer mirror holding settings.
+func newLayer(settings map[string]any) *viper.Viper {
+	layer := viper.New()
+	_ = layer.MergeConfigMap(cloneSettings(settings)) // never fails
+	return layer
+}
+
+// cloneSettings deep-copies the nested maps of a settings map, since viper
+// keeps and mutates the maps it merges.
+func cloneSettings(settings map[string]any) map[string]any {
+	out := make(map[string]any, len(settings))
+	for k, v := range settings {
+		if m, ok := v.(map[string]any); ok {
+			v = cloneSettings(m)
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// checkYAMLDocuments returns ErrMultiDocumentYAML if the config file just
+// read is YAML with more than one document.
+func (b *Backend) checkYAMLDocuments() error {
+	path := b.v.ConfigFileUsed()
+	typ := b.configType
+	if typ == "" {
+		typ = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	if typ != "yaml" && typ != "yml" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config/viper: read %s: %w", path, err)
+	}
+	return singleYAMLDocument(path, data)
+}
+
+// singleYAMLDocument returns ErrMultiDocumentYAML if data holds more than
+// one non-empty YAML document. Parse errors are left to viper.
+func singleYAMLDocument(path string, data []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	docs := 0
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil //nolint:nilerr // viper reports parse errors
+		}
+		if len(doc.Content) > 0 && doc.Content[0].Tag != "!!null" {
+			docs++
+		}
+	}
+	if docs > 1 {
+		return fmt.Errorf("%w: %s has %d documents", ErrMultiDocumentYAML, path, docs)
+	}
+	return nil
+}
+
+// canSniff reports whether err from reading the config file is due to an
+// unrecognized extension and no type was set explicitly.
+func (b *Backend) canSniff(err error) bool {
+	var unsupported viper.UnsupportedConfigError
+	return b.configType == "" && errors.As(err, &unsupported)
+}
+
+// mergeSniffed parses the config file with the first of sniffedConfigTypes
+// that accepts its content and merges the result. The type is not set on
+// the shared viper instance, so later files keep extension-based detection.
+func (b *Backend) mergeSniffed() error {
+	path := b.v.ConfigFileUsed()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config/viper: read %s: %w", path, err)
+	}
+	for _, typ := range sniffedConfigTypes {
+		probe := viper.New()
+		probe.SetConfigType(typ)
+		if probe.ReadConfig(bytes.NewReader(data)) == nil {
+			if typ == "yaml" {
+				if docErr := singleYAMLDocument(path, data); docErr != nil {
+					return docErr
+				}
+			}
+			if err := b.v.MergeConfigMap(probe.AllSettings()); err != nil {
+				return err
+			}
+			return b.mergeLayer(probe.AllSettings())
+		}
+	}
+	return fmt.Errorf("%w: %s is not valid %s",
+		ErrUnknownConfigFormat, path, strings.Join(sniffedConfigTypes, ", "))
 }
 
 // BindPFlags binds pflags to configuration keys.
@@ -291,9 +749,121 @@ func (b *Backend) IsConfigFileNotFoundError(err error) bool {
 	return IsConfigFileNotFoundError(err)
 }
 
+// ConfigSettings returns a copy of the values read from config files and
+// merged with MergeConfigMap, without defaults, environment variables,
+// flags, or values set with Set. Manager.Snapshot uses it.
+func (b *Backend) ConfigSettings() map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.file.AllSettings()
+}
+
+// Overrides returns a copy of the values set with Set, keyed by the
+// lower-cased key they were set with. Manager.Snapshot uses it.
+func (b *Backend) Overrides() map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return maps.Clone(b.overrides)
+}
+
+// ReplaceConfig replaces the values read from config files and merged with
+// MergeConfigMap by settings, as if settings were the whole config file.
+// Defaults, env vars, flags, and values set with Set keep their precedence.
+// Manager.Restore uses it to roll back to a snapshot.
+//
+// Settings are re-read in the type viper reads the config file in: the one
+// set with SetConfigType, or the config file's extension. The type is not
+// changed, so if neither identifies a format, the config values are patched
+// in place instead: changed values are merged and removed ones unset. That
+// fails only if settings replace a section with a single value.
+func (b *Backend) ReplaceConfig(settings map[string]any) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	next := newLayer(settings)
+	if reflect.DeepEqual(b.file.AllSettings(), next.AllSettings()) {
+		return nil
+	}
+
+	typ := b.configType
+	if typ == "" {
+		typ = strings.TrimPrefix(filepath.Ext(b.v.ConfigFileUsed()), ".")
+	}
+	if !slices.Contains(viper.SupportedExts, typ) {
+		patch, err := configPatch(b.file, next)
+		if err != nil {
+			return err
+		}
+		if err := b.v.MergeConfigMap(patch); err != nil {
+			return fmt.Errorf("config/viper: replace config: %w", err)
+		}
+		b.file = next
+		return nil
+	}
+
+	probe := viper.New()
+	probe.SetConfigType(typ)
+	if err := probe.MergeConfigMap(cloneSettings(settings)); err != nil {
+		return fmt.Errorf("config/viper: replace config: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := probe.WriteConfigTo(&buf); err != nil {
+		return fmt.Errorf("config/viper: encode config: %w", err)
+	}
+	if err := b.v.ReadConfig(&buf); err != nil {
+		return fmt.Errorf("config/viper: replace config: %w", err)
+	}
+	b.file = newLayer(settings)
+	return nil
+}
+
+// configPatch returns the settings that, merged into a config layer holding
+// current, make it hold next: next's values, and nil for the keys only in
+// current, which viper treats as unset.
+func configPatch(current, next *viper.Viper) (map[string]any, error) {
+	patch := make(map[string]any)
+	for _, key := range current.AllKeys() {
+		if !next.IsSet(key) {
+			setNestedKey(patch, strings.Split(key, "."), nil)
+		}
+	}
+	for _, key := range next.AllKeys() {
+		// Merging cannot turn a section into a value.
+		if _, section := current.Get(key).(map[string]any); section {
+			return nil, fmt.Errorf("%w: set a config type to replace section %q with a value",
+				ErrUnknownConfigFormat, key)
+		}
+		setNestedKey(patch, strings.Split(key, "."), next.Get(key))
+	}
+	return patch, nil
+}
+
+// ReplaceOverrides replaces the values set with Set by overrides: keys set
+// since are unset, so lower-precedence sources apply to them again.
+// Manager.Restore uses it to roll back to a snapshot.
+func (b *Backend) ReplaceOverrides(overrides map[string]any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key := range b.overrides {
+		if _, ok := overrides[key]; !ok {
+			b.v.Set(key, nil) // viper skips nil overrides
+		}
+	}
+	for key, value := range overrides {
+		b.v.Set(key, value)
+	}
+	b.overrides = maps.Clone(overrides)
+	if b.overrides == nil {
+		b.overrides = make(map[string]any)
+	}
+}
+
 // MergeConfigMap merges a map of config values into the current configuration.
 // This is useful for testing scenarios where you want to inject config values
 // without loading from files.
 func (b *Backend) MergeConfigMap(cfg map[string]any) error {
-	return b.v.MergeConfigMap(cfg)
+	if err := b.v.MergeConfigMap(cfg); err != nil {
+		return err
+	}
+	return b.mergeLayer(cfg)
 }
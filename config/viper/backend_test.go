@@ -335,6 +335,220 @@ func TestBackend_ConfigFileUsed(t *testing.T) {
 	assert.Contains(t, configFile, "config.yaml")
 }
 
+func writeExtensionlessConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestBackend_ReadInConfig_SniffsExtensionlessYAML(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, "server:\n  host: yamlhost\n  port: 9000\n"))
+
+	require.NoError(t, backend.ReadInConfig())
+
+	assert.Equal(t, "yamlhost", backend.GetString("server.host"))
+	assert.Equal(t, 9000, backend.GetInt("server.port"))
+}
+
+func TestBackend_ReadInConfig_SniffsExtensionlessJSON(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, `{"server": {"host": "jsonhost", "port": 9001}}`))
+
+	require.NoError(t, backend.ReadInConfig())
+
+	assert.Equal(t, "jsonhost", backend.GetString("server.host"))
+	assert.Equal(t, 9001, backend.GetInt("server.port"))
+}
+
+func TestBackend_ReadInConfig_SniffsExtensionlessTOML(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, "[server]\nhost = \"tomlhost\"\n"))
+
+	require.NoError(t, backend.ReadInConfig())
+
+	assert.Equal(t, "tomlhost", backend.GetString("server.host"))
+}
+
+func TestBackend_ReadInConfig_UnknownFormat(t *testing.T) {
+	backend := cfgviper.New()
+	path := writeExtensionlessConfig(t, "{not: [valid")
+	backend.SetConfigFile(path)
+
+	err := backend.ReadInConfig()
+	require.ErrorIs(t, err, cfgviper.ErrUnknownConfigFormat)
+	assert.Contains(t, err.Error(), path)
+}
+
+func TestBackend_ReadInConfig_ExplicitTypeDisablesSniffing(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, `{"host": "jsonhost"}`))
+	backend.SetConfigType("ini")
+
+	err := backend.ReadInConfig()
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, cfgviper.ErrUnknownConfigFormat)
+}
+
+func TestBackend_MergeInConfig_SniffsExtensionlessProfile(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, "host: basehost\nport: 3000\n"))
+	require.NoError(t, backend.ReadInConfig())
+
+	backend.SetConfigFile(writeExtensionlessConfig(t, `{"host": "profilehost"}`))
+	require.NoError(t, backend.MergeInConfig())
+
+	assert.Equal(t, "profilehost", backend.GetString("host")) // Overridden
+	assert.Equal(t, 3000, backend.GetInt("port"))             // From base
+}
+
+func TestBackend_ReplaceConfig_KeepsConfigType(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "config.json")
+	tomlPath := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"host": "jsonhost"}`), 0o600))
+	require.NoError(t, os.WriteFile(tomlPath, []byte("host = \"tomlhost\"\n"), 0o600))
+
+	backend := cfgviper.New()
+	backend.SetConfigFile(jsonPath)
+	require.NoError(t, backend.ReadInConfig())
+	require.NoError(t, backend.ReplaceConfig(map[string]any{"host": "restored"}))
+	assert.Equal(t, "restored", backend.GetString("host"))
+	assert.Equal(t, map[string]any{"host": "restored"}, backend.ConfigSettings())
+
+	// The TOML file is still read by its extension, not as JSON.
+	backend.SetConfigFile(tomlPath)
+	require.NoError(t, backend.ReadInConfig())
+	assert.Equal(t, "tomlhost", backend.GetString("host"))
+}
+
+func TestBackend_ReplaceConfig_WithoutConfigType(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeExtensionlessConfig(t, "host: basehost\nserver:\n  port: 8080\n"))
+	require.NoError(t, backend.ReadInConfig())
+	require.NoError(t, backend.MergeConfigMap(map[string]any{"extra": "merged"}))
+	backend.SetDefault("server.port", 1)
+
+	require.NoError(t, backend.ReplaceConfig(map[string]any{
+		"host": "other",
+		"tls":  map[string]any{"enabled": true},
+	}))
+	assert.Equal(t, "other", backend.GetString("host"))
+	assert.True(t, backend.GetBool("tls.enabled"))
+	assert.False(t, backend.IsSet("extra"))
+	assert.Equal(t, 1, backend.GetInt("server.port"), "removed values fall back to defaults")
+	assert.Equal(t, map[string]any{
+		"host": "other",
+		"tls":  map[string]any{"enabled": true},
+	}, backend.ConfigSettings())
+
+	// A section cannot be patched into a single value.
+	err := backend.ReplaceConfig(map[string]any{"tls": "on"})
+	require.ErrorIs(t, err, cfgviper.ErrUnknownConfigFormat)
+	assert.True(t, backend.GetBool("tls.enabled"))
+}
+
+func TestBackend_ConfigSettings_ExcludesOtherSources(t *testing.T) {
+	t.Setenv("LAYERTEST_PORT", "9100")
+
+	backend := cfgviper.New()
+	backend.SetEnvPrefix("LAYERTEST")
+	backend.AutomaticEnv()
+	backend.SetDefault("debug", true)
+	backend.Set("Name", "override")
+	require.NoError(t, backend.MergeConfigMap(map[string]any{"host": "maphost"}))
+
+	assert.Equal(t, map[string]any{"host": "maphost"}, backend.ConfigSettings())
+	assert.Equal(t, map[string]any{"name": "override"}, backend.Overrides())
+	assert.Equal(t, 9100, backend.GetInt("port"))
+}
+
+func TestBackend_ReplaceOverrides_UnsetsNewKeys(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetDefault("host", "defaulthost")
+	backend.Set("port", 1)
+	overrides := backend.Overrides()
+
+	backend.Set("host", "sethost")
+	backend.Set("port", 2)
+	backend.ReplaceOverrides(overrides)
+
+	assert.Equal(t, "defaulthost", backend.GetString("host"))
+	assert.Equal(t, 1, backend.GetInt("port"))
+	assert.Equal(t, map[string]any{"port": 1}, backend.Overrides())
+}
+
+func writeYAMLConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestBackend_ReadInConfig_ResolvesYAMLAnchors(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeYAMLConfig(t, `
+defaults: &defaults
+  host: anchoredhost
+  port: 5432
+primary:
+  <<: *defaults
+  port: 6432
+replica: *defaults
+timeout: &timeout 5s
+client:
+  timeout: *timeout
+`))
+
+	require.NoError(t, backend.ReadInConfig())
+
+	assert.Equal(t, "anchoredhost", backend.GetString("primary.host"))
+	assert.Equal(t, 6432, backend.GetInt("primary.port"))
+	assert.Equal(t, "anchoredhost", backend.GetString("replica.host"))
+	assert.Equal(t, 5432, backend.GetInt("replica.port"))
+	assert.Equal(t, 5*time.Second, backend.GetDuration("client.timeout"))
+}
+
+func TestBackend_ReadInConfig_RejectsMultiDocumentYAML(t *testing.T) {
+	content := "host: first\n---\nhost: second\n"
+
+	t.Run("yaml extension", func(t *testing.T) {
+		backend := cfgviper.New()
+		path := writeYAMLConfig(t, content)
+		backend.SetConfigFile(path)
+
+		err := backend.ReadInConfig()
+		require.ErrorIs(t, err, cfgviper.ErrMultiDocumentYAML)
+		assert.Contains(t, err.Error(), path)
+		assert.Contains(t, err.Error(), "2 documents")
+	})
+
+	t.Run("sniffed", func(t *testing.T) {
+		backend := cfgviper.New()
+		backend.SetConfigFile(writeExtensionlessConfig(t, content))
+
+		require.ErrorIs(t, backend.ReadInConfig(), cfgviper.ErrMultiDocumentYAML)
+	})
+
+	t.Run("merged profile", func(t *testing.T) {
+		backend := cfgviper.New()
+		backend.SetConfigFile(writeYAMLConfig(t, "host: base\n"))
+		require.NoError(t, backend.ReadInConfig())
+
+		backend.SetConfigFile(writeYAMLConfig(t, content))
+		require.ErrorIs(t, backend.MergeInConfig(), cfgviper.ErrMultiDocumentYAML)
+	})
+}
+
+func TestBackend_ReadInConfig_AllowsSingleDocumentMarkers(t *testing.T) {
+	backend := cfgviper.New()
+	backend.SetConfigFile(writeYAMLConfig(t, "---\nhost: onlyhost\n---\n"))
+
+	require.NoError(t, backend.ReadInConfig())
+	assert.Equal(t, "onlyhost", backend.GetString("host"))
+}
+
 func TestBackend_AllSettings(t *testing.T) {
 	backend := cfgviper.New()
 	backend.Set("host", "localhost")
@@ -593,6 +807,27 @@ func TestBackend_UnmarshalKeyWithGazTag(t *testing.T) {
 	assert.Equal(t, 5432, db.Port)
 }
 
+func TestBackend_UnmarshalKeyWithGazTag_MergesEnv(t *testing.T) {
+	t.Setenv("DATABASE_PORT", "6543")
+
+	backend := cfgviper.New()
+	backend.SetDefault("database.host", "db-host")
+	backend.SetDefault("database.port", 5432)
+	require.NoError(t, backend.BindEnv("database.port", "DATABASE_PORT"))
+
+	type dbConfig struct {
+		Host string `gaz:"host"`
+		Port int    `gaz:"port"`
+	}
+
+	var db dbConfig
+	err := backend.UnmarshalKeyWithGazTag("database", &db)
+	require.NoError(t, err)
+
+	assert.Equal(t, "db-host", db.Host)
+	assert.Equal(t, 6543, db.Port)
+}
+
 // =============================================================================
 // Test HasKey function
 // =============================================================================
@@ -622,6 +857,23 @@ func TestBackend_HasKey_NestedKey(t *testing.T) {
 	assert.False(t, backend.HasKey("server"))
 }
 
+func TestBackend_HasKey_FlagOnlyNamespace(t *testing.T) {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Int("cache-size", 64, "")
+	require.NoError(t, fs.Parse([]string{"--cache-size=128"}))
+
+	backend := cfgviper.New()
+	require.NoError(t, backend.BindPFlag("cache.size", fs.Lookup("cache-size")))
+
+	assert.True(t, backend.HasKey("cache"))
+
+	var cache struct {
+		Size int `mapstructure:"size"`
+	}
+	require.NoError(t, backend.UnmarshalKey("cache", &cache))
+	assert.Equal(t, 128, cache.Size)
+}
+
 // =============================================================================
 // Test MergeConfigMap function
 // =============================================================================
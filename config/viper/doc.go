@@ -22,4 +22,52 @@
 // The Backend wraps a viper.Viper instance and delegates all operations to it.
 // Additional viper-specific methods are exposed for configuration loading
 // (SetConfigName, AddConfigPath, ReadInConfig, etc.).
+//
+// # Format Detection
+//
+// The config file format comes from its extension. Files without a
+// recognized extension, such as a ConfigMap mounted as "config", are parsed
+// as JSON, YAML, or TOML by content unless SetConfigType was called.
+//
+// # YAML Anchors and Documents
+//
+// YAML anchors, aliases, and merge keys are resolved, so shared blocks can be
+// reused:
+//
+//	defaults: &defaults
+//	  host: db.internal
+//	primary:
+//	  <<: *defaults
+//	  port: 5432
+//
+// A YAML file must hold a single document. Files with several "---"
+// separated documents fail with [ErrMultiDocumentYAML] instead of silently
+// using only the first; split them into a base file and profile files.
+//
+// # Remote Configuration
+//
+// The Backend implements [config.RemoteBackend] for etcd, Consul, and the
+// other stores viper supports. Their clients are heavy, so they are only
+// compiled in with the gaz_remote build tag:
+//
+//	go get github.com/spf13/viper/remote
+//	go build -tags gaz_remote ./...
+//
+// Remote documents have no extension, so set their format explicitly:
+//
+//	backend.SetConfigType("json")
+//	if err := backend.AddRemoteProvider("etcd3", "http://127.0.0.1:2379", "/config/app.json"); err != nil {
+//	    return err
+//	}
+//	if err := backend.ReadRemoteConfig(); err != nil {
+//	    return err
+//	}
+//	backend.OnConfigChange(func(event any) {
+//	    if _, ok := event.(configviper.RemoteChangeEvent); ok {
+//	        // reload
+//	    }
+//	})
+//	backend.SetRemoteWatchInterval(30 * time.Second)
+//	_ = backend.WatchRemoteConfig()
+//	defer backend.StopRemoteWatch()
 package viper
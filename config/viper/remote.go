@@ -0,0 +1,7 @@
+//go:build gaz_remote
+
+package viper
+
+// Registers viper's etcd, Consul, Firestore, and NATS clients. This pulls in
+// their dependencies, so it is only compiled with the gaz_remote build tag.
+import _ "github.com/spf13/viper/remote"
@@ -0,0 +1,163 @@
+package viper_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfgviper "github.com/petabytecl/gaz/config/viper"
+)
+
+// fakeRemote stands in for viper's remote provider clients. Like the real
+// clients, Get and Watch return the current document immediately, so a watch
+// loop that does not wait between reads would spin.
+type fakeRemote struct {
+	mu    sync.Mutex
+	doc   []byte
+	reads int
+}
+
+func (f *fakeRemote) set(doc string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.doc = []byte(doc)
+}
+
+func (f *fakeRemote) readCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.reads
+}
+
+func (f *fakeRemote) Get(_ viper.RemoteProvider) (io.Reader, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reads++
+	return bytes.NewReader(f.doc), nil
+}
+
+func (f *fakeRemote) Watch(rp viper.RemoteProvider) (io.Reader, error) {
+	return f.Get(rp)
+}
+
+func (f *fakeRemote) WatchChannel(_ viper.RemoteProvider) (<-chan *viper.RemoteResponse, chan bool) {
+	return nil, nil
+}
+
+// useFakeRemote installs a fake remote provider client for the test.
+func useFakeRemote(t *testing.T, initial string) *fakeRemote {
+	t.Helper()
+	fake := &fakeRemote{doc: []byte(initial)}
+	prev := viper.RemoteConfig
+	viper.RemoteConfig = fake
+	t.Cleanup(func() { viper.RemoteConfig = prev })
+	return fake
+}
+
+func TestBackend_ReadRemoteConfig(t *testing.T) {
+	useFakeRemote(t, `{"server": {"port": 9090}}`)
+
+	backend := cfgviper.New()
+	backend.SetConfigType("json")
+	require.NoError(t, backend.AddRemoteProvider("etcd3", "http://127.0.0.1:2379", "/config/app.json"))
+	require.NoError(t, backend.ReadRemoteConfig())
+
+	assert.Equal(t, 9090, backend.GetInt("server.port"))
+}
+
+func TestBackend_ReadRemoteConfig_WithoutClients(t *testing.T) {
+	prev := viper.RemoteConfig
+	viper.RemoteConfig = nil
+	t.Cleanup(func() { viper.RemoteConfig = prev })
+
+	backend := cfgviper.New()
+	require.NoError(t, backend.AddRemoteProvider("consul", "127.0.0.1:8500", "config/app"))
+
+	require.Error(t, backend.ReadRemoteConfig())
+	require.Error(t, backend.WatchRemoteConfig())
+}
+
+func TestBackend_AddRemoteProvider_Unsupported(t *testing.T) {
+	backend := cfgviper.New()
+	err := backend.AddRemoteProvider("zookeeper", "127.0.0.1:2181", "/config")
+
+	var unsupported viper.UnsupportedRemoteProviderError
+	require.ErrorAs(t, err, &unsupported)
+}
+
+func TestBackend_WatchRemoteConfig_FiresCallbackOnChange(t *testing.T) {
+	fake := useFakeRemote(t, `{"server": {"port": 9090}}`)
+
+	backend := cfgviper.New()
+	backend.SetConfigType("json")
+	backend.SetRemoteWatchInterval(5 * time.Millisecond)
+	require.NoError(t, backend.AddRemoteProvider("etcd3", "http://127.0.0.1:2379", "/config/app.json"))
+	require.NoError(t, backend.ReadRemoteConfig())
+
+	var mu sync.Mutex
+	var ports []int
+	events := make(chan any, 10)
+	backend.OnConfigChange(func(event any) {
+		mu.Lock()
+		ports = append(ports, backend.GetInt("server.port"))
+		mu.Unlock()
+		events <- event
+	})
+	require.NoError(t, backend.WatchRemoteConfig())
+	defer backend.StopRemoteWatch()
+
+	for _, doc := range []string{`{"server": {"port": 9091}}`, `{"server": {"port": 9092}}`} {
+		fake.set(doc)
+		select {
+		case event := <-events:
+			assert.IsType(t, cfgviper.RemoteChangeEvent{}, event)
+		case <-time.After(time.Second):
+			t.Fatal("callback not called for remote change")
+		}
+	}
+
+	// Unchanged polls must not fire the callback, and polling must not spin.
+	before := fake.readCount()
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case <-events:
+		t.Fatal("callback called without a remote change")
+	default:
+	}
+	assert.Less(t, fake.readCount()-before, 50, "remote watch is not waiting between polls")
+
+	backend.StopRemoteWatch()
+	stopped := fake.readCount()
+	time.Sleep(20 * time.Millisecond)
+	assert.LessOrEqual(t, fake.readCount()-stopped, 1, "polling continued after StopRemoteWatch")
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []int{9091, 9092}, ports)
+}
+
+func TestBackend_WatchRemoteConfig_ConcurrentRead(t *testing.T) {
+	fake := useFakeRemote(t, `{"server": {"port": 9090}}`)
+
+	backend := cfgviper.New()
+	backend.SetConfigType("json")
+	backend.SetRemoteWatchInterval(time.Millisecond)
+	require.NoError(t, backend.AddRemoteProvider("etcd3", "http://127.0.0.1:2379", "/config/app.json"))
+	require.NoError(t, backend.WatchRemoteConfig())
+	defer backend.StopRemoteWatch()
+
+	// Reads racing the poller's reloads must not corrupt the live settings;
+	// run with -race to catch unsynchronized writes.
+	for i := range 50 {
+		fake.set(fmt.Sprintf(`{"server": {"port": %d}}`, 9100+i))
+		require.NoError(t, backend.ReadRemoteConfig())
+		time.Sleep(100 * time.Microsecond)
+	}
+}
@@ -0,0 +1,82 @@
+package gaz
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/petabytecl/gaz/config"
+)
+
+// BindConfig binds a single config namespace to a struct of type T and
+// registers it in the container as an eager singleton. It must be called
+// before Build.
+//
+// The returned pointer is the registered instance. It is populated during
+// Build, after config files, environment variables and flags are loaded:
+//
+//  1. Bind env vars for each field (e.g. "server.port" → SERVER_PORT)
+//  2. Unmarshal the namespace into T using "gaz" struct tags
+//  3. Apply Defaulter interface if implemented
+//  4. Validate using struct tags (go-playground/validator)
+//  5. Validate using Validator interface if implemented
+//
+// A missing namespace is not an error; T keeps its zero value plus defaults.
+// Other services can inject *T directly.
+//
+// Example:
+//
+//	type ServerConfig struct {
+//	    Host string `gaz:"host"`
+//	    Port int    `gaz:"port" validate:"min=1,max=65535"`
+//	}
+//
+//	cfg, err := gaz.BindConfig[ServerConfig](app, "server")
+//	if err != nil {
+//	    return err
+//	}
+//	if err := app.Build(); err != nil {
+//	    return err
+//	}
+//	fmt.Println(cfg.Port)
+func BindConfig[T any](app *App, namespace string) (*T, error) {
+	target := new(T)
+
+	err := For[*T](app.container).Eager().Provider(func(c *Container) (*T, error) {
+		if app.configMgr == nil {
+			return nil, fmt.Errorf("bind config %q: no config manager", namespace)
+		}
+		if bindErr := app.configMgr.BindNamespaceEnv(namespace, target); bindErr != nil {
+			return nil, fmt.Errorf("bind config %q: %w", namespace, bindErr)
+		}
+
+		pv, resolveErr := Resolve[*ProviderValues](c)
+		if resolveErr != nil {
+			return nil, fmt.Errorf("bind config %q: %w", namespace, resolveErr)
+		}
+		if unmarshalErr := pv.UnmarshalKey(namespace, target); unmarshalErr != nil &&
+			!errors.Is(unmarshalErr, config.ErrKeyNotFound) {
+			return nil, fmt.Errorf("bind config %q: %w", namespace, unmarshalErr)
+		}
+
+		if d, ok := any(target).(config.Defaulter); ok {
+			d.Default()
+		}
+		if validateErr := config.ValidateStruct(target); validateErr != nil {
+			// Report field paths relative to the config root ("server.port")
+			if ve, ok := errors.AsType[config.ValidationError](validateErr); ok {
+				validateErr = ve.WithPrefix(namespace)
+			}
+			return nil, fmt.Errorf("bind config %q: %w", namespace, validateErr)
+		}
+		if v, ok := any(target).(config.Validator); ok {
+			if validateErr := v.Validate(); validateErr != nil {
+				return nil, fmt.Errorf("bind config %q: custom validation failed: %w", namespace, validateErr)
+			}
+		}
+		return target, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("register config %q: %w", namespace, err)
+	}
+	return target, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -77,6 +78,70 @@ func (s *ConfigSuite) TestValidation() {
 	s.Contains(err.Error(), "port must be positive")
 }
 
+func (s *ConfigSuite) TestConfigValidationHookReceivesLoadedConfig() {
+	s.T().Setenv("TEST_HOOK_HOST", "example.com")
+
+	var cfg TestConfig
+	var seen TestConfig
+	calls := 0
+	app := gaz.New().
+		WithConfig(&cfg, config.WithEnvPrefix("TEST_HOOK")).
+		WithConfigValidationHook(func(c any) error {
+			calls++
+			loaded, ok := c.(*TestConfig)
+			s.Require().True(ok, "hook should receive the WithConfig target")
+			seen = *loaded
+			return nil
+		})
+
+	s.Require().NoError(app.Build())
+
+	s.Equal(1, calls)
+	// Env values and Defaulter output are both applied before the hook
+	s.Equal(TestConfig{Host: "example.com", Port: 8080}, seen)
+}
+
+func (s *ConfigSuite) TestConfigValidationHookErrorFailsBuild() {
+	var cfg TestConfig
+	errTLS := errors.New("cert and key required when TLS is enabled")
+	app := gaz.New().
+		WithConfig(&cfg).
+		WithConfigValidationHook(func(any) error { return nil }).
+		WithConfigValidationHook(func(any) error { return errTLS })
+
+	err := app.Build()
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, errTLS)
+	s.Contains(err.Error(), "validating config")
+}
+
+func (s *ConfigSuite) TestConfigValidationHookRunsAfterValidator() {
+	s.T().Setenv("TEST_HOOK_ORDER_PORT", "-1")
+
+	var cfg TestConfig
+	hookCalled := false
+	app := gaz.New().
+		WithConfig(&cfg, config.WithEnvPrefix("TEST_HOOK_ORDER")).
+		WithConfigValidationHook(func(any) error {
+			hookCalled = true
+			return nil
+		})
+
+	err := app.Build()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "port must be positive")
+	s.False(hookCalled, "hook should not run when Validator fails")
+}
+
+func (s *ConfigSuite) TestConfigValidationHookAfterBuildPanics() {
+	app := gaz.New()
+	s.Require().NoError(app.Build())
+
+	s.Panics(func() {
+		app.WithConfigValidationHook(func(any) error { return nil })
+	})
+}
+
 func (s *ConfigSuite) TestInjection() {
 	var cfg TestConfig
 	var injectedCfg *TestConfig
@@ -131,3 +196,98 @@ func (s *ConfigSuite) TestProfiles() {
 	s.Equal("prod-host", cfg.Host)
 	s.Equal(8080, cfg.Port) // Preserved from base
 }
+
+type ServerConfig struct {
+	Host    string `gaz:"host"`
+	Port    int    `gaz:"port" validate:"min=1,max=65535"`
+	Timeout string `gaz:"timeout"`
+}
+
+func (c *ServerConfig) Default() {
+	if c.Host == "" {
+		c.Host = "0.0.0.0"
+	}
+	if c.Timeout == "" {
+		c.Timeout = "30s"
+	}
+}
+
+func (s *ConfigSuite) TestBindConfig() {
+	tmpDir := s.T().TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "config.yaml"), []byte("server:\n  host: file-host\n  port: 8080\n"), 0o600)
+	s.Require().NoError(err)
+
+	s.T().Setenv("SERVER_PORT", "9090")
+
+	app := gaz.New().WithConfig(nil, config.WithSearchPaths(tmpDir))
+	cfg, err := gaz.BindConfig[ServerConfig](app, "server")
+	s.Require().NoError(err)
+
+	s.Require().NoError(app.Build())
+
+	s.Equal("file-host", cfg.Host) // From file
+	s.Equal(9090, cfg.Port)        // Env overrides file
+	s.Equal("30s", cfg.Timeout)    // From Defaulter
+
+	injected, err := gaz.Resolve[*ServerConfig](app.Container())
+	s.Require().NoError(err)
+	s.Same(cfg, injected)
+}
+
+func (s *ConfigSuite) TestBindConfigMissingNamespace() {
+	s.T().Setenv("SERVER_PORT", "7070")
+
+	app := gaz.New().WithConfig(nil, config.WithSearchPaths(s.T().TempDir()))
+	cfg, err := gaz.BindConfig[ServerConfig](app, "server")
+	s.Require().NoError(err)
+
+	s.Require().NoError(app.Build())
+
+	s.Equal("0.0.0.0", cfg.Host)
+	s.Equal(7070, cfg.Port)
+}
+
+// ScheduleConfig mixes a nested config struct with a time.Time leaf.
+type ScheduleConfig struct {
+	Start  time.Time `gaz:"start"`
+	Window struct {
+		Hours int `gaz:"hours"`
+	} `gaz:"window"`
+}
+
+func (s *ConfigSuite) TestBindConfigTimeField() {
+	s.T().Setenv("SCHEDULE_START", "2026-01-02T03:04:05Z")
+	s.T().Setenv("SCHEDULE_WINDOW_HOURS", "6")
+
+	app := gaz.New().WithConfig(nil, config.WithSearchPaths(s.T().TempDir()))
+	cfg, err := gaz.BindConfig[ScheduleConfig](app, "schedule")
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	// time.Time is bound as one key, not walked as a nested struct
+	s.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), cfg.Start)
+	s.Equal(6, cfg.Window.Hours)
+}
+
+func (s *ConfigSuite) TestBindConfigValidation() {
+	app := gaz.New().WithConfig(nil, config.WithSearchPaths(s.T().TempDir()))
+	_, err := gaz.BindConfig[ServerConfig](app, "server")
+	s.Require().NoError(err)
+
+	err = app.Build()
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, config.ErrConfigValidation)
+
+	var ve config.ValidationError
+	s.Require().ErrorAs(err, &ve)
+	s.Require().Len(ve.Errors, 1)
+	s.Equal("server.port", ve.Errors[0].FieldPath)
+}
+
+func (s *ConfigSuite) TestBindConfigAfterBuild() {
+	app := gaz.New()
+	s.Require().NoError(app.Build())
+
+	_, err := gaz.BindConfig[ServerConfig](app, "server")
+	s.Require().ErrorIs(err, gaz.ErrDIAlreadyBuilt)
+}
@@ -0,0 +1,56 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/petabytecl/gaz/cron/internal"
+)
+
+// Clock provides the current time and timers to the Scheduler. Tests
+// substitute a [FakeClock] to run scheduled jobs without waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock.
+type Timer interface {
+	// C returns the channel the fire time is sent on.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It reports whether the timer was
+	// stopped before it fired.
+	Stop() bool
+}
+
+// WithClock sets the clock the scheduler uses to decide when jobs are due
+// and to time jitter delays (see WithJitter). A nil clock keeps the system
+// clock.
+//
+// With gaz.App, use gaz.WithCronClock; with gaztest, Builder.WithClock.
+func WithClock(clock Clock) SchedulerOption {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// systemTimer is the Timer used when no Clock is set.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time { return t.timer.C }
+
+func (t systemTimer) Stop() bool { return t.timer.Stop() }
+
+// internalClock adapts a Clock to the internal scheduler's clock.
+type internalClock struct {
+	clock Clock
+}
+
+func (c internalClock) Now() time.Time { return c.clock.Now() }
+
+func (c internalClock) NewTimer(d time.Duration) internal.Timer { return c.clock.NewTimer(d) }
@@ -56,6 +56,12 @@
 //   - @hourly - Run once an hour at the beginning of the hour
 //   - @every <duration> - Run at fixed intervals (e.g., @every 5m)
 //
+// The day-of-month field also accepts Quartz-style specifiers, useful for
+// billing and reporting jobs:
+//
+//   - L - Last day of the month (e.g., "0 0 L * *" runs on Jan 31, Feb 28/29, ...)
+//   - nW - Weekday nearest to day n, within the month (e.g., "0 9 15W * *")
+//
 // # Registration Pattern
 //
 // Jobs are registered as transient providers and discovered during app.Build():
@@ -70,4 +76,52 @@
 //   - Jobs gracefully complete on shutdown (scheduler waits for running jobs)
 //   - Panics are recovered, logged with stack trace, and don't crash the app
 //   - Each execution resolves a fresh job instance from the container (transient)
+//
+// # Manual Triggering
+//
+// [Scheduler.TriggerNow] runs a job immediately, e.g. from an admin endpoint.
+// It uses the same resolution, timeout, and overlap rules as scheduled runs:
+//
+//	err := app.Scheduler().TriggerNow(ctx, "cleanup")
+//
+// # Pausing Jobs
+//
+// [Scheduler.Pause] and [Scheduler.Resume] suspend and re-enable the scheduled
+// runs of one job; [Scheduler.PauseAll] and [Scheduler.ResumeAll] affect every
+// job. Ticks that fire while a job is paused are skipped rather than queued:
+//
+//	_ = app.Scheduler().Pause("nightly-cleanup") // maintenance window
+//	_ = app.Scheduler().Resume("nightly-cleanup")
+//
+// # Jitter
+//
+// Jobs sharing a schedule such as @hourly all fire at the same instant. Use
+// [WithJitter] (gaz.WithCronJitter for an App) to delay each scheduled run by
+// a random duration below a bound, or implement [JitterProvider] on a job to
+// set its own bound. Only the individual run is delayed; the schedule does
+// not drift, and TriggerNow is never delayed.
+//
+// # Testing Schedules
+//
+// [WithClock] (gaz.WithCronClock for an App) replaces the system clock. A
+// [FakeClock] only moves when advanced, so tests can run scheduled jobs
+// deterministically:
+//
+//	clock := cron.NewFakeClock(time.Now())
+//	s := cron.NewScheduler(resolver, ctx, logger, cron.WithClock(clock))
+//	// register jobs and start s
+//	clock.WaitForTimers(1, time.Second)
+//	clock.Advance(time.Minute)
+//
+// Jitter delays wait on the same clock, so with jitter a due job starts once
+// the clock is advanced past its delay as well.
+//
+// # Multiple Replicas
+//
+// Every replica of a service runs its own scheduler, so by default every
+// replica runs every tick. Supply a [Locker] (e.g. backed by Redis or a
+// database) with [WithLocker], or register one in the container for gaz.App,
+// and only the replica that acquires a job's lock runs it:
+//
+//	gaz.For[cron.Locker](c).Instance(cron.Locker(NewRedisLocker(client)))
 package cron
@@ -7,4 +7,19 @@ var (
 	// ErrNotRunning indicates an operation was attempted on a scheduler
 	// that is not running.
 	ErrNotRunning = errors.New("cron: scheduler not running")
+
+	// ErrJobFailed indicates the most recent execution of a scheduled job
+	// returned an error or panicked.
+	ErrJobFailed = errors.New("cron: job failed")
+
+	// ErrJobNotFound indicates no registered job has the given name.
+	ErrJobNotFound = errors.New("cron: job not found")
+
+	// ErrJobRunning indicates a job could not be triggered because an
+	// execution is already in progress.
+	ErrJobRunning = errors.New("cron: job already running")
+
+	// ErrJobLocked indicates a job execution was skipped because its Locker
+	// lock is held elsewhere, typically by another replica.
+	ErrJobLocked = errors.New("cron: job locked")
 )
@@ -0,0 +1,37 @@
+package internal
+
+import "time"
+
+// Clock provides the current time and timers to the scheduler. The default
+// uses the time package; tests substitute a fake to control time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock.
+type Timer interface {
+	// C returns the channel the fire time is sent on.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It reports whether the timer was
+	// stopped before it fired.
+	Stop() bool
+}
+
+// realClock is the Clock backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+// realTimer adapts *time.Timer to Timer.
+type realTimer struct{ t *time.Timer }
+
+func (r realTimer) C() <-chan time.Time { return r.t.C }
+
+func (r realTimer) Stop() bool { return r.t.Stop() }
@@ -16,6 +16,7 @@ import (
 type Cron struct {
 	parser    ScheduleParser
 	location  *time.Location
+	clock     Clock
 	stop      chan struct{}
 	add       chan *Entry
 	remove    chan EntryID
@@ -98,6 +99,7 @@ func New(opts ...Option) *Cron {
 		runningMu: sync.Mutex{},
 		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
 		location:  time.Local,
+		clock:     realClock{},
 		parser:    standardParser,
 	}
 	for _, opt := range opts {
@@ -229,18 +231,18 @@ func (c *Cron) run() {
 		// Determine the next entry to run.
 		sort.Sort(byTime(c.entries))
 
-		var timer *time.Timer
+		var timer Timer
 		if len(c.entries) == 0 || c.entries[0].Next.IsZero() {
 			// If there are no entries yet, just sleep - it still handles new entries
 			// and stop requests.
-			timer = time.NewTimer(100000 * time.Hour) //nolint:mnd // forever
+			timer = c.clock.NewTimer(100000 * time.Hour) //nolint:mnd // forever
 		} else {
-			timer = time.NewTimer(c.entries[0].Next.Sub(now))
+			timer = c.clock.NewTimer(c.entries[0].Next.Sub(now))
 		}
 
 		for {
 			select {
-			case now = <-timer.C:
+			case now = <-timer.C():
 				now = now.In(c.location)
 				c.logger.Info("wake", "now", now)
 
@@ -294,7 +296,7 @@ func (c *Cron) startJob(j Job) {
 
 // now returns current time in c location.
 func (c *Cron) now() time.Time {
-	return time.Now().In(c.location)
+	return c.clock.Now().In(c.location)
 }
 
 // Stop stops the cron scheduler if it is running; otherwise it does nothing.
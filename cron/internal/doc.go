@@ -10,7 +10,9 @@
 //   - ConstantDelaySchedule for @every duration expressions
 //
 // The parser supports the standard cron syntax with named months (jan-dec) and
-// days of week (sun-sat), ranges, steps, and wildcards.
+// days of week (sun-sat), ranges, steps, and wildcards. The day-of-month field
+// also accepts the Quartz-style specifiers L (last day of the month) and nW
+// (weekday nearest to day n, within the month).
 //
 // Example usage:
 //
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// getDomField parses the day-of-month field. In addition to the standard
+// syntax it accepts the Quartz-style specifiers:
+//
+//	L   last day of the month
+//	nW  weekday (Mon-Fri) nearest to day n, within the same month
+//
+// Specifiers may be mixed with standard ranges in a comma-separated list.
+// It returns the standard bits, whether L was given, and the days given with W.
+func getDomField(field string) (uint64, bool, uint64, error) {
+	var (
+		bits, weekday uint64
+		last          bool
+	)
+	for _, expr := range strings.FieldsFunc(field, func(r rune) bool { return r == ',' }) {
+		switch {
+		case strings.EqualFold(expr, "L"):
+			last = true
+		case len(expr) > 1 && (expr[len(expr)-1] == 'W' || expr[len(expr)-1] == 'w'):
+			day, err := mustParseInt(expr[:len(expr)-1])
+			if err != nil {
+				return 0, false, 0, fmt.Errorf("malformed W specifier %q: want a single day of month, e.g. 15W: %w", expr, err)
+			}
+			if day < dom.min || day > dom.max {
+				return 0, false, 0, fmt.Errorf("malformed W specifier %q: day must be between %d and %d", expr, dom.min, dom.max)
+			}
+			weekday |= 1 << day
+		case strings.ContainsAny(expr, "LlWw"):
+			return 0, false, 0, fmt.Errorf("malformed day-of-month specifier %q: L and W must stand alone, as L or nW", expr)
+		default:
+			bit, err := getRange(expr, dom)
+			if err != nil {
+				return 0, false, 0, err
+			}
+			bits |= bit
+		}
+	}
+	return bits, last, weekday, nil
+}
+
+// daysIn returns the number of days in t's month.
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// nearestWeekday returns the day of t's month that is the weekday nearest to
+// day, without leaving the month: a Saturday moves to Friday (or Monday if
+// day is the 1st) and a Sunday to Monday (or Friday if day is the last day).
+// It returns 0 if the month has fewer than day days.
+func nearestWeekday(t time.Time, day int) int {
+	last := daysIn(t)
+	if day > last {
+		return 0
+	}
+
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2 //nolint:mnd // Saturday 1st -> Monday 3rd
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2 //nolint:mnd // Sunday last -> Friday
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+// specialDomMatches reports whether t satisfies the schedule's L or W
+// day-of-month specifiers.
+func specialDomMatches(s *SpecSchedule, t time.Time) bool {
+	if s.DomLast && t.Day() == daysIn(t) {
+		return true
+	}
+	for day := int(dom.min); s.DomWeekday != 0 && day <= int(dom.max); day++ {
+		if s.DomWeekday&(1<<uint(day)) != 0 && nearestWeekday(t, day) == t.Day() { //nolint:gosec // range checked
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLastDayOfMonth(t *testing.T) {
+	tests := []struct {
+		from, expected time.Time
+	}{
+		// Leap years resolve to Feb 29, others to Feb 28.
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2100, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2100, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// 30- and 31-day months, and rolling into the next month.
+		{time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	sched, err := ParseStandard("TZ=UTC 0 0 L * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range tests {
+		if actual := sched.Next(c.from); !actual.Equal(c.expected) {
+			t.Errorf("L from %s: expected %s, got %s", c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestNearestWeekday(t *testing.T) {
+	tests := []struct {
+		spec           string
+		from, expected time.Time
+	}{
+		// 15th on a weekday fires that day (Mon 2024-01-15).
+		{"0 0 15W * *", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		// Saturday 2024-06-15 moves back to Friday the 14th.
+		{"0 0 15W * *", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)},
+		// Sunday 2024-09-15 moves forward to Monday the 16th.
+		{"0 0 15W * *", time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 16, 0, 0, 0, 0, time.UTC)},
+		// Saturday the 1st (2024-06-01) stays in the month: Monday the 3rd.
+		{"0 0 1W * *", time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC), time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		// Sunday the 31st (2024-03-31) stays in the month: Friday the 29th.
+		{"0 0 31W * *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC)},
+		// April has no 31st, so 31W next fires in May (Fri 2024-05-31).
+		{"0 0 31W * *", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+		// Mixed with a standard day.
+		{"0 0 1,15W * *", time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range tests {
+		sched, err := ParseStandard("TZ=UTC " + c.spec)
+		if err != nil {
+			t.Fatalf("%s: %v", c.spec, err)
+		}
+		if actual := sched.Next(c.from); !actual.Equal(c.expected) {
+			t.Errorf("%s from %s: expected %s, got %s", c.spec, c.from, c.expected, actual)
+		}
+	}
+}
+
+func TestDomSpecifierErrors(t *testing.T) {
+	tests := []struct{ expr, err string }{
+		{"0 0 W * *", "L and W must stand alone"},
+		{"0 0 LW * *", "malformed W specifier"},
+		{"0 0 1-5W * *", "malformed W specifier"},
+		{"0 0 32W * *", "day must be between 1 and 31"},
+		{"0 0 0W * *", "day must be between 1 and 31"},
+		{"0 0 L5 * *", "L and W must stand alone"},
+	}
+	for _, c := range tests {
+		actual, err := ParseStandard(c.expr)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s => expected %v, got %v", c.expr, c.err, err)
+		}
+		if actual != nil {
+			t.Errorf("expected nil schedule on error, got %v", actual)
+		}
+	}
+}
@@ -15,6 +15,16 @@ func WithLocation(loc *time.Location) Option {
 	}
 }
 
+// WithClock overrides the clock used to compute and wait for activation
+// times. A nil clock keeps the default, which uses the time package.
+func WithClock(clock Clock) Option {
+	return func(c *Cron) {
+		if clock != nil {
+			c.clock = clock
+		}
+	}
+}
+
 // WithSeconds overrides the parser used for interpreting job schedules to
 // include a seconds field as the first one.
 func WithSeconds() Option {
@@ -153,25 +153,35 @@ func (p Parser) Parse(spec string) (Schedule, error) {
 	}
 
 	var (
-		second     = field(fields[0], seconds)
-		minute     = field(fields[1], minutes)
-		hour       = field(fields[2], hours)
-		dayofmonth = field(fields[3], dom)
-		month      = field(fields[4], months)
-		dayofweek  = field(fields[5], dow)
+		second = field(fields[0], seconds)
+		minute = field(fields[1], minutes)
+		hour   = field(fields[2], hours)
+	)
+	if err != nil {
+		return nil, err
+	}
+	dayofmonth, lastDom, weekdayDom, err := getDomField(fields[3])
+	if err != nil {
+		return nil, err
+	}
+	var (
+		month     = field(fields[4], months)
+		dayofweek = field(fields[5], dow)
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &SpecSchedule{
-		Second:   second,
-		Minute:   minute,
-		Hour:     hour,
-		Dom:      dayofmonth,
-		Month:    month,
-		Dow:      dayofweek,
-		Location: loc,
+		Second:     second,
+		Minute:     minute,
+		Hour:       hour,
+		Dom:        dayofmonth,
+		Month:      month,
+		Dow:        dayofweek,
+		DomLast:    lastDom,
+		DomWeekday: weekdayDom,
+		Location:   loc,
 	}, nil
 }
 
@@ -12,6 +12,12 @@ type SpecSchedule struct {
 	Dom      uint64
 	Month    uint64
 	Dow      uint64
+
+	// DomLast matches the last day of the month ("L" in day-of-month).
+	DomLast bool
+	// DomWeekday holds days n given as "nW" in day-of-month, matching the
+	// weekday nearest to day n.
+	DomWeekday uint64
 }
 
 // bounds provides a range of acceptable values (plus a map of name to value).
@@ -186,8 +192,8 @@ WRAP:
 // restrictions are satisfied by the given time.
 func dayMatches(s *SpecSchedule, t time.Time) bool {
 	var (
-		domMatch = 1<<uint(t.Day())&s.Dom > 0     //nolint:gosec // range checked
-		dowMatch = 1<<uint(t.Weekday())&s.Dow > 0 //nolint:gosec // range checked
+		domMatch = 1<<uint(t.Day())&s.Dom > 0 || specialDomMatches(s, t) //nolint:gosec // range checked
+		dowMatch = 1<<uint(t.Weekday())&s.Dow > 0                        //nolint:gosec // range checked
 	)
 	if s.Dom&starBit > 0 || s.Dow&starBit > 0 {
 		return domMatch && dowMatch
@@ -0,0 +1,37 @@
+package cron
+
+import "time"
+
+// WithJitter delays each scheduled run of every job by a random duration in
+// [0, max), so jobs sharing a schedule such as @hourly do not all hit shared
+// resources at once. Only the individual run is delayed; the schedule itself
+// does not drift. TriggerNow runs immediately. Jobs registered with
+// [WithJobJitter] use their own bound instead.
+//
+// With gaz.App, use gaz.WithCronJitter.
+func WithJitter(maxDelay time.Duration) SchedulerOption {
+	return func(s *Scheduler) {
+		s.jitter = maxDelay
+	}
+}
+
+// JobOption configures a single job registered with RegisterJob.
+type JobOption func(*diJobWrapper)
+
+// WithJobJitter sets the jitter bound for one job, overriding [WithJitter].
+// Zero disables jitter for the job.
+//
+// With gaz.App, implement [JitterProvider] on the CronJob instead.
+func WithJobJitter(maxDelay time.Duration) JobOption {
+	return func(w *diJobWrapper) {
+		w.jitter = maxDelay
+	}
+}
+
+// JitterProvider is an optional interface for a CronJob that wants its own
+// jitter bound. gaz.App registers discovered jobs that implement it with
+// [WithJobJitter].
+type JitterProvider interface {
+	// Jitter returns the maximum random delay before each scheduled run.
+	Jitter() time.Duration
+}
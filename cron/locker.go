@@ -0,0 +1,42 @@
+package cron
+
+import "context"
+
+// Locker coordinates job executions across replicas of a service, so that
+// only one replica runs a given tick. Implementations typically wrap a Redis
+// or database lock keyed by job name.
+type Locker interface {
+	// Acquire tries to take the lock for jobName. If ok is true, release must
+	// be called when the execution finishes. If ok is false, another replica
+	// holds the lock and the execution is skipped. A non-nil err means the
+	// lock state is unknown; the execution is skipped and err is recorded as
+	// the job's last error.
+	Acquire(ctx context.Context, jobName string) (release func(), ok bool, err error)
+}
+
+// NoopLocker is the default Locker. It always acquires, so every replica
+// runs every tick.
+type NoopLocker struct{}
+
+// Acquire always succeeds.
+func (NoopLocker) Acquire(context.Context, string) (func(), bool, error) {
+	return func() {}, true, nil
+}
+
+// SchedulerOption configures a Scheduler.
+type SchedulerOption func(*Scheduler)
+
+// WithLocker sets the Locker consulted before each job execution, scheduled
+// or triggered. Jobs whose lock cannot be acquired are skipped.
+//
+// With gaz.App, register a Locker in the container instead; it is picked up
+// when cron jobs are discovered:
+//
+//	gaz.For[cron.Locker](c).Instance(cron.Locker(redisLocker))
+func WithLocker(l Locker) SchedulerOption {
+	return func(s *Scheduler) {
+		if l != nil {
+			s.locker = l
+		}
+	}
+}
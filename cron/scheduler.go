@@ -2,6 +2,7 @@ package cron
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -24,6 +25,9 @@ type Scheduler struct {
 	logger   *slog.Logger
 	resolver Resolver
 	appCtx   context.Context
+	locker   Locker
+	jitter   time.Duration // Default max delay before scheduled runs (see WithJitter)
+	clock    Clock         // nil uses the system clock (see WithClock)
 
 	mu      sync.Mutex
 	jobs    []*diJobWrapper
@@ -41,22 +45,31 @@ type Scheduler struct {
 //   - resolver: Container interface for resolving job instances
 //   - appCtx: Application context (cancelled on shutdown)
 //   - logger: Logger for structured logging
-func NewScheduler(resolver Resolver, appCtx context.Context, logger *slog.Logger) *Scheduler {
+//   - opts: Optional settings such as [WithLocker], [WithJitter], and [WithClock]
+func NewScheduler(resolver Resolver, appCtx context.Context, logger *slog.Logger, opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		logger:   logger.With("component", "cron.Scheduler"),
+		resolver: resolver,
+		appCtx:   appCtx,
+		locker:   NoopLocker{},
+		jobs:     make([]*diJobWrapper, 0),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	// Create internal instance with options
 	// Note: We use custom panic recovery in diJobWrapper, not internal.Recover()
 	// This gives us stack traces via slog
-	c := internal.New(
+	cronOpts := []internal.Option{
 		internal.WithLogger(logger),
 		internal.WithChain(internal.SkipIfStillRunning(logger)),
-	)
-
-	return &Scheduler{
-		cron:     c,
-		logger:   logger.With("component", "cron.Scheduler"),
-		resolver: resolver,
-		appCtx:   appCtx,
-		jobs:     make([]*diJobWrapper, 0),
 	}
+	if s.clock != nil {
+		cronOpts = append(cronOpts, internal.WithClock(internalClock{s.clock}))
+	}
+	s.cron = internal.New(cronOpts...)
+	return s
 }
 
 // Name implements worker.Worker interface.
@@ -116,10 +129,11 @@ func (s *Scheduler) OnStop(ctx context.Context) error {
 //   - jobName: Human-readable name for logging
 //   - schedule: Cron schedule expression (empty string disables job)
 //   - timeout: Job execution timeout (0 for no timeout)
+//   - opts: Optional per-job settings such as [WithJobJitter]
 //
 // Returns error if schedule expression is invalid.
 // Empty schedule is not an error - the job is simply not scheduled (soft disable).
-func (s *Scheduler) RegisterJob(serviceName, jobName, schedule string, timeout time.Duration) error {
+func (s *Scheduler) RegisterJob(serviceName, jobName, schedule string, timeout time.Duration, opts ...JobOption) error {
 	// Empty schedule disables the job (per CONTEXT.md)
 	if schedule == "" {
 		s.logger.Info("job schedule disabled", slog.String("job", jobName))
@@ -136,6 +150,12 @@ func (s *Scheduler) RegisterJob(serviceName, jobName, schedule string, timeout t
 		s.appCtx,
 		s.logger,
 	)
+	wrapper.locker = s.locker
+	wrapper.jitter = s.jitter
+	wrapper.clock = s.clock
+	for _, opt := range opts {
+		opt(wrapper)
+	}
 
 	// Register with internal (same API as robfig/cron)
 	// AddJob validates the schedule expression and returns error if invalid
@@ -156,6 +176,107 @@ func (s *Scheduler) RegisterJob(serviceName, jobName, schedule string, timeout t
 	return nil
 }
 
+// TriggerNow runs the named job immediately, outside its schedule, and waits
+// for it to finish. Like a scheduled run, it resolves a fresh job instance and
+// applies the job's timeout.
+//
+// Overlapping executions are skipped as for scheduled runs: TriggerNow returns
+// ErrJobRunning if the job is executing, and a scheduled tick that fires
+// during a triggered run is skipped. It returns ErrJobLocked if the Locker
+// reports another replica holds the job's lock, ErrJobNotFound for unknown
+// names (including jobs with an empty schedule), ErrJobFailed wrapping the
+// job's error if the run fails, and ctx's error if ctx is done first; the job
+// keeps running in that case.
+//
+// Example:
+//
+//	// "Run nightly cleanup now" admin action:
+//	err := scheduler.TriggerNow(ctx, "nightly-cleanup")
+func (s *Scheduler) TriggerNow(ctx context.Context, jobName string) error {
+	job, err := s.job(jobName)
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "triggering job", slog.String("job", jobName))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- job.tryRun()
+	}()
+
+	select {
+	case err := <-done:
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, ErrJobRunning), errors.Is(err, ErrJobLocked):
+			return fmt.Errorf("%w: %s", err, jobName)
+		default:
+			return fmt.Errorf("%w: %s: %w", ErrJobFailed, jobName, err)
+		}
+	case <-ctx.Done():
+		return fmt.Errorf("cron: trigger %s: %w", jobName, ctx.Err())
+	}
+}
+
+// Pause suspends scheduled executions of the named job, for example during a
+// maintenance window. Ticks that fire while the job is paused are skipped,
+// not queued, so after Resume the job next runs at its following scheduled
+// time. TriggerNow still runs a paused job. Pausing a paused job is a no-op.
+//
+// It returns ErrJobNotFound for unknown names.
+func (s *Scheduler) Pause(jobName string) error {
+	job, err := s.job(jobName)
+	if err != nil {
+		return err
+	}
+	if !job.paused.Swap(true) {
+		s.logger.Info("job paused", slog.String("job", jobName))
+	}
+	return nil
+}
+
+// Resume re-enables scheduled executions of a job suspended with Pause or
+// PauseAll. It returns ErrJobNotFound for unknown names.
+func (s *Scheduler) Resume(jobName string) error {
+	job, err := s.job(jobName)
+	if err != nil {
+		return err
+	}
+	if job.paused.Swap(false) {
+		s.logger.Info("job resumed", slog.String("job", jobName))
+	}
+	return nil
+}
+
+// PauseAll suspends scheduled executions of every registered job.
+// See Pause.
+func (s *Scheduler) PauseAll() {
+	for _, job := range s.Jobs() {
+		job.paused.Store(true)
+	}
+	s.logger.Info("all jobs paused")
+}
+
+// ResumeAll re-enables scheduled executions of every registered job.
+func (s *Scheduler) ResumeAll() {
+	for _, job := range s.Jobs() {
+		job.paused.Store(false)
+	}
+	s.logger.Info("all jobs resumed")
+}
+
+// job returns the registered job with the given name, or ErrJobNotFound.
+func (s *Scheduler) job(jobName string) (*diJobWrapper, error) {
+	for _, j := range s.Jobs() {
+		if j.Name() == jobName {
+			return j, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrJobNotFound, jobName)
+}
+
 // HealthCheck checks if the scheduler is running.
 // Implements basic health check for CRN-09.
 func (s *Scheduler) HealthCheck(_ context.Context) error {
@@ -169,6 +290,20 @@ func (s *Scheduler) HealthCheck(_ context.Context) error {
 	return nil
 }
 
+// JobHealthCheck reports whether any registered job failed on its most recent
+// execution. It returns ErrJobFailed wrapped with the job name and the job's
+// last error, or nil if every job's last run succeeded (or has not run yet).
+// A job that succeeds on a later run clears its failure. gaz.App registers
+// it as the "cron" readiness check when jobs are scheduled.
+func (s *Scheduler) JobHealthCheck(_ context.Context) error {
+	for _, job := range s.Jobs() {
+		if err := job.LastError(); err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrJobFailed, job.Name(), err)
+		}
+	}
+	return nil
+}
+
 // JobCount returns the number of registered jobs.
 // Useful for testing and logging.
 func (s *Scheduler) JobCount() int {
@@ -194,3 +329,41 @@ func (s *Scheduler) Jobs() []*diJobWrapper {
 	copy(result, s.jobs)
 	return result
 }
+
+// JobStatus is a snapshot of a registered job's execution state.
+type JobStatus struct {
+	Name      string
+	Schedule  string
+	Running   bool
+	Paused    bool
+	LastRun   time.Time // zero if the job has not run
+	LastError error     // error from the last execution, nil on success
+	Runs      int64     // completed executions
+	Failures  int64     // executions that returned an error or panicked
+}
+
+// Status returns a snapshot of every registered job, in registration order.
+//
+// Example:
+//
+//	for _, job := range scheduler.Status() {
+//	    log.Printf("%s: %d runs, %d failures", job.Name, job.Runs, job.Failures)
+//	}
+func (s *Scheduler) Status() []JobStatus {
+	jobs := s.Jobs()
+	status := make([]JobStatus, 0, len(jobs))
+	for _, job := range jobs {
+		runs, failures := job.Runs()
+		status = append(status, JobStatus{
+			Name:      job.Name(),
+			Schedule:  job.Schedule(),
+			Running:   job.IsRunning(),
+			Paused:    job.IsPaused(),
+			LastRun:   job.LastRun(),
+			LastError: job.LastError(),
+			Runs:      runs,
+			Failures:  failures,
+		})
+	}
+	return status
+}
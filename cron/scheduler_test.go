@@ -221,6 +221,28 @@ func TestScheduler_HealthCheck_NotRunning(t *testing.T) {
 	assert.Contains(t, err.Error(), "scheduler not running")
 }
 
+func TestScheduler_JobHealthCheck(t *testing.T) {
+	resolver := newMockResolver()
+	ctx := context.Background()
+	logger := slog.Default()
+
+	scheduler := NewScheduler(resolver, ctx, logger)
+
+	err := scheduler.RegisterJob("failing", "failing-job", "@hourly", time.Minute)
+	require.NoError(t, err)
+
+	// No executions yet: healthy
+	require.NoError(t, scheduler.JobHealthCheck(ctx))
+
+	// Resolution failure is recorded as the job's last error
+	scheduler.Jobs()[0].Run()
+
+	err = scheduler.JobHealthCheck(ctx)
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrJobFailed)
+	assert.Contains(t, err.Error(), "failing-job")
+}
+
 func TestScheduler_Jobs(t *testing.T) {
 	resolver := newMockResolver()
 	ctx := context.Background()
@@ -316,3 +338,386 @@ func TestScheduler_PredefinedSchedules(t *testing.T) {
 		assert.Equal(t, i+1, scheduler.JobCount())
 	}
 }
+
+func TestScheduler_TriggerNow(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "cleanup", schedule: "@every 1h"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "cleanup", "@every 1h", 0))
+
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "cleanup"))
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "cleanup"))
+
+	assert.Equal(t, 2, job.getRunCount())
+	assert.Equal(t, 2, resolver.getResolveCalls(), "each trigger resolves a fresh instance")
+	assert.False(t, scheduler.Jobs()[0].LastRun().IsZero())
+
+	err := scheduler.TriggerNow(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestScheduler_TriggerNow_Timeout(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{
+		name: "slow",
+		runFn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "slow", "@every 1h", 20*time.Millisecond))
+
+	err := scheduler.TriggerNow(context.Background(), "slow")
+	require.ErrorIs(t, err, ErrJobFailed)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, scheduler.JobHealthCheck(context.Background()), ErrJobFailed)
+}
+
+func TestScheduler_TriggerNow_SkipsOverlap(t *testing.T) {
+	resolver := newMockResolver()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	job := &mockCronJob{
+		name: "blocking",
+		runFn: func(_ context.Context) error {
+			close(started)
+			<-release
+			return nil
+		},
+	}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "blocking", "@every 1h", 0))
+
+	triggered := make(chan error, 1)
+	go func() { triggered <- scheduler.TriggerNow(context.Background(), "blocking") }()
+	<-started
+
+	// A second trigger and a scheduled tick during the run are both skipped.
+	require.ErrorIs(t, scheduler.TriggerNow(context.Background(), "blocking"), ErrJobRunning)
+	scheduler.Jobs()[0].Run()
+
+	close(release)
+	require.NoError(t, <-triggered)
+	assert.Equal(t, 1, job.getRunCount())
+}
+
+func TestScheduler_TriggerNow_ContextDone(t *testing.T) {
+	resolver := newMockResolver()
+	release := make(chan struct{})
+	job := &mockCronJob{
+		name: "blocking",
+		runFn: func(_ context.Context) error {
+			<-release
+			return nil
+		},
+	}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "blocking", "@every 1h", 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := scheduler.TriggerNow(ctx, "blocking")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(release)
+}
+
+// fakeLocker grants the lock when allow is set and counts releases.
+type fakeLocker struct {
+	mu       sync.Mutex
+	allow    bool
+	err      error
+	acquired []string
+	released int
+}
+
+func (l *fakeLocker) Acquire(_ context.Context, jobName string) (func(), bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil || !l.allow {
+		return nil, false, l.err
+	}
+	l.acquired = append(l.acquired, jobName)
+	return func() {
+		l.mu.Lock()
+		l.released++
+		l.mu.Unlock()
+	}, true, nil
+}
+
+func newLockedScheduler(t *testing.T, locker Locker) (*Scheduler, *mockCronJob) {
+	t.Helper()
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "report"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default(), WithLocker(locker))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@every 1h", 0))
+	return scheduler, job
+}
+
+func TestScheduler_Pause_SkipsTicks(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "report"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@every 1h", 0))
+	wrapper := scheduler.Jobs()[0]
+
+	require.NoError(t, scheduler.Pause("report"))
+	assert.True(t, wrapper.IsPaused())
+
+	wrapper.Run() // Scheduled tick while paused
+	assert.Equal(t, 0, job.getRunCount(), "paused job must not run on its tick")
+	assert.Equal(t, 0, resolver.getResolveCalls())
+
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "report"))
+	assert.Equal(t, 1, job.getRunCount(), "TriggerNow runs a paused job")
+
+	require.NoError(t, scheduler.Resume("report"))
+	assert.False(t, wrapper.IsPaused())
+
+	wrapper.Run()
+	assert.Equal(t, 2, job.getRunCount(), "job fires again after Resume")
+}
+
+func TestScheduler_Pause_UnknownJob(t *testing.T) {
+	scheduler := NewScheduler(newMockResolver(), context.Background(), slog.Default())
+
+	require.ErrorIs(t, scheduler.Pause("missing"), ErrJobNotFound)
+	require.ErrorIs(t, scheduler.Resume("missing"), ErrJobNotFound)
+}
+
+func TestScheduler_PauseAll_ResumeAll(t *testing.T) {
+	resolver := newMockResolver()
+	first := &mockCronJob{name: "first"}
+	second := &mockCronJob{name: "second"}
+	resolver.services["*cron.firstJob"] = first
+	resolver.services["*cron.secondJob"] = second
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.firstJob", "first", "@every 1h", 0))
+	require.NoError(t, scheduler.RegisterJob("*cron.secondJob", "second", "@every 1h", 0))
+
+	tick := func() {
+		for _, j := range scheduler.Jobs() {
+			j.Run()
+		}
+	}
+
+	scheduler.PauseAll()
+	tick()
+	assert.Equal(t, 0, first.getRunCount())
+	assert.Equal(t, 0, second.getRunCount())
+
+	scheduler.ResumeAll()
+	tick()
+	assert.Equal(t, 1, first.getRunCount())
+	assert.Equal(t, 1, second.getRunCount())
+}
+
+func TestScheduler_WithJitter_DelayWithinBound(t *testing.T) {
+	const maxDelay = 50 * time.Millisecond
+	scheduler := NewScheduler(newMockResolver(), context.Background(), slog.Default(), WithJitter(maxDelay))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+	wrapper := scheduler.Jobs()[0]
+
+	for range 1000 {
+		delay := wrapper.jitterDelay()
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.Less(t, delay, maxDelay)
+	}
+}
+
+func TestScheduler_WithJitter_RunStartsWithinBound(t *testing.T) {
+	const maxDelay = 30 * time.Millisecond
+	resolver := newMockResolver()
+	var started time.Time
+	job := &mockCronJob{name: "report", runFn: func(context.Context) error {
+		started = time.Now()
+		return nil
+	}}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default(), WithJitter(maxDelay))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+	wrapper := scheduler.Jobs()[0]
+
+	for range 5 {
+		tick := time.Now()
+		wrapper.Run()
+		delay := started.Sub(tick)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		// Allow scheduling slack on top of the jitter bound.
+		assert.Less(t, delay, maxDelay+20*time.Millisecond)
+	}
+	assert.Equal(t, 5, job.getRunCount())
+}
+
+func TestScheduler_WithJitter_ZeroPreservesTiming(t *testing.T) {
+	scheduler := NewScheduler(newMockResolver(), context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+
+	assert.Zero(t, scheduler.Jobs()[0].jitterDelay())
+}
+
+func TestScheduler_WithJitter_UsesClock(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "report"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default(),
+		WithJitter(time.Hour), WithClock(clock))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scheduler.Jobs()[0].Run()
+	}()
+
+	// The jitter delay waits on the fake clock, not the wall clock.
+	require.True(t, clock.WaitForTimers(1, time.Second))
+	assert.Equal(t, 0, job.getRunCount())
+	clock.Advance(time.Hour)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("jitter delay did not end when the clock advanced")
+	}
+	assert.Equal(t, 1, job.getRunCount())
+}
+
+func TestScheduler_WithJobJitter_OverridesDefault(t *testing.T) {
+	scheduler := NewScheduler(newMockResolver(), context.Background(), slog.Default(), WithJitter(time.Hour))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "exact", "@hourly", 0, WithJobJitter(0)))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "spread", "@hourly", 0))
+
+	jobs := scheduler.Jobs()
+	assert.Zero(t, jobs[0].jitterDelay())
+	assert.Equal(t, time.Hour, jobs[1].Jitter())
+}
+
+func TestScheduler_WithJitter_TriggerNowNotDelayed(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "report"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default(), WithJitter(time.Hour))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+
+	start := time.Now()
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "report"))
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 1, job.getRunCount())
+}
+
+func TestScheduler_WithJitter_ShutdownCancelsDelay(t *testing.T) {
+	resolver := newMockResolver()
+	job := &mockCronJob{name: "report"}
+	resolver.services["*cron.mockCronJob"] = job
+
+	appCtx, cancel := context.WithCancel(context.Background())
+	scheduler := NewScheduler(resolver, appCtx, slog.Default(), WithJitter(time.Hour))
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@hourly", 0))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scheduler.Jobs()[0].Run()
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("jitter delay not cancelled by shutdown")
+	}
+	assert.Equal(t, 0, job.getRunCount())
+}
+
+func TestScheduler_WithLocker_Acquired(t *testing.T) {
+	locker := &fakeLocker{allow: true}
+	scheduler, job := newLockedScheduler(t, locker)
+
+	scheduler.Jobs()[0].Run()
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "report"))
+
+	assert.Equal(t, 2, job.getRunCount())
+	assert.Equal(t, []string{"report", "report"}, locker.acquired)
+	assert.Equal(t, 2, locker.released)
+}
+
+func TestScheduler_WithLocker_NotAcquired(t *testing.T) {
+	locker := &fakeLocker{allow: false}
+	scheduler, job := newLockedScheduler(t, locker)
+
+	scheduler.Jobs()[0].Run()
+	require.ErrorIs(t, scheduler.TriggerNow(context.Background(), "report"), ErrJobLocked)
+
+	assert.Equal(t, 0, job.getRunCount())
+	assert.True(t, scheduler.Jobs()[0].LastRun().IsZero())
+	assert.NoError(t, scheduler.JobHealthCheck(context.Background()), "a skipped tick is not a failure")
+}
+
+func TestScheduler_WithLocker_Error(t *testing.T) {
+	locker := &fakeLocker{err: errors.New("redis unavailable")}
+	scheduler, job := newLockedScheduler(t, locker)
+
+	scheduler.Jobs()[0].Run()
+
+	assert.Equal(t, 0, job.getRunCount())
+	err := scheduler.JobHealthCheck(context.Background())
+	require.ErrorIs(t, err, ErrJobFailed)
+	assert.Contains(t, err.Error(), "redis unavailable")
+}
+
+func TestScheduler_DefaultLocker(t *testing.T) {
+	scheduler := NewScheduler(newMockResolver(), context.Background(), slog.Default(), WithLocker(nil))
+	assert.Equal(t, NoopLocker{}, scheduler.locker)
+}
+
+func TestScheduler_Status(t *testing.T) {
+	resolver := newMockResolver()
+	fail := true
+	job := &mockCronJob{name: "report", schedule: "@every 1h", runFn: func(context.Context) error {
+		if fail {
+			return errors.New("upstream down")
+		}
+		return nil
+	}}
+	resolver.services["*cron.mockCronJob"] = job
+
+	scheduler := NewScheduler(resolver, context.Background(), slog.Default())
+	require.NoError(t, scheduler.RegisterJob("*cron.mockCronJob", "report", "@every 1h", 0))
+
+	status := scheduler.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, JobStatus{Name: "report", Schedule: "@every 1h"}, status[0])
+
+	require.ErrorIs(t, scheduler.TriggerNow(context.Background(), "report"), ErrJobFailed)
+	status = scheduler.Status()
+	assert.Equal(t, int64(1), status[0].Runs)
+	assert.Equal(t, int64(1), status[0].Failures)
+	require.Error(t, status[0].LastError)
+	assert.False(t, status[0].LastRun.IsZero())
+
+	fail = false
+	require.NoError(t, scheduler.TriggerNow(context.Background(), "report"))
+	status = scheduler.Status()
+	assert.Equal(t, int64(2), status[0].Runs)
+	assert.Equal(t, int64(1), status[0].Failures)
+	assert.NoError(t, status[0].LastError)
+}
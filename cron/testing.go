@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -130,6 +131,114 @@ func TestScheduler(resolver Resolver, logger *slog.Logger) *Scheduler {
 	return NewScheduler(resolver, context.Background(), logger)
 }
 
+// fakeClockPollInterval is how often FakeClock.WaitForTimers re-checks the
+// pending timers.
+const fakeClockPollInterval = time.Millisecond
+
+// FakeClock is a Clock whose time only moves when Advance is called, for
+// deterministic scheduler tests. Timers fire during Advance once their
+// deadline is reached.
+//
+// Example:
+//
+//	clock := cron.NewFakeClock(time.Now())
+//	s := cron.NewScheduler(resolver, ctx, logger, cron.WithClock(clock))
+//	// register jobs, start s
+//	clock.WaitForTimers(1, time.Second)
+//	clock.Advance(time.Minute) // "@every 1m" jobs are now due
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeClock creates a FakeClock set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a Timer that fires when the clock is advanced by d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, deadline: c.now.Add(d), ch: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d and fires every timer whose
+// deadline has been reached.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	var due []*fakeTimer
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.deadline.After(now) {
+			pending = append(pending, t)
+		} else {
+			due = append(due, t)
+		}
+	}
+	c.timers = pending
+	c.mu.Unlock()
+
+	for _, t := range due {
+		t.ch <- now
+	}
+}
+
+// PendingTimers returns the number of timers that have neither fired nor
+// been stopped.
+func (c *FakeClock) PendingTimers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// WaitForTimers waits, in real time, until at least n timers are pending.
+// Call it before Advance so a started scheduler is waiting on the clock.
+// It reports whether the timers appeared within timeout.
+func (c *FakeClock) WaitForTimers(n int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for c.PendingTimers() < n {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(fakeClockPollInterval)
+	}
+	return true
+}
+
+// fakeTimer is a Timer created by a FakeClock.
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.ch }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, pending := range t.clock.timers {
+		if pending == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // RequireJobRan asserts the job was executed at least once.
 func RequireJobRan(tb testing.TB, j *SimpleJob) {
 	tb.Helper()
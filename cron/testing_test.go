@@ -3,6 +3,8 @@ package cron
 import (
 	"context"
 	"errors"
+	"io"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -138,3 +140,56 @@ func TestRequireSchedulerNotRunning(t *testing.T) {
 	s := TestScheduler(nil, nil)
 	RequireSchedulerNotRunning(t, s) // Should not fail - scheduler not started
 }
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	assert.Equal(t, start, clock.Now())
+
+	timer := clock.NewTimer(time.Minute)
+	stopped := clock.NewTimer(time.Minute)
+	assert.True(t, stopped.Stop())
+	assert.False(t, stopped.Stop())
+	assert.Equal(t, 1, clock.PendingTimers())
+
+	clock.Advance(59 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	select {
+	case fired := <-timer.C():
+		assert.Equal(t, start.Add(time.Minute), fired)
+	default:
+		t.Fatal("timer did not fire at its deadline")
+	}
+	assert.Zero(t, clock.PendingTimers())
+	assert.False(t, timer.Stop())
+	select {
+	case <-stopped.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+}
+
+func TestSchedulerWithFakeClock(t *testing.T) {
+	clock := NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	job := NewSimpleJob("tick", "@every 1m")
+	resolver := NewMockResolver()
+	resolver.On("ResolveByName", "tick-svc", mock.Anything).Return(job, nil)
+
+	s := NewScheduler(resolver, context.Background(), slog.New(slog.NewTextHandler(io.Discard, nil)), WithClock(clock))
+	require.NoError(t, s.RegisterJob("tick-svc", job.Name(), job.Schedule(), job.Timeout()))
+	require.NoError(t, s.OnStart(context.Background()))
+	t.Cleanup(func() { _ = s.OnStop(context.Background()) })
+
+	require.True(t, clock.WaitForTimers(1, time.Second))
+	clock.Advance(30 * time.Second)
+	RequireJobNotRan(t, job)
+
+	clock.Advance(30 * time.Second)
+	require.Eventually(t, func() bool { return job.RunCount.Load() == 1 }, time.Second, time.Millisecond)
+}
@@ -2,10 +2,13 @@ package cron
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -37,11 +40,18 @@ type diJobWrapper struct {
 	timeout     time.Duration // Job timeout duration
 	appCtx      context.Context
 	logger      *slog.Logger
+	locker      Locker        // Consulted before each execution (default NoopLocker)
+	jitter      time.Duration // Max random delay before scheduled runs (0 = none)
+	clock       Clock         // Times the jitter delay (nil = system clock)
 
-	mu      sync.Mutex
-	running bool
-	lastRun time.Time
-	lastErr error
+	paused atomic.Bool // Scheduled ticks are skipped while set (see Scheduler.Pause)
+
+	mu       sync.Mutex
+	running  bool
+	lastRun  time.Time
+	lastErr  error
+	runs     int64 // completed executions
+	failures int64 // executions that returned an error or panicked
 }
 
 // NewJobWrapper creates a new DI-aware job wrapper.
@@ -71,29 +81,109 @@ func NewJobWrapper(
 		timeout:     timeout,
 		appCtx:      appCtx,
 		logger:      logger.With("component", "cron", "job", jobName),
+		locker:      NoopLocker{},
 	}
 }
 
 // Run implements cron/internal.Job interface.
 // This method is called by cron/internal scheduler on each scheduled execution.
+// The execution is skipped if the job is paused, if it is already running,
+// e.g. from TriggerNow, or if its lock is held by another replica.
+//
+// With jitter, the execution starts after a random delay below the jitter
+// bound. The delay applies to this run only; the next tick is unaffected.
 func (w *diJobWrapper) Run() {
+	if w.paused.Load() {
+		w.logger.Debug("job paused, skipping scheduled execution")
+		return
+	}
+	if delay := w.jitterDelay(); delay > 0 {
+		timer := w.newTimer(delay)
+		select {
+		case <-timer.C():
+		case <-w.appCtx.Done():
+			timer.Stop()
+			return
+		}
+		if w.paused.Load() {
+			w.logger.Debug("job paused, skipping scheduled execution")
+			return
+		}
+	}
+	switch err := w.tryRun(); {
+	case errors.Is(err, ErrJobRunning):
+		w.logger.Info("job still running, skipping scheduled execution")
+	case errors.Is(err, ErrJobLocked):
+		w.logger.Info("job locked by another instance, skipping scheduled execution")
+	}
+}
+
+// newTimer returns a Timer firing after d on the job's clock.
+func (w *diJobWrapper) newTimer(d time.Duration) Timer {
+	if w.clock != nil {
+		return w.clock.NewTimer(d)
+	}
+	return systemTimer{time.NewTimer(d)}
+}
+
+// jitterDelay returns a random delay in [0, jitter), or 0 without jitter.
+func (w *diJobWrapper) jitterDelay() time.Duration {
+	if w.jitter <= 0 {
+		return 0
+	}
+	return rand.N(w.jitter)
+}
+
+// tryRun executes the job unless an execution is already in progress or the
+// Locker denies the lock, returning ErrJobRunning or ErrJobLocked
+// respectively. Otherwise it returns the execution's error.
+func (w *diJobWrapper) tryRun() error {
 	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return ErrJobRunning
+	}
 	w.running = true
 	w.mu.Unlock()
 
 	defer func() {
 		w.mu.Lock()
 		w.running = false
-		w.lastRun = time.Now()
 		w.mu.Unlock()
 	}()
 
-	w.runWithRecovery()
+	release, ok, err := w.locker.Acquire(w.appCtx, w.jobName)
+	if err != nil {
+		err = fmt.Errorf("acquire lock: %w", err)
+		w.logger.Error("failed to acquire job lock", slog.String("error", err.Error()))
+		w.mu.Lock()
+		w.lastErr = err
+		w.mu.Unlock()
+		return err
+	}
+	if !ok {
+		return ErrJobLocked
+	}
+	if release != nil {
+		defer release()
+	}
+
+	err = w.runWithRecovery()
+
+	w.mu.Lock()
+	w.lastRun = time.Now()
+	w.runs++
+	if err != nil {
+		w.failures++
+	}
+	w.mu.Unlock()
+
+	return err
 }
 
 // runWithRecovery wraps executeJob with panic recovery.
 // Following the pattern from worker/supervisor.go.
-func (w *diJobWrapper) runWithRecovery() {
+func (w *diJobWrapper) runWithRecovery() (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			stack := debug.Stack()
@@ -101,27 +191,29 @@ func (w *diJobWrapper) runWithRecovery() {
 				slog.Any("panic", r),
 				slog.String("stack", string(stack)),
 			)
+			err = fmt.Errorf("panic: %v", r)
 			w.mu.Lock()
-			w.lastErr = fmt.Errorf("panic: %v", r)
+			w.lastErr = err
 			w.mu.Unlock()
 		}
 	}()
 
-	w.executeJob()
+	return w.executeJob()
 }
 
-// executeJob resolves and runs the job.
-func (w *diJobWrapper) executeJob() {
+// executeJob resolves and runs the job, recording and returning its error.
+func (w *diJobWrapper) executeJob() error {
 	// Resolve fresh instance from container (transient per execution)
 	instance, err := w.resolver.ResolveByName(w.serviceName, nil)
 	if err != nil {
 		w.logger.Error("failed to resolve job",
 			slog.String("error", err.Error()),
 		)
+		err = fmt.Errorf("resolve failed: %w", err)
 		w.mu.Lock()
-		w.lastErr = fmt.Errorf("resolve failed: %w", err)
+		w.lastErr = err
 		w.mu.Unlock()
-		return
+		return err
 	}
 
 	job, ok := instance.(CronJob)
@@ -129,10 +221,11 @@ func (w *diJobWrapper) executeJob() {
 		w.logger.Error("resolved instance is not CronJob",
 			slog.String("type", fmt.Sprintf("%T", instance)),
 		)
+		err = fmt.Errorf("type assertion failed: %T is not CronJob", instance)
 		w.mu.Lock()
-		w.lastErr = fmt.Errorf("type assertion failed: %T is not CronJob", instance)
+		w.lastErr = err
 		w.mu.Unlock()
-		return
+		return err
 	}
 
 	// Create context with timeout if specified
@@ -164,6 +257,7 @@ func (w *diJobWrapper) executeJob() {
 			slog.Duration("duration", elapsed),
 		)
 	}
+	return err
 }
 
 // IsRunning returns true if the job is currently executing.
@@ -190,6 +284,24 @@ func (w *diJobWrapper) LastError() error {
 	return w.lastErr
 }
 
+// Runs returns how many times the job has executed and how many of those
+// executions failed. Thread-safe for health check access.
+func (w *diJobWrapper) Runs() (runs, failures int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.runs, w.failures
+}
+
+// Jitter returns the maximum random delay applied before scheduled runs.
+func (w *diJobWrapper) Jitter() time.Duration {
+	return w.jitter
+}
+
+// IsPaused reports whether scheduled executions of the job are suspended.
+func (w *diJobWrapper) IsPaused() bool {
+	return w.paused.Load()
+}
+
 // Name returns the job name for logging/debugging.
 func (w *diJobWrapper) Name() string {
 	return w.jobName
@@ -1,8 +1,12 @@
 package di
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -28,9 +32,21 @@ type Container struct {
 	// buildOnce ensures Build() logic executes exactly once, even under concurrent calls.
 	buildOnce sync.Once
 
+	// buildDone is closed when the single Build() execution finishes.
+	// buildErr and buildPanic are written before it is closed.
+	buildDone chan struct{}
+
 	// buildErr captures the error (if any) from the single Build() execution.
 	buildErr error
 
+	// buildPanic captures a panic raised by the build itself, with its stack.
+	buildPanic *PanicError
+
+	// buildAbort is the error returned once a BuildWithContext caller gave
+	// up on a build that is still running. Guarded by buildMu.
+	buildAbort error
+	buildMu    sync.Mutex
+
 	// resolutionChains tracks active resolution chains per goroutine.
 	// This enables cycle detection when providers call Resolve[T]().
 	resolutionChains map[int64][]string
@@ -40,6 +56,10 @@ type Container struct {
 	// This is used for lifecycle management (ordered startup/shutdown).
 	dependencyGraph map[string][]string
 	graphMu         sync.RWMutex
+
+	// nextSeq numbers registrations so ResolveGroup can fall back to
+	// registration order. Guarded by mu.
+	nextSeq uint64
 }
 
 // New creates a new empty Container.
@@ -63,20 +83,41 @@ func New() *Container {
 }
 
 // Register adds a service to the container.
-// Returns ErrAlreadyBuilt if the container has already been built via Build().
+// Returns ErrAlreadyBuilt if the container has already been built via Build();
+// the error names the service and its type.
 // Exported for use by gaz.App for reflection-based registration.
 func (c *Container) Register(name string, svc ServiceWrapper) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if c.built {
-		return fmt.Errorf("%w: cannot register %s after Build()", ErrAlreadyBuilt, name)
+		return fmt.Errorf("%w: cannot register %s after Build(); register it before Build() or use Replace()",
+			ErrAlreadyBuilt, describeService(name, svc.TypeName()))
 	}
 
+	c.stampRegistration(svc)
 	c.services[name] = append(c.services[name], svc)
 	return nil
 }
 
+// stampRegistration records the registration order of svc.
+// Caller must hold c.mu.
+func (c *Container) stampRegistration(svc ServiceWrapper) {
+	if o, ok := svc.(groupOrdered); ok {
+		c.nextSeq++
+		o.setRegistrationSeq(c.nextSeq)
+	}
+}
+
+// describeService formats a service for error messages, including the type
+// when the service was registered under an explicit name.
+func describeService(name, typeName string) string {
+	if typeName == "" || name == typeName {
+		return name
+	}
+	return fmt.Sprintf("%q (type %s)", name, typeName)
+}
+
 // MustRegister adds a service to the container, panicking if registration fails.
 // Use only for internal framework registration where failure is fatal.
 func (c *Container) MustRegister(name string, svc ServiceWrapper) {
@@ -91,6 +132,7 @@ func (c *Container) MustRegister(name string, svc ServiceWrapper) {
 func (c *Container) ReplaceService(name string, svc ServiceWrapper) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.stampRegistration(svc)
 	c.services[name] = []ServiceWrapper{svc}
 }
 
@@ -151,6 +193,14 @@ func (c *Container) pushChain(name string) {
 	c.resolutionChains[gid] = append(c.resolutionChains[gid], name)
 }
 
+// setChain replaces the resolution chain for this goroutine.
+// Used to carry a parent chain into a provider run on another goroutine.
+func (c *Container) setChain(chain []string) {
+	c.chainMu.Lock()
+	defer c.chainMu.Unlock()
+	c.resolutionChains[getGoroutineID()] = chain
+}
+
 // clearChain removes the entire resolution chain entry for the current goroutine.
 // This ensures no stale entries remain after panic or goroutine ID reuse.
 func (c *Container) clearChain() {
@@ -175,7 +225,9 @@ func (c *Container) popChain() {
 
 // Build instantiates all eager services and validates the container.
 // Call this after all registrations and before any resolves.
-// Returns an error if any eager service fails to instantiate.
+// Returns the joined errors of every eager service that fails to
+// instantiate. A panicking provider is reported as a *PanicError naming the
+// service, rather than crashing the process.
 // Build() is idempotent - calling it multiple times is safe.
 //
 // Example:
@@ -186,44 +238,129 @@ func (c *Container) popChain() {
 //	    log.Fatalf("container build failed: %v", err)
 //	}
 func (c *Container) Build() error {
-	c.buildOnce.Do(func() {
-		// Collect eager services
-		var eagerServices []ServiceWrapper
-
-		c.mu.RLock()
-		for _, wrappers := range c.services {
-			for _, wrapper := range wrappers {
-				if wrapper.IsEager() {
-					eagerServices = append(eagerServices, wrapper)
-				}
-			}
+	return c.BuildWithContext(context.Background())
+}
+
+// BuildWithContext is like Build but returns as soon as ctx is done, so a
+// startup deadline aborts a hanging eager provider. Providers do not take a
+// context, so an aborted provider keeps running in the background; no
+// further eager services are instantiated and later Build calls return the
+// context error without waiting for it. Provider panics are returned like
+// in Build.
+func (c *Container) BuildWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("di: build aborted: %w", err)
+	}
+
+	done := c.startBuild(ctx)
+
+	c.buildMu.Lock()
+	aborted := c.buildAbort
+	c.buildMu.Unlock()
+	if aborted != nil {
+		select {
+		case <-done:
+			return c.buildResult()
+		default:
+			return aborted
 		}
-		c.mu.RUnlock()
+	}
 
-		// Instantiate each eager service
-		for _, svc := range eagerServices {
-			if err := c.resolveEager(svc); err != nil {
-				c.buildErr = fmt.Errorf("di: building eager service %s: %w", svc.Name(), err)
-				return
-			}
+	select {
+	case <-done:
+		return c.buildResult()
+	case <-ctx.Done():
+		err := fmt.Errorf("di: build aborted: %w", ctx.Err())
+		c.buildMu.Lock()
+		if c.buildAbort == nil {
+			c.buildAbort = err
 		}
+		c.buildMu.Unlock()
+		return err
+	}
+}
 
-		c.mu.Lock()
-		c.built = true
-		c.mu.Unlock()
+// startBuild runs the single build on its own goroutine, so callers can stop
+// waiting for it, and returns a channel closed once it finishes.
+func (c *Container) startBuild(ctx context.Context) <-chan struct{} {
+	c.buildOnce.Do(func() {
+		c.buildDone = make(chan struct{})
+		go func() {
+			defer close(c.buildDone)
+			defer func() {
+				if r := recover(); r != nil {
+					c.buildPanic = &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+			c.build(ctx)
+		}()
 	})
+	return c.buildDone
+}
 
+// buildResult returns the outcome of the finished build, re-raising a panic
+// from the build itself with its original stack.
+func (c *Container) buildResult() error {
+	if c.buildPanic != nil {
+		panic(c.buildPanic)
+	}
 	return c.buildErr
 }
 
+// build instantiates eager services, stopping early if ctx is done.
+// Runs exactly once, on the goroutine started by startBuild.
+func (c *Container) build(ctx context.Context) {
+	// Collect eager services
+	var eagerServices []ServiceWrapper
+
+	c.mu.RLock()
+	for _, wrappers := range c.services {
+		for _, wrapper := range wrappers {
+			if wrapper.IsEager() {
+				eagerServices = append(eagerServices, wrapper)
+			}
+		}
+	}
+	c.mu.RUnlock()
+
+	// Instantiate each eager service, collecting every failure so one broken
+	// provider does not hide the others.
+	var errs []error
+	for _, svc := range eagerServices {
+		if err := ctx.Err(); err != nil {
+			c.buildErr = errors.Join(append(errs, fmt.Errorf("di: build aborted: %w", err))...)
+			return
+		}
+		if err := c.resolveEager(svc); err != nil {
+			errs = append(errs, fmt.Errorf("di: building eager service %s: %w", svc.Name(), err))
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("di: build aborted: %w", err))
+	}
+	if len(errs) > 0 {
+		c.buildErr = errors.Join(errs...)
+		return
+	}
+
+	c.mu.Lock()
+	c.built = true
+	c.mu.Unlock()
+}
+
 // resolveEager resolves a single eager service during Build, with deferred chain cleanup.
-func (c *Container) resolveEager(svc ServiceWrapper) error {
+// A panic in the provider, or in a dependency it resolves, is returned as a *PanicError.
+func (c *Container) resolveEager(svc ServiceWrapper) (err error) {
 	name := svc.Name()
 	c.pushChain(name)
 	defer c.clearChain()
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Service: name, Value: r, Stack: debug.Stack()}
+		}
+	}()
 
-	_, err := svc.GetInstance(c, nil)
-	if err != nil {
+	if _, err = svc.GetInstance(c, nil); err != nil {
 		return fmt.Errorf("getting instance: %w", err)
 	}
 
@@ -237,28 +374,48 @@ func (c *Container) ResolveByName(name string, _ []string) (any, error) {
 	chain := c.getChain()
 
 	// Cycle detection - check if we're already resolving this service
-	for _, seen := range chain {
-		if seen == name {
-			cycle := append(append([]string{}, chain...), name)
-			return nil, fmt.Errorf("%w: %s", ErrCycle, strings.Join(cycle, " -> "))
-		}
+	if err := checkCycle(chain, name); err != nil {
+		return nil, err
 	}
 
 	// Look up service
 	c.mu.RLock()
-	wrappers, ok := c.services[name]
+	wrapper, err := c.lookupLocked(name)
 	c.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.resolveWrapper(name, wrapper, chain)
+}
+
+// checkCycle returns a *CycleError if name is already in chain.
+func checkCycle(chain []string, name string) error {
+	i := slices.Index(chain, name)
+	if i < 0 {
+		return nil
+	}
+	// Report only the loop, not the services that led into it
+	cycle := append(slices.Clone(chain[i:]), name)
+	return &CycleError{Path: cycle}
+}
 
+// lookupLocked returns the single wrapper registered under name.
+// The caller must hold c.mu.
+func (c *Container) lookupLocked(name string) (ServiceWrapper, error) {
+	wrappers, ok := c.services[name]
 	if !ok || len(wrappers) == 0 {
 		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
 	}
-
 	if len(wrappers) > 1 {
 		return nil, fmt.Errorf("%w: %s (found %d)", ErrAmbiguous, name, len(wrappers))
 	}
+	return wrappers[0], nil
+}
 
-	wrapper := wrappers[0]
-
+// resolveWrapper gets the instance of wrapper, registered as name, while
+// tracking the resolution chain for cycle detection and the dependency graph.
+func (c *Container) resolveWrapper(name string, wrapper ServiceWrapper, chain []string) (any, error) {
 	// Record dependency if we are being resolved by another service
 	if len(chain) > 0 {
 		parent := chain[len(chain)-1]
@@ -281,6 +438,11 @@ func (c *Container) ResolveByName(name string, _ []string) (any, error) {
 	// The provider may call Resolve[T]() which will check the chain
 	instance, err := wrapper.GetInstance(c, nil)
 	if err != nil {
+		// The cycle path already names every service in the chain.
+		if _, ok := errors.AsType[*CycleError](err); ok {
+			return nil, err
+		}
+
 		// Wrap error with resolution context
 		if len(chain) > 0 {
 			return nil, fmt.Errorf("di: resolving %s -> %s: %w",
@@ -336,6 +498,84 @@ func (c *Container) List() []string {
 	return names
 }
 
+// ServiceScope describes how a registered service is instantiated.
+type ServiceScope string
+
+const (
+	// ScopeSingleton is a lazy singleton, built on first resolution.
+	ScopeSingleton ServiceScope = "singleton"
+	// ScopeTransient builds a new instance on every resolution.
+	ScopeTransient ServiceScope = "transient"
+	// ScopeEager is a singleton built during Build().
+	ScopeEager ServiceScope = "eager"
+	// ScopeInstance is a pre-built value registered with Instance().
+	ScopeInstance ServiceScope = "instance"
+)
+
+// ServiceInfo describes a single registration, as reported by Services.
+type ServiceInfo struct {
+	// Name is the registration key (the type name unless Named() was used).
+	Name string
+	// TypeName is the fully-qualified type of the service.
+	TypeName string
+	// Scope is how the service is instantiated.
+	Scope ServiceScope
+	// Groups lists the groups the service was added to with InGroup().
+	Groups []string
+	// HasLifecycle reports whether the service implements Starter or Stopper.
+	HasLifecycle bool
+}
+
+// Services describes every registration in the container, sorted by name.
+// Multiple registrations under one name are listed in registration order.
+// It does not instantiate anything and is safe to call before or after Build(),
+// which makes it suitable for admin and debug endpoints.
+//
+// Example:
+//
+//	for _, svc := range c.Services() {
+//	    fmt.Printf("%s (%s) %s\n", svc.Name, svc.TypeName, svc.Scope)
+//	}
+func (c *Container) Services() []ServiceInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	names := make([]string, 0, len(c.services))
+	for name := range c.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	infos := make([]ServiceInfo, 0, len(names))
+	for _, name := range names {
+		for _, svc := range c.services[name] {
+			infos = append(infos, ServiceInfo{
+				Name:         name,
+				TypeName:     svc.TypeName(),
+				Scope:        scopeOf(svc),
+				Groups:       append([]string(nil), svc.Groups()...),
+				HasLifecycle: svc.HasLifecycle(),
+			})
+		}
+	}
+	return infos
+}
+
+// scopeOf classifies a service wrapper for Services.
+func scopeOf(svc ServiceWrapper) ServiceScope {
+	if _, ok := svc.(preBuiltService); ok {
+		return ScopeInstance
+	}
+	switch {
+	case svc.IsTransient():
+		return ScopeTransient
+	case svc.IsEager():
+		return ScopeEager
+	default:
+		return ScopeSingleton
+	}
+}
+
 // Has returns true if a service of type T is registered in the container.
 //
 // Example:
@@ -363,11 +603,8 @@ func (c *Container) ResolveAllByName(name string) ([]any, error) {
 
 	// Check for cycle on the group name itself if relevant, but really we care about individual instances.
 	// However, if we are resolving "foo", and "foo" depends on "foo" list, that is a cycle.
-	for _, seen := range chain {
-		if seen == name {
-			cycle := append(append([]string{}, chain...), name)
-			return nil, fmt.Errorf("%w: %s (in ResolveAllByName)", ErrCycle, strings.Join(cycle, " -> "))
-		}
+	if err := checkCycle(chain, name); err != nil {
+		return nil, err
 	}
 
 	// If this is a top-level call, defer clearChain for panic safety
@@ -391,6 +628,8 @@ func (c *Container) ResolveAllByName(name string) ([]any, error) {
 }
 
 // ResolveGroup resolves all services belonging to the specified group.
+// Members are returned sorted by their Order (ascending), then by
+// registration order, so middleware and plugin chains are deterministic.
 // Returns an empty slice if no services are found.
 func (c *Container) ResolveGroup(group string) ([]any, error) {
 	c.mu.RLock()
@@ -410,6 +649,7 @@ func (c *Container) ResolveGroup(group string) ([]any, error) {
 	if len(candidates) == 0 {
 		return []any{}, nil
 	}
+	sortGroupMembers(candidates)
 
 	var results []any
 	chain := c.getChain()
@@ -424,11 +664,8 @@ func (c *Container) ResolveGroup(group string) ([]any, error) {
 		name := wrapper.Name()
 
 		// Cycle detection per item
-		for _, seen := range chain {
-			if seen == name {
-				cycle := append(append([]string{}, chain...), name)
-				return nil, fmt.Errorf("%w: %s (in ResolveGroup)", ErrCycle, strings.Join(cycle, " -> "))
-			}
+		if err := checkCycle(chain, name); err != nil {
+			return nil, err
 		}
 
 		c.pushChain(name)
@@ -443,6 +680,50 @@ func (c *Container) ResolveGroup(group string) ([]any, error) {
 	return results, nil
 }
 
+// sortGroupMembers orders group members by Order, then registration order.
+// Wrappers not embedding baseService keep their relative position.
+func sortGroupMembers(members []ServiceWrapper) {
+	key := func(svc ServiceWrapper) (int, uint64) {
+		if o, ok := svc.(groupOrdered); ok {
+			return o.groupOrder(), o.registrationSeq()
+		}
+		return 0, 0
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		io, iseq := key(members[i])
+		jo, jseq := key(members[j])
+		if io != jo {
+			return io < jo
+		}
+		return iseq < jseq
+	})
+}
+
+// namesOfType returns the sorted names of services registered under an
+// explicit name for exactly type t. Registrations under the default type name
+// are not included.
+func (c *Container) namesOfType(t reflect.Type) []string {
+	defaultName := typeName(t)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var names []string
+	for name, wrappers := range c.services {
+		if name == defaultName {
+			continue
+		}
+		for _, wrapper := range wrappers {
+			if wrapper.ServiceType() == t {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ResolveAllByType resolves all services that are assignable to the given type.
 // This scans all registered services regardless of their registration name.
 func (c *Container) ResolveAllByType(t reflect.Type) ([]any, error) {
@@ -477,11 +758,8 @@ func (c *Container) ResolveAllByType(t reflect.Type) ([]any, error) {
 		name := wrapper.Name()
 
 		// Cycle detection per item
-		for _, seen := range chain {
-			if seen == name {
-				cycle := append(append([]string{}, chain...), name)
-				return nil, fmt.Errorf("%w: %s (in ResolveAllByType)", ErrCycle, strings.Join(cycle, " -> "))
-			}
+		if err := checkCycle(chain, name); err != nil {
+			return nil, err
 		}
 
 		c.pushChain(name)
@@ -1,10 +1,12 @@
 package di
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -79,6 +81,65 @@ func (s *ContainerSuite) TestBuild_EagerError_PropagatesWithContext() {
 	s.Contains(err.Error(), "startup failed")
 }
 
+func (s *ContainerSuite) TestBuildWithContext_CancelAbortsSlowEagerProvider() {
+	c := New()
+	release := make(chan struct{})
+	defer close(release)
+	err := For[*testEagerPool](c).Eager().Provider(func(_ *Container) (*testEagerPool, error) {
+		<-release
+		return &testEagerPool{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = c.BuildWithContext(ctx)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Less(time.Since(start), 2*time.Second, "Build should not wait for the provider")
+}
+
+func (s *ContainerSuite) TestBuildWithContext_AbortedBuildDoesNotBlockBuild() {
+	c := New()
+	release := make(chan struct{})
+	defer close(release)
+	err := For[*testEagerPool](c).Eager().Provider(func(_ *Container) (*testEagerPool, error) {
+		<-release
+		return &testEagerPool{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	s.Require().ErrorIs(c.BuildWithContext(ctx), context.DeadlineExceeded)
+
+	returned := make(chan error, 1)
+	go func() { returned <- c.Build() }()
+	select {
+	case err := <-returned:
+		s.ErrorIs(err, context.DeadlineExceeded)
+	case <-time.After(2 * time.Second):
+		s.FailNow("Build blocked on the abandoned provider")
+	}
+}
+
+func (s *ContainerSuite) TestBuildWithContext_CancelledSkipsEagerServices() {
+	c := New()
+	instantiated := false
+	err := For[*testEagerPool](c).Eager().Provider(func(_ *Container) (*testEagerPool, error) {
+		instantiated = true
+		return &testEagerPool{}, nil
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Require().ErrorIs(c.BuildWithContext(ctx), context.Canceled)
+	s.False(instantiated, "cancelled build must not instantiate eager services")
+}
+
 func (s *ContainerSuite) TestBuild_ResolveAfterBuild_ReturnsCachedEagerService() {
 	c := New()
 	callCount := 0
@@ -136,6 +197,90 @@ func (s *ContainerSuite) TestList_Sorted() {
 	}
 }
 
+// =============================================================================
+// Services() Tests
+// =============================================================================
+
+func (s *ContainerSuite) TestServices_Empty() {
+	s.Empty(New().Services())
+}
+
+func (s *ContainerSuite) TestServices_ReportsScopes() {
+	c := New()
+	s.Require().NoError(For[*testDatabase](c).ProviderFunc(func(*Container) *testDatabase { return &testDatabase{} }))
+	s.Require().NoError(For[*testLazyService](c).Transient().
+		ProviderFunc(func(*Container) *testLazyService { return &testLazyService{} }))
+	s.Require().NoError(For[*testApp](c).Eager().ProviderFunc(func(*Container) *testApp { return &testApp{} }))
+	s.Require().NoError(For[*testRegConfig](c).Instance(&testRegConfig{}))
+	s.Require().NoError(c.Register("raw", NewInstanceServiceAny("raw", "string", "value")))
+
+	scopes := make(map[string]ServiceScope)
+	for _, info := range c.Services() {
+		scopes[info.Name] = info.Scope
+	}
+	s.Equal(map[string]ServiceScope{
+		TypeName[*testDatabase]():    ScopeSingleton,
+		TypeName[*testLazyService](): ScopeTransient,
+		TypeName[*testApp]():         ScopeEager,
+		TypeName[*testRegConfig]():   ScopeInstance,
+		"raw":                        ScopeInstance,
+	}, scopes)
+}
+
+func (s *ContainerSuite) TestServices_GroupsAndLifecycle() {
+	c := New()
+	s.Require().NoError(For[*starterService](c).InGroup("workers").InGroup("startup").
+		ProviderFunc(func(*Container) *starterService { return &starterService{} }))
+	s.Require().NoError(For[*testDatabase](c).Named("plain").Instance(&testDatabase{}))
+
+	infos := c.Services()
+	s.Require().Len(infos, 2)
+
+	byName := make(map[string]ServiceInfo, len(infos))
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+	starter := byName[TypeName[*starterService]()]
+	s.Equal([]string{"workers", "startup"}, starter.Groups)
+	s.True(starter.HasLifecycle)
+
+	plain := byName["plain"]
+	s.Equal(TypeName[*testDatabase](), plain.TypeName)
+	s.Empty(plain.Groups)
+	s.False(plain.HasLifecycle)
+}
+
+func (s *ContainerSuite) TestServices_SortedAndMultiBinding() {
+	c := New()
+	s.Require().NoError(For[*testDatabase](c).Named("b").Instance(&testDatabase{}))
+	s.Require().NoError(For[*testDatabase](c).Named("a").Instance(&testDatabase{}))
+	s.Require().NoError(For[*testDatabase](c).Named("a").Transient().
+		ProviderFunc(func(*Container) *testDatabase { return &testDatabase{} }))
+
+	infos := c.Services()
+	s.Require().Len(infos, 3)
+	s.Equal("a", infos[0].Name)
+	s.Equal(ScopeInstance, infos[0].Scope)
+	s.Equal("a", infos[1].Name)
+	s.Equal(ScopeTransient, infos[1].Scope)
+	s.Equal("b", infos[2].Name)
+}
+
+func (s *ContainerSuite) TestServices_AfterBuildDoesNotInstantiate() {
+	c := New()
+	built := false
+	s.Require().NoError(For[*testDatabase](c).ProviderFunc(func(*Container) *testDatabase {
+		built = true
+		return &testDatabase{}
+	}))
+	s.Require().NoError(c.Build())
+
+	infos := c.Services()
+	s.Require().Len(infos, 1)
+	s.Equal(ScopeSingleton, infos[0].Scope)
+	s.False(built, "Services should not resolve lazy singletons")
+}
+
 // =============================================================================
 // Has[T]() Tests
 // =============================================================================
@@ -530,10 +675,8 @@ func (s *ContainerSuite) TestResolutionChain_CleanedAfterBuildPanic() {
 		panic("eager provider exploded")
 	})
 
-	// Build should panic because the eager provider panics
-	s.Require().Panics(func() {
-		_ = c.Build()
-	})
+	// Build recovers the panic and reports it as an error
+	s.Require().ErrorIs(c.Build(), ErrProviderPanic)
 
 	// After panic recovery, the chain map should have no entry for this goroutine
 	c.chainMu.Lock()
@@ -635,3 +778,58 @@ func (s *ContainerSuite) TestNilInstanceServiceType_DoesNotPanic() {
 		s.Nil(st)
 	})
 }
+
+// testBrokenEager is an eager service whose provider returns an error.
+type testBrokenEager struct{}
+
+func (s *ContainerSuite) TestBuild_EagerPanicBecomesError() {
+	c := New()
+	s.Require().NoError(For[*testPanicking](c).Eager().Provider(func(_ *Container) (*testPanicking, error) {
+		panic("eager provider exploded")
+	}))
+
+	err := c.Build()
+	s.Require().ErrorIs(err, ErrProviderPanic)
+
+	var pe *PanicError
+	s.Require().ErrorAs(err, &pe)
+	s.Equal(TypeName[*testPanicking](), pe.Service)
+	s.Equal("eager provider exploded", pe.Value)
+	s.Contains(string(pe.Stack), "resolveEager")
+	s.Contains(err.Error(), "eager provider exploded")
+	s.Contains(err.Error(), TypeName[*testPanicking]())
+
+	// The container stays failed.
+	s.Require().ErrorIs(c.Build(), ErrProviderPanic)
+}
+
+func (s *ContainerSuite) TestBuild_ReportsEveryEagerFailure() {
+	c := New()
+	s.Require().NoError(For[*testPanicking](c).Eager().Provider(func(_ *Container) (*testPanicking, error) {
+		panic("eager provider exploded")
+	}))
+	s.Require().NoError(For[*testBrokenEager](c).Eager().Provider(func(_ *Container) (*testBrokenEager, error) {
+		return nil, errors.New("broken dependency")
+	}))
+
+	err := c.Build()
+	s.Require().ErrorIs(err, ErrProviderPanic)
+	s.Contains(err.Error(), "broken dependency")
+	s.Contains(err.Error(), TypeName[*testBrokenEager]())
+}
+
+func (s *ContainerSuite) TestBuild_PanicInLazyDependencyNamesEagerService() {
+	c := New()
+	s.Require().NoError(For[*testBrokenEager](c).Provider(func(_ *Container) (*testBrokenEager, error) {
+		panic("lazy dependency exploded")
+	}))
+	s.Require().NoError(For[*testPanicking](c).Eager().Provider(func(c *Container) (*testPanicking, error) {
+		_, err := Resolve[*testBrokenEager](c)
+		return &testPanicking{}, err
+	}))
+
+	var pe *PanicError
+	s.Require().ErrorAs(c.Build(), &pe)
+	s.Equal(TypeName[*testPanicking](), pe.Service)
+	s.Equal("lazy dependency exploded", pe.Value)
+}
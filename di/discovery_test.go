@@ -73,9 +73,135 @@ func (s *DiscoverySuite) TestResolveGroup() {
 	s.Len(results, 2)
 }
 
+// discNamed is a group member identified by name, for ordering tests.
+type discNamed struct{ name string }
+
+func (i *discNamed) GetValue() string { return i.name }
+
+// groupValues returns the GetValue of each member of group, in order.
+func groupValues(c *Container, group string) ([]string, error) {
+	results, err := ResolveGroup[discService](c, group)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(results))
+	for i, r := range results {
+		values[i] = r.GetValue()
+	}
+	return values, nil
+}
+
+func (s *DiscoverySuite) TestResolveGroup_OrderAscending() {
+	c := New()
+	for _, m := range []struct {
+		name  string
+		order int
+	}{{"third", 30}, {"first", -5}, {"second", 10}} {
+		s.Require().NoError(For[*discNamed](c).Named(m.name).InGroup("chain").Order(m.order).
+			Instance(&discNamed{name: m.name}))
+	}
+
+	values, err := groupValues(c, "chain")
+	s.Require().NoError(err)
+	s.Equal([]string{"first", "second", "third"}, values)
+}
+
+func (s *DiscoverySuite) TestResolveGroup_EqualOrderKeepsRegistrationOrder() {
+	c := New()
+	names := []string{"e", "b", "d", "a", "c", "f", "h", "g"}
+	for _, name := range names {
+		s.Require().NoError(For[*discNamed](c).Named(name).InGroup("chain").
+			ProviderFunc(func(_ *Container) *discNamed { return &discNamed{name: name} }))
+	}
+	s.Require().NoError(For[*discNamed](c).Named("last").InGroup("chain").Order(1).
+		Instance(&discNamed{name: "last"}))
+
+	// Map iteration is random, so repeat to catch unstable ordering.
+	for range 10 {
+		values, err := groupValues(c, "chain")
+		s.Require().NoError(err)
+		s.Equal(append(names, "last"), values)
+	}
+}
+
 func (s *DiscoverySuite) TestResolveGroup_Empty() {
 	c := New()
 	results, err := ResolveGroup[discService](c, "nonexistent")
 	s.Require().NoError(err)
 	s.Empty(results)
 }
+
+// discAggregator collects the members of a group, as a plugin manager would.
+type discAggregator struct {
+	members []discService
+}
+
+func (s *DiscoverySuite) TestProviderWithGroup_PassesMembersInOrder() {
+	c := New()
+	s.Require().NoError(For[*discNamed](c).Named("late").InGroup("plugins").Order(20).
+		Instance(&discNamed{name: "late"}))
+	s.Require().NoError(For[*discNamed](c).Named("early").InGroup("plugins").Order(10).
+		Instance(&discNamed{name: "early"}))
+
+	s.Require().NoError(ProviderWithGroup(For[*discAggregator](c), "plugins",
+		func(_ *Container, plugins []discService) (*discAggregator, error) {
+			return &discAggregator{members: plugins}, nil
+		}))
+
+	agg, err := Resolve[*discAggregator](c)
+	s.Require().NoError(err)
+	s.Require().Len(agg.members, 2)
+	s.Equal("early", agg.members[0].GetValue())
+	s.Equal("late", agg.members[1].GetValue())
+}
+
+func (s *DiscoverySuite) TestProviderWithGroup_EmptyGroup() {
+	c := New()
+	var got []discService
+	s.Require().NoError(ProviderWithGroup(For[*discAggregator](c), "plugins",
+		func(_ *Container, plugins []discService) (*discAggregator, error) {
+			got = plugins
+			return &discAggregator{members: plugins}, nil
+		}))
+
+	_, err := Resolve[*discAggregator](c)
+	s.Require().NoError(err)
+	s.NotNil(got, "empty group should be an empty slice, not nil")
+	s.Empty(got)
+}
+
+func (s *DiscoverySuite) TestProviderWithGroup_KeepsBuilderOptions() {
+	c := New()
+	s.Require().NoError(For[*discImplA](c).InGroup("plugins").
+		ProviderFunc(func(_ *Container) *discImplA { return &discImplA{} }))
+
+	calls := 0
+	s.Require().NoError(ProviderWithGroup(For[*discAggregator](c).Named("agg").Transient(), "plugins",
+		func(_ *Container, plugins []discService) (*discAggregator, error) {
+			calls++
+			return &discAggregator{members: plugins}, nil
+		}))
+
+	first, err := Resolve[*discAggregator](c, Named("agg"))
+	s.Require().NoError(err)
+	second, err := Resolve[*discAggregator](c, Named("agg"))
+	s.Require().NoError(err)
+
+	s.NotSame(first, second)
+	s.Equal(2, calls)
+	s.Equal("A", first.members[0].GetValue())
+}
+
+func (s *DiscoverySuite) TestProviderWithGroup_MemberErrorFailsResolution() {
+	c := New()
+	s.Require().NoError(For[*discImplA](c).InGroup("plugins").
+		Provider(func(_ *Container) (*discImplA, error) { return nil, ErrNotFound }))
+	s.Require().NoError(ProviderWithGroup(For[*discAggregator](c), "plugins",
+		func(_ *Container, plugins []discService) (*discAggregator, error) {
+			return &discAggregator{members: plugins}, nil
+		}))
+
+	_, err := Resolve[*discAggregator](c)
+	s.Require().Error(err)
+	s.Contains(err.Error(), `resolving group "plugins"`)
+}
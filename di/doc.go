@@ -45,6 +45,7 @@
 //	di.ErrTypeMismatch → gaz.ErrDITypeMismatch
 //	di.ErrAlreadyBuilt → gaz.ErrDIAlreadyBuilt
 //	di.ErrInvalidProvider → gaz.ErrDIInvalidProvider
+//	di.ErrProviderTimeout → gaz.ErrDIProviderTimeout
 //
 // Both forms work with errors.Is:
 //
@@ -67,6 +68,17 @@
 //
 //	db, err := di.Resolve[*Database](c)
 //
+// ResolveBatch resolves several services at once, taking the container lock
+// once for all lookups. Targets are only set if every service resolves:
+//
+//	var db *Database
+//	var log *slog.Logger
+//	err := di.ResolveBatch(c, &db, &log)
+//
+// To check wiring without instantiating anything (e.g. in CI), call
+// [Container.Validate] instead of Build. It reports missing gaz:"inject"
+// dependencies and cycles without invoking providers.
+//
 // # Registration Patterns
 //
 // Services can be registered as singletons (default), transient, or eager:
@@ -76,6 +88,28 @@
 //	di.For[*Pool](c).Eager().Provider(NewPool)      // Eager singleton
 //	di.For[*Request](c).Transient().Provider(fn)    // New instance each time
 //
+// When construction needs runtime arguments, register a factory and call it:
+//
+//	di.For[*Client](c).Factory(func(c *di.Container, args ...any) (*Client, error) {
+//	    return NewClient(di.MustResolve[*Pool](c), args[0].(string)), nil
+//	})
+//	newClient, _ := di.ResolveFactory[*Client](c)
+//	acme, _ := newClient("acme")
+//
+// Inject registers a plain constructor and resolves its parameters by type.
+// Pass Named per parameter position to pick a named registration:
+//
+//	// NewService(db *Database, log *slog.Logger) (*Service, error)
+//	di.For[*Service](c).Inject(NewService)
+//	// NewReport's first parameter is the "replica" *Database
+//	di.For[*Report](c).Inject(NewReport, di.Named("replica"))
+//
+// Transient instances are not tracked, so they never get OnStop. Register a
+// cleanup and resolve with a request context to release them when it is done:
+//
+//	di.For[*Tx](c).Transient().WithCleanup(func(tx *Tx) { tx.Rollback() }).Provider(NewTx)
+//	tx, _ := di.ResolveTransientScoped[*Tx](r.Context(), c)
+//
 // # Named Services
 //
 // Multiple services of the same type can be registered with different names:
@@ -84,6 +118,36 @@
 //	di.For[*sql.DB](c).Named("replica").Provider(NewReplicaDB)
 //	primary, _ := di.Resolve[*sql.DB](c, di.Named("primary"))
 //
+// Resolving an unknown name lists the names registered for the type:
+//
+//	di: not found: primray (available: primary, replica)
+//
+// # Groups
+//
+// Services tagged with InGroup are resolved together with ResolveGroup, sorted
+// by Order and then by registration order, so chains are deterministic:
+//
+//	di.For[*Auth](c).Named("auth").InGroup("interceptors").Order(10).Provider(NewAuth)
+//	di.For[*Logging](c).Named("logging").InGroup("interceptors").Order(20).Provider(NewLogging)
+//	chain, _ := di.ResolveGroup[Interceptor](c, "interceptors")
+//
+// Aggregators can receive a group directly with ProviderWithGroup:
+//
+//	di.ProviderWithGroup(di.For[*Chain](c), "interceptors",
+//	    func(c *di.Container, members []Interceptor) (*Chain, error) {
+//	        return NewChain(members), nil
+//	    })
+//
+// # Resolve Hooks
+//
+// OnResolve registers a callback that receives each instance the provider
+// builds: once for a singleton and on every resolution for a transient.
+// Registrations without hooks are not wrapped and pay nothing:
+//
+//	di.For[*sql.DB](c).OnResolve(func(db *sql.DB) {
+//	    metrics.Inc("db_resolved")
+//	}).Provider(NewDB)
+//
 // # Lifecycle Hooks
 //
 // Services implementing Starter or Stopper interfaces automatically participate
@@ -105,5 +169,14 @@
 //	// Registration is simple - no lifecycle methods needed
 //	di.For[*Server](c).Provider(NewServer)
 //
+// # Introspection
+//
+// Services describes every registration without instantiating anything, so it
+// can back admin or debug endpoints before or after Build():
+//
+//	for _, svc := range c.Services() {
+//	    fmt.Println(svc.Name, svc.Scope, svc.Groups, svc.HasLifecycle)
+//	}
+//
 // See the gaz package for full application examples with lifecycle management.
 package di
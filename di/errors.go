@@ -1,6 +1,10 @@
 package di
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // DI sentinel errors with standardized "di: action" format.
 // These are the canonical source of truth for DI errors.
@@ -34,7 +38,61 @@ var (
 	// Check with: errors.Is(err, di.ErrInvalidProvider) or errors.Is(err, gaz.ErrDIInvalidProvider).
 	ErrInvalidProvider = errors.New("di: invalid provider")
 
+	// ErrProviderTimeout is returned when a provider registered with WithProviderTimeout
+	// does not return within its timeout.
+	// Check with: errors.Is(err, di.ErrProviderTimeout) or errors.Is(err, gaz.ErrDIProviderTimeout).
+	ErrProviderTimeout = errors.New("di: provider timeout")
+
+	// ErrProviderPanic is returned by Build when an eager provider panics.
+	// Check with: errors.Is(err, di.ErrProviderPanic) or errors.Is(err, gaz.ErrDIProviderPanic).
+	ErrProviderPanic = errors.New("di: provider panic")
+
 	// ErrAmbiguous is returned when multiple services are registered for the same key.
 	// Check with: errors.Is(err, di.ErrAmbiguous).
 	ErrAmbiguous = errors.New("di: ambiguous resolution: multiple services registered")
 )
+
+// CycleError reports a circular dependency with the full resolution path,
+// starting and ending at the same service (e.g. A -> B -> C -> A).
+// It matches ErrCycle with errors.Is; use errors.As to read the path.
+type CycleError struct {
+	// Path is the resolution chain that closed the loop.
+	Path []string
+}
+
+// Error returns the cycle as "di: circular dependency: A -> B -> C -> A".
+func (e *CycleError) Error() string {
+	return ErrCycle.Error() + ": " + strings.Join(e.Path, " -> ")
+}
+
+// Unwrap returns ErrCycle.
+func (e *CycleError) Unwrap() error {
+	return ErrCycle
+}
+
+// PanicError reports a panic recovered while building an eager service.
+// It matches ErrProviderPanic with errors.Is; use errors.As to read the
+// recovered value and stack.
+type PanicError struct {
+	// Service is the name of the eager service being built, or empty when
+	// the panic was raised by the build outside any provider.
+	Service string
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+// Error returns "di: provider panic: <service>: <value>" followed by the stack.
+// The service is omitted when empty.
+func (e *PanicError) Error() string {
+	if e.Service == "" {
+		return fmt.Sprintf("%s: %v\n%s", ErrProviderPanic.Error(), e.Value, e.Stack)
+	}
+	return fmt.Sprintf("%s: %s: %v\n%s", ErrProviderPanic.Error(), e.Service, e.Value, e.Stack)
+}
+
+// Unwrap returns ErrProviderPanic.
+func (e *PanicError) Unwrap() error {
+	return ErrProviderPanic
+}
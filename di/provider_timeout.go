@@ -0,0 +1,53 @@
+package di
+
+import (
+	"fmt"
+	"time"
+)
+
+// providerResult carries a provider's return values, or a recovered panic,
+// back from the goroutine running it.
+type providerResult[T any] struct {
+	value    T
+	err      error
+	panicked bool
+	panicVal any
+}
+
+// withProviderTimeout wraps fn so that it fails with ErrProviderTimeout if it
+// does not return within d. fn runs on its own goroutine, which inherits the
+// caller's resolution chain so cycle detection and dependency recording still
+// work for services it resolves. A panic in fn is re-raised on the caller.
+func withProviderTimeout[T any](fn func(*Container) (T, error), d time.Duration, typeName string) func(*Container) (T, error) {
+	return func(c *Container) (T, error) {
+		chain := append([]string(nil), c.getChain()...)
+		done := make(chan providerResult[T], 1)
+
+		go func() {
+			c.setChain(chain)
+			defer c.clearChain()
+			defer func() {
+				if r := recover(); r != nil {
+					done <- providerResult[T]{panicked: true, panicVal: r}
+				}
+			}()
+
+			value, err := fn(c)
+			done <- providerResult[T]{value: value, err: err}
+		}()
+
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case res := <-done:
+			if res.panicked {
+				panic(res.panicVal)
+			}
+			return res.value, res.err
+		case <-timer.C:
+			var zero T
+			return zero, fmt.Errorf("%w: provider for %s did not return within %s", ErrProviderTimeout, typeName, d)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package di
 
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
 // serviceScope defines the lifecycle scope for a registered service.
 type serviceScope int
 
@@ -18,12 +24,16 @@ const (
 // di.Stopper interfaces on your service type. These interfaces are auto-detected.
 type RegistrationBuilder[T any] struct {
 	container    *Container
-	name         string       // Registration key (default: type name)
-	typeName     string       // Type name for errors
-	scope        serviceScope // singleton or transient
-	lazy         bool         // lazy (default) or eager
-	allowReplace bool         // allow overwriting existing
-	groups       []string     // service groups
+	name         string        // Registration key (default: type name)
+	typeName     string        // Type name for errors
+	scope        serviceScope  // singleton or transient
+	lazy         bool          // lazy (default) or eager
+	allowReplace bool          // allow overwriting existing
+	groups       []string      // service groups
+	order        int           // position within groups
+	timeout      time.Duration // provider timeout (0 = none)
+	onResolve    []func(T)     // called with each instance the provider builds
+	cleanup      []func(T)     // transient cleanups run by ResolveTransientScoped
 }
 
 // For returns a registration builder for type T.
@@ -93,9 +103,79 @@ func (b *RegistrationBuilder[T]) InGroup(group string) *RegistrationBuilder[T] {
 	return b
 }
 
+// Order sets the service's position within its groups. ResolveGroup returns
+// members sorted by Order ascending; members with equal Order (the default
+// is 0) keep their registration order.
+//
+// Example:
+//
+//	di.For[*AuthInterceptor](c).InGroup("interceptors").Order(10).Provider(NewAuth)
+//	di.For[*LogInterceptor](c).InGroup("interceptors").Order(20).Provider(NewLog)
+func (b *RegistrationBuilder[T]) Order(n int) *RegistrationBuilder[T] {
+	b.order = n
+	return b
+}
+
+// WithProviderTimeout bounds how long the provider may run when the service
+// is resolved. If it does not return within d, resolution fails with
+// ErrProviderTimeout naming the type, so a hanging constructor (e.g. dialing
+// an unreachable database) surfaces during Build() instead of blocking it.
+// The provider keeps running in the background; its result is discarded.
+// Has no effect on Instance().
+//
+// Example:
+//
+//	di.For[*sql.DB](c).Eager().WithProviderTimeout(5*time.Second).Provider(openDB)
+func (b *RegistrationBuilder[T]) WithProviderTimeout(d time.Duration) *RegistrationBuilder[T] {
+	b.timeout = d
+	return b
+}
+
+// OnResolve registers a hook that is called with the instance each time the
+// provider builds one: once for a singleton, right after construction and
+// gaz:"inject" field injection, and on every resolution for a transient. It
+// is meant for cross-cutting concerns such as logging or metrics. Multiple
+// calls add hooks that run in order. Hooks are not called when the provider
+// or injection fails. Has no effect on Instance().
+//
+// Singleton hooks run after the instance is cached and its lock released,
+// so concurrent resolutions may receive the instance before its hooks have
+// finished. A hook that resolves the service it is attached to gets
+// ErrCycle instead of blocking.
+//
+// Example:
+//
+//	di.For[*sql.DB](c).OnResolve(func(db *sql.DB) {
+//	    log.Printf("resolved *sql.DB %p", db)
+//	}).Provider(openDB)
+func (b *RegistrationBuilder[T]) OnResolve(hook func(instance T)) *RegistrationBuilder[T] {
+	b.onResolve = append(b.onResolve, hook)
+	return b
+}
+
+// WithCleanup registers fn to release a transient instance's resources, such
+// as temp files or connections. Transient instances are not tracked by the
+// container, so their OnStop is never called; instead, fn runs for each
+// instance resolved with ResolveTransientScoped once that call's context is
+// done. Instances resolved with Resolve are not cleaned up. Cleanups run in
+// registration order.
+//
+// Only Transient registrations accept cleanups; Provider returns
+// ErrInvalidProvider otherwise.
+//
+// Example:
+//
+//	err := di.For[*TempDir](c).Transient().
+//	    WithCleanup(func(d *TempDir) { _ = os.RemoveAll(d.Path) }).
+//	    Provider(NewTempDir)
+func (b *RegistrationBuilder[T]) WithCleanup(fn func(instance T)) *RegistrationBuilder[T] {
+	b.cleanup = append(b.cleanup, fn)
+	return b
+}
+
 // Provider registers a provider function that creates the service instance.
 // The provider receives the container for resolving dependencies.
-// Returns an error if a service with the same name already exists (unless Replace() was called).
+// Returns ErrAlreadyBuilt if the container has been built (unless Replace() was called).
 //
 // Example:
 //
@@ -107,16 +187,32 @@ func (b *RegistrationBuilder[T]) InGroup(group string) *RegistrationBuilder[T] {
 //	    return &MyService{dep: dep}, nil
 //	})
 func (b *RegistrationBuilder[T]) Provider(fn func(*Container) (T, error)) error {
-	// Create appropriate service wrapper based on scope and lazy settings
+	if len(b.cleanup) > 0 && b.scope != scopeTransient {
+		return fmt.Errorf("%w: WithCleanup requires Transient for %s", ErrInvalidProvider, b.typeName)
+	}
+	if b.timeout > 0 {
+		fn = withProviderTimeout(fn, b.timeout, b.typeName)
+	}
+
+	// Create appropriate service wrapper based on scope and lazy settings.
+	// The wrappers run OnResolve hooks once struct injection succeeds.
 	var svc ServiceWrapper
 	switch {
 	case b.scope == scopeTransient:
-		svc = newTransient(b.name, b.typeName, fn, b.groups...)
+		t := newTransient(b.name, b.typeName, fn, b.groups...)
+		t.onResolve = b.onResolve
+		t.cleanup = b.cleanup
+		svc = t
 	case !b.lazy:
-		svc = newEagerSingleton(b.name, b.typeName, fn, b.groups...)
+		e := newEagerSingleton(b.name, b.typeName, fn, b.groups...)
+		e.onResolve = b.onResolve
+		svc = e
 	default:
-		svc = newLazySingleton(b.name, b.typeName, fn, b.groups...)
+		l := newLazySingleton(b.name, b.typeName, fn, b.groups...)
+		l.onResolve = b.onResolve
+		svc = l
 	}
+	b.applyOrder(svc)
 
 	if b.allowReplace {
 		b.container.ReplaceService(b.name, svc)
@@ -125,6 +221,96 @@ func (b *RegistrationBuilder[T]) Provider(fn func(*Container) (T, error)) error
 	return b.container.Register(b.name, svc)
 }
 
+// ProviderWithGroup registers a provider that receives the members of a
+// service group, resolved with ResolveGroup[P] and passed in group order.
+// Use it for aggregators such as plugin managers, so the group lookup is part
+// of the registration instead of hidden in the constructor. An empty group is
+// passed as an empty, non-nil slice. It is a function rather than a method
+// because Go methods cannot declare the extra type parameter P.
+//
+// Example:
+//
+//	err := di.ProviderWithGroup(di.For[*PluginManager](c), "plugins",
+//	    func(c *di.Container, plugins []Plugin) (*PluginManager, error) {
+//	        return NewPluginManager(plugins), nil
+//	    })
+func ProviderWithGroup[T, P any](
+	b *RegistrationBuilder[T],
+	group string,
+	fn func(*Container, []P) (T, error),
+) error {
+	typeName := b.typeName
+	return b.Provider(func(c *Container) (T, error) {
+		members, err := ResolveGroup[P](c, group)
+		if err != nil {
+			var zero T
+			return zero, fmt.Errorf("di: resolving group %q for %s: %w", group, typeName, err)
+		}
+		if members == nil {
+			members = []P{}
+		}
+		return fn(c, members)
+	})
+}
+
+// Factory creates a fresh instance of T from runtime arguments.
+// Obtain one with ResolveFactory after registering it with
+// RegistrationBuilder.Factory.
+type Factory[T any] func(args ...any) (T, error)
+
+// Factory registers a parameterized constructor for T. Instead of T itself,
+// the container holds a Factory[T] that calls fn with the container and the
+// arguments passed at call time, returning a new instance on every call.
+// Use it when construction needs runtime values (such as a tenant ID) as well
+// as container dependencies; Transient covers the argument-less case.
+//
+// OnResolve hooks run for each instance and WithProviderTimeout bounds each
+// call. Scope, Eager, and InGroup do not apply. Resolve the factory with
+// ResolveFactory, passing Named if the builder was named.
+//
+// Example:
+//
+//	err := di.For[*Client](c).Factory(func(c *di.Container, args ...any) (*Client, error) {
+//	    pool, err := di.Resolve[*Pool](c)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return NewClient(pool, args[0].(string)), nil
+//	})
+func (b *RegistrationBuilder[T]) Factory(fn func(c *Container, args ...any) (T, error)) error {
+	c := b.container
+	typeName := b.typeName
+	timeout := b.timeout
+	hooks := b.onResolve
+
+	factory := Factory[T](func(args ...any) (T, error) {
+		build := func(c *Container) (T, error) {
+			return fn(c, args...)
+		}
+		if timeout > 0 {
+			build = withProviderTimeout(build, timeout, typeName)
+		}
+		instance, err := build(c)
+		if err != nil {
+			return instance, err
+		}
+		runResolveHooks(hooks, instance)
+		return instance, nil
+	})
+
+	factoryType := TypeName[Factory[T]]()
+	name := b.name
+	if name == typeName {
+		name = factoryType
+	}
+	svc := newInstanceService(name, factoryType, factory)
+	if b.allowReplace {
+		c.ReplaceService(name, svc)
+		return nil
+	}
+	return c.Register(name, svc)
+}
+
 // ProviderFunc registers a simple provider function that creates the service instance.
 // Unlike Provider(), this variant does not return an error from the provider.
 // Use this for providers that cannot fail.
@@ -140,9 +326,97 @@ func (b *RegistrationBuilder[T]) ProviderFunc(fn func(*Container) T) error {
 	})
 }
 
+// Inject registers fn as a constructor whose parameters are resolved from
+// the container by type. fn must return T or (T, error). Pass params to
+// resolve parameters by name: params[i] applies to the i-th parameter, and a
+// nil entry keeps the by-type lookup. Scope, Eager, hooks, and timeouts apply
+// as with Provider.
+//
+// Returns ErrInvalidProvider if fn has the wrong signature. A missing
+// dependency fails at resolution with ErrNotFound, and a cycle with ErrCycle.
+//
+// Example:
+//
+//	err := di.For[*Service](c).Inject(func(db *Database, log *slog.Logger) (*Service, error) {
+//	    return NewService(db, log)
+//	})
+//
+//	err = di.For[*Report](c).Inject(NewReport, di.Named("replica"))
+func (b *RegistrationBuilder[T]) Inject(fn any, params ...ResolveOption) error {
+	fnVal := reflect.ValueOf(fn)
+	fnType := reflect.TypeOf(fn)
+	if err := checkInjectSignature(fnType, reflect.TypeFor[T](), len(params)); err != nil {
+		return fmt.Errorf("%w: Inject for %s: %v", ErrInvalidProvider, b.typeName, err)
+	}
+
+	names := make([]string, fnType.NumIn())
+	for i := range names {
+		names[i] = typeName(fnType.In(i))
+		if i < len(params) && params[i] != nil {
+			if o := applyOptions(params[i : i+1]); o.name != "" {
+				names[i] = o.name
+			}
+		}
+	}
+
+	target := b.typeName
+	return b.Provider(func(c *Container) (T, error) {
+		var zero T
+		args := make([]reflect.Value, len(names))
+		for i, name := range names {
+			paramType := fnType.In(i)
+			instance, err := c.ResolveByName(name, nil)
+			if err != nil {
+				return zero, fmt.Errorf("di: injecting parameter %d (%s) of %s: %w",
+					i, paramType, target, err)
+			}
+			if instance == nil {
+				args[i] = reflect.Zero(paramType)
+				continue
+			}
+			arg := reflect.ValueOf(instance)
+			if !arg.Type().AssignableTo(paramType) {
+				return zero, fmt.Errorf("%w: cannot assign %s to parameter %d (%s) of %s",
+					ErrTypeMismatch, arg.Type(), i, paramType, target)
+			}
+			args[i] = arg
+		}
+
+		out := fnVal.Call(args)
+		if len(out) == 2 && !out[1].IsNil() {
+			err, _ := out[1].Interface().(error)
+			return zero, err
+		}
+		result, _ := out[0].Interface().(T)
+		return result, nil
+	})
+}
+
+// checkInjectSignature reports why fnType cannot construct want with
+// numParams named parameters, or nil if it can.
+func checkInjectSignature(fnType reflect.Type, want reflect.Type, numParams int) error {
+	errorType := reflect.TypeFor[error]()
+	switch {
+	case fnType == nil || fnType.Kind() != reflect.Func:
+		return fmt.Errorf("expected a function, got %v", fnType)
+	case fnType.IsVariadic():
+		return fmt.Errorf("variadic function %v is not supported", fnType)
+	case numParams > fnType.NumIn():
+		return fmt.Errorf("%d parameter options for %d parameters", numParams, fnType.NumIn())
+	case fnType.NumOut() < 1 || fnType.NumOut() > 2:
+		return fmt.Errorf("%v must return %v or (%v, error)", fnType, want, want)
+	case !fnType.Out(0).AssignableTo(want):
+		return fmt.Errorf("%v returns %v, not %v", fnType, fnType.Out(0), want)
+	case fnType.NumOut() == 2 && fnType.Out(1) != errorType:
+		return fmt.Errorf("%v must return %v or (%v, error)", fnType, want, want)
+	}
+	return nil
+}
+
 // Instance registers a pre-built value as the service.
 // No provider is called - the value is returned directly on resolution.
 // This is useful for configuration objects or external dependencies.
+// Returns ErrAlreadyBuilt if the container has been built (unless Replace() was called).
 //
 // Example:
 //
@@ -150,9 +424,17 @@ func (b *RegistrationBuilder[T]) ProviderFunc(fn func(*Container) T) error {
 //	err := di.For[*Config](c).Instance(cfg)
 func (b *RegistrationBuilder[T]) Instance(val T) error {
 	svc := newInstanceService(b.name, b.typeName, val, b.groups...)
+	b.applyOrder(svc)
 	if b.allowReplace {
 		b.container.ReplaceService(b.name, svc)
 		return nil
 	}
 	return b.container.Register(b.name, svc)
 }
+
+// applyOrder sets the group order on the service wrapper.
+func (b *RegistrationBuilder[T]) applyOrder(svc ServiceWrapper) {
+	if o, ok := svc.(groupOrdered); ok {
+		o.setGroupOrder(b.order)
+	}
+}
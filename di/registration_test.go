@@ -1,7 +1,9 @@
 package di
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -269,3 +271,496 @@ func (s *RegistrationSuite) TestFor_Provider_ReturnsProviderError() {
 	_, resolveErr := Resolve[*testRegService](c)
 	s.Require().ErrorIs(resolveErr, providerErr)
 }
+
+// =============================================================================
+// Post-Build Registration Tests
+// =============================================================================
+
+func (s *RegistrationSuite) TestFor_AfterBuild_ReturnsErrAlreadyBuilt() {
+	newProvider := func(_ *Container) (*testRegService, error) {
+		return &testRegService{id: 1}, nil
+	}
+
+	tests := []struct {
+		name     string
+		register func(c *Container) error
+	}{
+		{"Provider", func(c *Container) error {
+			return For[*testRegService](c).Provider(newProvider)
+		}},
+		{"ProviderFunc", func(c *Container) error {
+			return For[*testRegService](c).ProviderFunc(func(_ *Container) *testRegService {
+				return &testRegService{id: 1}
+			})
+		}},
+		{"Instance", func(c *Container) error {
+			return For[*testRegService](c).Instance(&testRegService{id: 1})
+		}},
+		{"Transient", func(c *Container) error {
+			return For[*testRegService](c).Transient().Provider(newProvider)
+		}},
+		{"Eager", func(c *Container) error {
+			return For[*testRegService](c).Eager().Provider(newProvider)
+		}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			c := New()
+			s.Require().NoError(c.Build())
+
+			err := tt.register(c)
+			s.Require().ErrorIs(err, ErrAlreadyBuilt)
+			s.Contains(err.Error(), TypeName[*testRegService]())
+			s.False(Has[*testRegService](c), "late registration must not take effect")
+		})
+	}
+}
+
+func (s *RegistrationSuite) TestFor_Named_AfterBuild_NamesType() {
+	c := New()
+	s.Require().NoError(c.Build())
+
+	err := For[*testRegDB](c).Named("analytics").Instance(&testRegDB{name: "analytics"})
+	s.Require().ErrorIs(err, ErrAlreadyBuilt)
+	s.Contains(err.Error(), `"analytics"`)
+	s.Contains(err.Error(), TypeName[*testRegDB]())
+}
+
+func (s *RegistrationSuite) TestFor_BeforeBuild_Unchanged() {
+	c := New()
+
+	s.Require().NoError(For[*testRegService](c).Provider(func(_ *Container) (*testRegService, error) {
+		return &testRegService{id: 7}, nil
+	}))
+	s.Require().NoError(For[*testRegConfig](c).Transient().ProviderFunc(func(_ *Container) *testRegConfig {
+		return &testRegConfig{value: "v"}
+	}))
+	s.Require().NoError(For[*testRegDB](c).Instance(&testRegDB{name: "main"}))
+	s.Require().NoError(c.Build())
+
+	svc, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+	s.Equal(7, svc.id)
+}
+
+func (s *RegistrationSuite) TestFor_Replace_AfterBuild_Allowed() {
+	c := New()
+	s.Require().NoError(For[*testRegService](c).Instance(&testRegService{id: 1}))
+	s.Require().NoError(c.Build())
+
+	s.Require().NoError(For[*testRegService](c).Replace().Instance(&testRegService{id: 2}))
+
+	svc, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+	s.Equal(2, svc.id)
+}
+
+// =============================================================================
+// Provider Timeout Tests
+// =============================================================================
+
+func (s *RegistrationSuite) TestFor_WithProviderTimeout_SlowProviderTimesOut() {
+	c := New()
+	release := make(chan struct{})
+	defer close(release)
+
+	err := For[*testRegDB](c).Eager().WithProviderTimeout(20 * time.Millisecond).
+		Provider(func(_ *Container) (*testRegDB, error) {
+			<-release // Simulates dialing an unreachable database.
+			return &testRegDB{name: "slow"}, nil
+		})
+	s.Require().NoError(err)
+
+	start := time.Now()
+	buildErr := c.Build()
+	s.Require().ErrorIs(buildErr, ErrProviderTimeout)
+	s.Contains(buildErr.Error(), TypeName[*testRegDB]())
+	s.Less(time.Since(start), time.Second)
+}
+
+func (s *RegistrationSuite) TestFor_WithProviderTimeout_FastProviderSucceeds() {
+	c := New()
+	s.Require().NoError(For[*testRegConfig](c).Instance(&testRegConfig{value: "dsn"}))
+	s.Require().NoError(For[*testRegDB](c).WithProviderTimeout(time.Second).
+		Provider(func(c *Container) (*testRegDB, error) {
+			cfg, err := Resolve[*testRegConfig](c)
+			if err != nil {
+				return nil, err
+			}
+			return &testRegDB{name: cfg.value}, nil
+		}))
+	s.Require().NoError(c.Build())
+
+	db, err := Resolve[*testRegDB](c)
+	s.Require().NoError(err)
+	s.Equal("dsn", db.name)
+}
+
+func (s *RegistrationSuite) TestFor_WithProviderTimeout_KeepsCycleDetection() {
+	c := New()
+	s.Require().NoError(For[*testRegDB](c).WithProviderTimeout(time.Second).
+		Provider(func(c *Container) (*testRegDB, error) {
+			_, err := Resolve[*testRegService](c)
+			return &testRegDB{}, err
+		}))
+	s.Require().NoError(For[*testRegService](c).Provider(func(c *Container) (*testRegService, error) {
+		_, err := Resolve[*testRegDB](c)
+		return &testRegService{}, err
+	}))
+
+	_, err := Resolve[*testRegDB](c)
+	s.Require().ErrorIs(err, ErrCycle)
+}
+
+func (s *RegistrationSuite) TestFor_WithProviderTimeout_PropagatesPanic() {
+	c := New()
+	s.Require().NoError(For[*testRegDB](c).WithProviderTimeout(time.Second).
+		Provider(func(_ *Container) (*testRegDB, error) {
+			panic("boom")
+		}))
+
+	s.PanicsWithValue("boom", func() {
+		_, _ = Resolve[*testRegDB](c)
+	})
+}
+
+// =============================================================================
+// OnResolve Tests
+// =============================================================================
+
+func (s *RegistrationSuite) TestFor_OnResolve_SingletonFiresOnce() {
+	c := New()
+	var seen []*testRegService
+	s.Require().NoError(For[*testRegService](c).
+		OnResolve(func(svc *testRegService) { seen = append(seen, svc) }).
+		Provider(func(_ *Container) (*testRegService, error) {
+			return &testRegService{id: 1}, nil
+		}))
+
+	first, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+	second, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+
+	s.Same(first, second)
+	s.Require().Len(seen, 1)
+	s.Same(first, seen[0])
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_TransientFiresPerResolution() {
+	c := New()
+	next := 0
+	var seen []*testRegService
+	s.Require().NoError(For[*testRegService](c).Transient().
+		OnResolve(func(svc *testRegService) { seen = append(seen, svc) }).
+		Provider(func(_ *Container) (*testRegService, error) {
+			next++
+			return &testRegService{id: next}, nil
+		}))
+
+	var resolved []*testRegService
+	for range 3 {
+		svc, err := Resolve[*testRegService](c)
+		s.Require().NoError(err)
+		resolved = append(resolved, svc)
+	}
+
+	s.Equal(resolved, seen)
+	s.Equal(3, seen[2].id)
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_HooksRunInOrder() {
+	c := New()
+	var calls []string
+	s.Require().NoError(For[*testRegDB](c).
+		OnResolve(func(db *testRegDB) { calls = append(calls, "first:"+db.name) }).
+		OnResolve(func(db *testRegDB) { calls = append(calls, "second:"+db.name) }).
+		Provider(func(_ *Container) (*testRegDB, error) {
+			return &testRegDB{name: "main"}, nil
+		}))
+
+	_, err := Resolve[*testRegDB](c)
+	s.Require().NoError(err)
+	s.Equal([]string{"first:main", "second:main"}, calls)
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_SkippedOnProviderError() {
+	c := New()
+	called := false
+	s.Require().NoError(For[*testRegDB](c).
+		OnResolve(func(*testRegDB) { called = true }).
+		Provider(func(_ *Container) (*testRegDB, error) {
+			return nil, errors.New("dial failed")
+		}))
+
+	_, err := Resolve[*testRegDB](c)
+	s.Require().Error(err)
+	s.False(called)
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_HookResolvesSameService() {
+	for _, eager := range []bool{false, true} {
+		c := New()
+		var fromHook *testRegService
+		var hookErr error
+		builder := For[*testRegService](c).
+			OnResolve(func(*testRegService) {
+				fromHook, hookErr = Resolve[*testRegService](c)
+			})
+		if eager {
+			builder = builder.Eager()
+		}
+		s.Require().NoError(builder.Provider(func(_ *Container) (*testRegService, error) {
+			return &testRegService{id: 1}, nil
+		}))
+
+		done := make(chan struct{})
+		var svc *testRegService
+		var err error
+		go func() {
+			defer close(done)
+			svc, err = Resolve[*testRegService](c)
+		}()
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			s.FailNow("OnResolve hook resolving its own service blocked", "eager=%v", eager)
+		}
+
+		s.Require().NoError(err)
+		s.NotNil(svc)
+		s.Require().ErrorIs(hookErr, ErrCycle)
+		s.Nil(fromHook)
+	}
+}
+
+// testRegInjected has a field filled by gaz:"inject".
+type testRegInjected struct {
+	DB *testRegDB `gaz:"inject"`
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_RunsAfterInjection() {
+	c := New()
+	s.Require().NoError(For[*testRegDB](c).Instance(&testRegDB{name: "primary"}))
+	var injected *testRegDB
+	s.Require().NoError(For[*testRegInjected](c).
+		OnResolve(func(svc *testRegInjected) { injected = svc.DB }).
+		Provider(func(_ *Container) (*testRegInjected, error) {
+			return &testRegInjected{}, nil
+		}))
+
+	_, err := Resolve[*testRegInjected](c)
+	s.Require().NoError(err)
+	s.Require().NotNil(injected, "hook should see injected fields")
+	s.Equal("primary", injected.name)
+}
+
+func (s *RegistrationSuite) TestFor_OnResolve_SkippedOnInjectionError() {
+	c := New()
+	called := false
+	s.Require().NoError(For[*testRegInjected](c).Transient().
+		OnResolve(func(*testRegInjected) { called = true }).
+		Provider(func(_ *Container) (*testRegInjected, error) {
+			return &testRegInjected{}, nil
+		}))
+
+	_, err := Resolve[*testRegInjected](c)
+	s.Require().Error(err, "*testRegDB is not registered")
+	s.False(called)
+}
+
+// =============================================================================
+// Factory
+// =============================================================================
+
+// testTenantClient is built per tenant by a factory.
+type testTenantClient struct {
+	cfg    *testRegConfig
+	tenant string
+}
+
+func (s *RegistrationSuite) registerTenantFactory(c *Container) {
+	s.Require().NoError(For[*testRegConfig](c).Instance(&testRegConfig{value: "shared"}))
+	s.Require().NoError(For[*testTenantClient](c).Factory(func(c *Container, args ...any) (*testTenantClient, error) {
+		cfg, err := Resolve[*testRegConfig](c)
+		if err != nil {
+			return nil, err
+		}
+		return &testTenantClient{cfg: cfg, tenant: args[0].(string)}, nil
+	}))
+}
+
+func (s *RegistrationSuite) TestFactory_InjectsContainerDeps() {
+	c := New()
+	s.registerTenantFactory(c)
+
+	newClient, err := ResolveFactory[*testTenantClient](c)
+	s.Require().NoError(err)
+
+	client, err := newClient("acme")
+	s.Require().NoError(err)
+	s.Equal("shared", client.cfg.value)
+	s.Equal("acme", client.tenant)
+}
+
+func (s *RegistrationSuite) TestFactory_DistinctInstancesPerCall() {
+	c := New()
+	s.registerTenantFactory(c)
+
+	newClient, err := ResolveFactory[*testTenantClient](c)
+	s.Require().NoError(err)
+
+	a, err := newClient("acme")
+	s.Require().NoError(err)
+	b, err := newClient("globex")
+	s.Require().NoError(err)
+	again, err := newClient("acme")
+	s.Require().NoError(err)
+
+	s.Equal("globex", b.tenant)
+	s.NotSame(a, again, "each call must build a new instance")
+	s.Same(a.cfg, b.cfg, "container deps stay singletons")
+}
+
+func (s *RegistrationSuite) TestFactory_DoesNotRegisterT() {
+	c := New()
+	s.registerTenantFactory(c)
+
+	s.False(Has[*testTenantClient](c))
+	s.True(Has[Factory[*testTenantClient]](c))
+}
+
+func (s *RegistrationSuite) TestFactory_PropagatesErrors() {
+	c := New()
+	s.Require().NoError(For[*testTenantClient](c).Factory(func(c *Container, _ ...any) (*testTenantClient, error) {
+		_, err := Resolve[*testRegConfig](c)
+		return nil, err
+	}))
+
+	newClient, err := ResolveFactory[*testTenantClient](c)
+	s.Require().NoError(err)
+	_, err = newClient("acme")
+	s.Require().ErrorIs(err, ErrNotFound)
+}
+
+func (s *RegistrationSuite) TestFactory_NamedAndHooks() {
+	c := New()
+	var built []string
+	s.Require().NoError(For[*testTenantClient](c).Named("tenants").
+		OnResolve(func(t *testTenantClient) { built = append(built, t.tenant) }).
+		Factory(func(_ *Container, args ...any) (*testTenantClient, error) {
+			return &testTenantClient{tenant: args[0].(string)}, nil
+		}))
+
+	_, err := ResolveFactory[*testTenantClient](c)
+	s.Require().ErrorIs(err, ErrNotFound)
+
+	newClient, err := ResolveFactory[*testTenantClient](c, Named("tenants"))
+	s.Require().NoError(err)
+	_, err = newClient("acme")
+	s.Require().NoError(err)
+	_, err = newClient("globex")
+	s.Require().NoError(err)
+	s.Equal([]string{"acme", "globex"}, built)
+}
+
+// =============================================================================
+// Inject Tests
+// =============================================================================
+
+// testInjectA and testInjectB depend on each other to exercise cycle
+// detection through Inject.
+type testInjectA struct{ b *testInjectB }
+
+type testInjectB struct{ a *testInjectA }
+
+func (s *RegistrationSuite) TestInject_ResolvesParametersByType() {
+	c := New()
+	s.Require().NoError(For[*testRegConfig](c).Instance(&testRegConfig{value: "cfg"}))
+	s.Require().NoError(For[*testRegDB](c).Instance(&testRegDB{name: "main"}))
+	s.Require().NoError(For[*testRegService](c).Inject(
+		func(cfg *testRegConfig, db *testRegDB) *testRegService {
+			return &testRegService{id: len(cfg.value) + len(db.name)}
+		}))
+
+	svc, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+	s.Equal(7, svc.id)
+}
+
+func (s *RegistrationSuite) TestInject_NamedParameter() {
+	c := New()
+	s.Require().NoError(For[*testRegDB](c).Instance(&testRegDB{name: "main"}))
+	s.Require().NoError(For[*testRegDB](c).Named("replica").Instance(&testRegDB{name: "replica"}))
+
+	var got *testRegDB
+	s.Require().NoError(For[*testRegService](c).Inject(
+		func(db *testRegDB) (*testRegService, error) {
+			got = db
+			return &testRegService{}, nil
+		}, Named("replica")))
+
+	_, err := Resolve[*testRegService](c)
+	s.Require().NoError(err)
+	s.Equal("replica", got.name)
+}
+
+func (s *RegistrationSuite) TestInject_ReturnsConstructorError() {
+	c := New()
+	boom := errors.New("boom")
+	s.Require().NoError(For[*testRegService](c).Inject(func() (*testRegService, error) {
+		return nil, boom
+	}))
+
+	_, err := Resolve[*testRegService](c)
+	s.Require().ErrorIs(err, boom)
+}
+
+func (s *RegistrationSuite) TestInject_MissingDependencyFailsAtResolve() {
+	c := New()
+	called := false
+	s.Require().NoError(For[*testRegService](c).Inject(func(_ *testRegConfig) *testRegService {
+		called = true
+		return &testRegService{}
+	}))
+
+	_, err := Resolve[*testRegService](c)
+	s.Require().ErrorIs(err, ErrNotFound)
+	s.Contains(err.Error(), "parameter 0")
+	s.False(called)
+}
+
+func (s *RegistrationSuite) TestInject_DetectsCycle() {
+	c := New()
+	s.Require().NoError(For[*testInjectA](c).Inject(func(b *testInjectB) *testInjectA {
+		return &testInjectA{b: b}
+	}))
+	s.Require().NoError(For[*testInjectB](c).Inject(func(a *testInjectA) *testInjectB {
+		return &testInjectB{a: a}
+	}))
+
+	_, err := Resolve[*testInjectA](c)
+	s.Require().ErrorIs(err, ErrCycle)
+}
+
+func (s *RegistrationSuite) TestInject_InvalidSignature() {
+	cases := map[string]struct {
+		fn     any
+		params []ResolveOption
+	}{
+		"not a function":  {fn: 42},
+		"nil":             {fn: nil},
+		"no results":      {fn: func() {}},
+		"wrong type":      {fn: func() *testRegConfig { return nil }},
+		"non-error tail":  {fn: func() (*testRegService, string) { return nil, "" }},
+		"variadic":        {fn: func(_ ...*testRegDB) *testRegService { return nil }},
+		"too many params": {fn: func() *testRegService { return nil }, params: []ResolveOption{Named("x")}},
+	}
+	for name, tc := range cases {
+		s.Run(name, func() {
+			err := For[*testRegService](New()).Inject(tc.fn, tc.params...)
+			s.Require().ErrorIs(err, ErrInvalidProvider)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package di
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // Resolve retrieves a service of type T from the container.
@@ -10,7 +12,8 @@ import (
 // Use Named() option to resolve by a custom registration name.
 //
 // Returns (T, error) - the resolved instance or an error if:
-//   - Service not found (ErrNotFound)
+//   - Service not found (ErrNotFound); for an unknown Named() name, the error
+//     lists the names registered for T, e.g. "(available: primary, replica)"
 //   - Circular dependency detected (ErrCycle)
 //   - Provider returns an error (wrapped with resolution context)
 //   - Type assertion fails (ErrTypeMismatch)
@@ -40,6 +43,11 @@ func Resolve[T any](c *Container, opts ...ResolveOption) (T, error) {
 	instance, err := c.ResolveByName(name, chain)
 	if err != nil {
 		var zero T
+		if options.name != "" && !c.HasService(name) {
+			if names := c.namesOfType(reflect.TypeOf((*T)(nil)).Elem()); len(names) > 0 {
+				err = fmt.Errorf("%w (available: %s)", err, strings.Join(names, ", "))
+			}
+		}
 		return zero, err
 	}
 
@@ -53,6 +61,50 @@ func Resolve[T any](c *Container, opts ...ResolveOption) (T, error) {
 	return result, nil
 }
 
+// scopedCleaner is implemented by transient services registered with
+// WithCleanup.
+type scopedCleaner interface {
+	scopedCleanup(instance any) func()
+}
+
+// ResolveTransientScoped resolves a service like Resolve and, for transient
+// services registered with WithCleanup, runs the cleanups on the returned
+// instance once ctx is done. Use it with a request context so per-request
+// transients release their resources when the request ends. Each call gets
+// its own instance and its own cleanup.
+//
+// For services without cleanups it behaves like Resolve. A context that is
+// never done, such as context.Background(), never triggers the cleanup.
+//
+// Example:
+//
+//	func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    tx, err := di.ResolveTransientScoped[*Tx](r.Context(), h.container)
+//	    ...
+//	}
+func ResolveTransientScoped[T any](ctx context.Context, c *Container, opts ...ResolveOption) (T, error) {
+	result, err := Resolve[T](c, opts...)
+	if err != nil {
+		return result, err
+	}
+
+	name := applyOptions(opts).name
+	if name == "" {
+		name = TypeName[T]()
+	}
+	c.mu.RLock()
+	wrappers := c.services[name]
+	c.mu.RUnlock()
+	if len(wrappers) == 1 {
+		if sc, ok := wrappers[0].(scopedCleaner); ok {
+			if release := sc.scopedCleanup(result); release != nil {
+				context.AfterFunc(ctx, release)
+			}
+		}
+	}
+	return result, nil
+}
+
 // MustResolve resolves a service or panics if resolution fails.
 // Use only in test setup or main() initialization where failure is fatal.
 //
@@ -71,6 +123,51 @@ func MustResolve[T any](c *Container, opts ...ResolveOption) T {
 	return result
 }
 
+// ResolveFactory retrieves the Factory[T] registered with
+// RegistrationBuilder.Factory. Each call of the returned factory builds a new
+// instance of T from its arguments and the container's dependencies.
+// Returns ErrNotFound if no factory for T is registered.
+//
+// Example:
+//
+//	newClient, err := di.ResolveFactory[*Client](c)
+//	if err != nil {
+//	    return err
+//	}
+//	acme, err := newClient("acme")
+func ResolveFactory[T any](c *Container, opts ...ResolveOption) (Factory[T], error) {
+	return Resolve[Factory[T]](c, opts...)
+}
+
+// ResolveOr resolves a service of type T, or returns fallback() if no service
+// of type T is registered. The fallback result is not stored in the container,
+// so fallback runs on every call that finds nothing registered.
+// Panics if T is registered but resolution fails (e.g. its provider errors);
+// use ResolveOrErr to handle that case as an error.
+//
+// Example:
+//
+//	recorder := di.ResolveOr[metrics.Recorder](c, func() metrics.Recorder {
+//	    return metrics.NopRecorder{}
+//	})
+func ResolveOr[T any](c *Container, fallback func() T) T {
+	result, err := ResolveOrErr[T](c, fallback)
+	if err != nil {
+		panic(fmt.Sprintf("di.ResolveOr[%s]: %v", TypeName[T](), err))
+	}
+	return result
+}
+
+// ResolveOrErr is like ResolveOr but returns resolution errors instead of
+// panicking. A missing registration for T is not an error; fallback() is
+// returned instead. A missing dependency of T's provider is still an error.
+func ResolveOrErr[T any](c *Container, fallback func() T) (T, error) {
+	if !c.HasService(TypeName[T]()) {
+		return fallback(), nil
+	}
+	return Resolve[T](c)
+}
+
 // ResolveAll retrieves all registered services of type T.
 // This matches services registered by type T, or services implementing interface T.
 func ResolveAll[T any](c *Container) ([]T, error) {
@@ -110,3 +207,77 @@ func ResolveGroup[T any](c *Container, group string) ([]T, error) {
 	}
 	return results, nil
 }
+
+// ResolveBatch resolves one service per target, looking all of them up under
+// a single acquisition of the container lock. Each target must be a non-nil
+// pointer to a variable of the service type; the service is looked up by that
+// type's name, as Resolve does.
+//
+// The batch is all-or-nothing: if any target is invalid, not registered, or
+// fails to resolve, ResolveBatch returns an error naming the target's index
+// and type and leaves every target unchanged. Lookups happen before any
+// provider runs, so a missing service aborts the batch without instantiating
+// the others.
+//
+// Example:
+//
+//	var (
+//	    db    *sql.DB
+//	    cache *redis.Client
+//	)
+//	if err := di.ResolveBatch(c, &db, &cache); err != nil {
+//	    return err
+//	}
+func ResolveBatch(c *Container, targets ...any) error {
+	values := make([]reflect.Value, len(targets))
+	names := make([]string, len(targets))
+	for i, target := range targets {
+		v := reflect.ValueOf(target)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			return fmt.Errorf("%w: ResolveBatch target %d must be a non-nil pointer, got %T",
+				ErrTypeMismatch, i, target)
+		}
+		values[i] = v.Elem()
+		names[i] = typeName(v.Type().Elem())
+	}
+
+	wrappers := make([]ServiceWrapper, len(targets))
+	c.mu.RLock()
+	for i, name := range names {
+		wrapper, err := c.lookupLocked(name)
+		if err != nil {
+			c.mu.RUnlock()
+			return fmt.Errorf("di: ResolveBatch target %d (%s): %w", i, name, err)
+		}
+		wrappers[i] = wrapper
+	}
+	c.mu.RUnlock()
+
+	// Providers may resolve their own dependencies, so instances are built
+	// outside the lock.
+	chain := c.getChain()
+	instances := make([]reflect.Value, len(targets))
+	for i, wrapper := range wrappers {
+		if err := checkCycle(chain, names[i]); err != nil {
+			return fmt.Errorf("di: ResolveBatch target %d (%s): %w", i, names[i], err)
+		}
+		instance, err := c.resolveWrapper(names[i], wrapper, chain)
+		if err != nil {
+			return fmt.Errorf("di: ResolveBatch target %d (%s): %w", i, names[i], err)
+		}
+		iv := reflect.ValueOf(instance)
+		if !iv.IsValid() {
+			iv = reflect.Zero(values[i].Type())
+		}
+		if !iv.Type().AssignableTo(values[i].Type()) {
+			return fmt.Errorf("%w: ResolveBatch target %d: expected %s, got %T",
+				ErrTypeMismatch, i, names[i], instance)
+		}
+		instances[i] = iv
+	}
+
+	for i, iv := range instances {
+		values[i].Set(iv)
+	}
+	return nil
+}
@@ -1,8 +1,10 @@
 package di
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -38,6 +40,15 @@ type testResolveCyclicB struct {
 	a *testResolveCyclicA
 }
 
+// Three-node cycle test types (Alpha -> Beta -> Gamma -> Alpha), and a
+// Root that depends on Alpha from outside the loop.
+type (
+	testCycleRoot  struct{}
+	testCycleAlpha struct{}
+	testCycleBeta  struct{}
+	testCycleGamma struct{}
+)
+
 // Dependency chain test types.
 type testResolveDepA struct {
 	b *testResolveDepB
@@ -84,6 +95,27 @@ func (s *ResolutionSuite) TestResolve_NotFound() {
 	s.Contains(err.Error(), "testResolveServiceA", "error should contain type name")
 }
 
+func (s *ResolutionSuite) TestResolve_NamedNotFoundListsAvailable() {
+	c := New()
+	s.Require().NoError(For[*testResolveServiceA](c).Named("replica").Instance(&testResolveServiceA{}))
+	s.Require().NoError(For[*testResolveServiceA](c).Named("primary").Instance(&testResolveServiceA{}))
+	s.Require().NoError(For[*testResolveServiceB](c).Named("other").Instance(&testResolveServiceB{}))
+
+	_, err := Resolve[*testResolveServiceA](c, Named("primray"))
+
+	s.Require().ErrorIs(err, ErrNotFound)
+	s.Equal("di: not found: primray (available: primary, replica)", err.Error())
+}
+
+func (s *ResolutionSuite) TestResolve_NamedNotFoundUnregisteredType() {
+	c := New()
+
+	_, err := Resolve[*testResolveServiceA](c, Named("primary"))
+
+	s.Require().ErrorIs(err, ErrNotFound)
+	s.Equal("di: not found: primary", err.Error())
+}
+
 func (s *ResolutionSuite) TestResolve_Named() {
 	c := New()
 
@@ -149,6 +181,93 @@ func (s *ResolutionSuite) TestResolve_CycleDetection() {
 	s.Contains(errMsg, "testResolveCyclicB", "error should contain cyclicB")
 }
 
+// registerThreeNodeCycle registers Alpha -> Beta -> Gamma -> Alpha.
+func registerThreeNodeCycle(s *ResolutionSuite, c *Container, eagerAlpha bool) {
+	alpha := For[*testCycleAlpha](c)
+	if eagerAlpha {
+		alpha = alpha.Eager()
+	}
+	s.Require().NoError(alpha.Provider(func(c *Container) (*testCycleAlpha, error) {
+		_, err := Resolve[*testCycleBeta](c)
+		return &testCycleAlpha{}, err
+	}))
+	s.Require().NoError(For[*testCycleBeta](c).Provider(func(c *Container) (*testCycleBeta, error) {
+		_, err := Resolve[*testCycleGamma](c)
+		return &testCycleBeta{}, err
+	}))
+	s.Require().NoError(For[*testCycleGamma](c).Provider(func(c *Container) (*testCycleGamma, error) {
+		_, err := Resolve[*testCycleAlpha](c)
+		return &testCycleGamma{}, err
+	}))
+}
+
+func (s *ResolutionSuite) TestResolve_ThreeNodeCycleReportsFullPath() {
+	c := New()
+	registerThreeNodeCycle(s, c, false)
+
+	_, err := Resolve[*testCycleAlpha](c)
+	s.Require().ErrorIs(err, ErrCycle)
+
+	alpha := TypeName[*testCycleAlpha]()
+	beta := TypeName[*testCycleBeta]()
+	gamma := TypeName[*testCycleGamma]()
+	want := alpha + " -> " + beta + " -> " + gamma + " -> " + alpha
+
+	s.Equal("di: circular dependency: "+want, err.Error())
+
+	cycleErr, ok := errors.AsType[*CycleError](err)
+	s.Require().True(ok)
+	s.Equal([]string{alpha, beta, gamma, alpha}, cycleErr.Path)
+}
+
+func (s *ResolutionSuite) TestResolve_CyclePathExcludesServicesLeadingIn() {
+	c := New()
+	registerThreeNodeCycle(s, c, false)
+	s.Require().NoError(For[*testCycleRoot](c).Provider(func(c *Container) (*testCycleRoot, error) {
+		_, err := Resolve[*testCycleAlpha](c)
+		return &testCycleRoot{}, err
+	}))
+
+	_, err := Resolve[*testCycleRoot](c)
+	s.Require().ErrorIs(err, ErrCycle)
+
+	alpha := TypeName[*testCycleAlpha]()
+	beta := TypeName[*testCycleBeta]()
+	gamma := TypeName[*testCycleGamma]()
+
+	cycleErr, ok := errors.AsType[*CycleError](err)
+	s.Require().True(ok)
+	s.Equal([]string{alpha, beta, gamma, alpha}, cycleErr.Path)
+}
+
+func (s *ResolutionSuite) TestResolveAll_CycleReportsPath() {
+	c := New()
+	s.Require().NoError(For[*testCycleAlpha](c).Provider(func(c *Container) (*testCycleAlpha, error) {
+		_, err := ResolveAll[*testCycleAlpha](c)
+		return &testCycleAlpha{}, err
+	}))
+
+	_, err := Resolve[*testCycleAlpha](c)
+	s.Require().ErrorIs(err, ErrCycle)
+
+	alpha := TypeName[*testCycleAlpha]()
+	cycleErr, ok := errors.AsType[*CycleError](err)
+	s.Require().True(ok)
+	s.Equal([]string{alpha, alpha}, cycleErr.Path)
+}
+
+func (s *ResolutionSuite) TestBuild_EagerThreeNodeCycleReportsFullPath() {
+	c := New()
+	registerThreeNodeCycle(s, c, true)
+
+	err := c.Build()
+	s.Require().ErrorIs(err, ErrCycle)
+
+	alpha := TypeName[*testCycleAlpha]()
+	want := alpha + " -> " + TypeName[*testCycleBeta]() + " -> " + TypeName[*testCycleGamma]() + " -> " + alpha
+	s.Contains(err.Error(), want)
+}
+
 func (s *ResolutionSuite) TestResolve_ProviderErrorPropagates() {
 	c := New()
 
@@ -361,6 +480,88 @@ func (s *ResolutionSuite) TestMustResolve_PanicsOnProviderError() {
 	}, "MustResolve should panic when provider returns error")
 }
 
+// =============================================================================
+// ResolveOr Tests
+// =============================================================================
+
+func (s *ResolutionSuite) TestResolveOr_Found() {
+	c := New()
+	registered := &testResolveServiceA{}
+	s.Require().NoError(For[*testResolveServiceA](c).Instance(registered))
+
+	calls := 0
+	got := ResolveOr[*testResolveServiceA](c, func() *testResolveServiceA {
+		calls++
+		return &testResolveServiceA{}
+	})
+
+	s.Same(registered, got)
+	s.Equal(0, calls, "fallback must not be called when the service is registered")
+}
+
+func (s *ResolutionSuite) TestResolveOr_NotFound() {
+	c := New()
+
+	calls := 0
+	fallback := &testResolveServiceA{}
+	got := ResolveOr[*testResolveServiceA](c, func() *testResolveServiceA {
+		calls++
+		return fallback
+	})
+
+	s.Same(fallback, got)
+	s.Equal(1, calls)
+
+	// The fallback result is not cached in the container.
+	s.False(Has[*testResolveServiceA](c))
+	_, err := Resolve[*testResolveServiceA](c)
+	s.Require().ErrorIs(err, ErrNotFound)
+}
+
+func (s *ResolutionSuite) TestResolveOr_PanicsOnProviderError() {
+	c := New()
+	s.Require().NoError(For[*testResolveServiceA](c).Provider(func(_ *Container) (*testResolveServiceA, error) {
+		return nil, errors.New("provider error")
+	}))
+
+	s.Panics(func() {
+		ResolveOr[*testResolveServiceA](c, func() *testResolveServiceA {
+			return &testResolveServiceA{}
+		})
+	})
+}
+
+func (s *ResolutionSuite) TestResolveOrErr_SurfacesProviderError() {
+	c := New()
+	providerErr := errors.New("provider error")
+	s.Require().NoError(For[*testResolveServiceA](c).Provider(func(_ *Container) (*testResolveServiceA, error) {
+		return nil, providerErr
+	}))
+
+	called := false
+	_, err := ResolveOrErr[*testResolveServiceA](c, func() *testResolveServiceA {
+		called = true
+		return &testResolveServiceA{}
+	})
+
+	s.Require().ErrorIs(err, providerErr)
+	s.False(called)
+}
+
+func (s *ResolutionSuite) TestResolveOrErr_MissingDependencyIsAnError() {
+	c := New()
+	// A is registered but depends on B, which is not.
+	s.Require().NoError(For[*testResolveServiceA](c).Provider(func(c *Container) (*testResolveServiceA, error) {
+		_, err := Resolve[*testResolveServiceB](c)
+		return &testResolveServiceA{}, err
+	}))
+
+	_, err := ResolveOrErr[*testResolveServiceA](c, func() *testResolveServiceA {
+		return &testResolveServiceA{}
+	})
+	s.Require().ErrorIs(err, ErrNotFound)
+}
+
 func (s *ResolutionSuite) TestMustResolve_PanicMessageContainsTypeName() {
 	c := New()
 
@@ -447,3 +648,228 @@ func (s *ResolutionSuite) TestResolveAll_CheckedTypeAssertion_NosPanic() {
 	s.Require().Len(results, 1)
 	s.Equal("hello", results[0])
 }
+
+// =============================================================================
+// ResolveTransientScoped Tests
+// =============================================================================
+
+// scopedResource records whether its cleanup ran.
+type scopedResource struct {
+	id     int
+	closed chan struct{}
+}
+
+func registerScopedResource(c *Container) error {
+	var next int
+	return For[*scopedResource](c).Transient().
+		WithCleanup(func(r *scopedResource) { close(r.closed) }).
+		Provider(func(_ *Container) (*scopedResource, error) {
+			next++
+			return &scopedResource{id: next, closed: make(chan struct{})}, nil
+		})
+}
+
+func (s *ResolutionSuite) TestResolveTransientScoped_CleanupOnCancel() {
+	c := New()
+	s.Require().NoError(registerScopedResource(c))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := ResolveTransientScoped[*scopedResource](ctx, c)
+	s.Require().NoError(err)
+
+	select {
+	case <-r.closed:
+		s.FailNow("cleanup ran before the context was done")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case <-r.closed:
+	case <-time.After(time.Second):
+		s.FailNow("cleanup did not run after cancel")
+	}
+}
+
+func (s *ResolutionSuite) TestResolveTransientScoped_EachInstanceHasOwnCleanup() {
+	c := New()
+	s.Require().NoError(registerScopedResource(c))
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+
+	r1, err := ResolveTransientScoped[*scopedResource](ctx1, c)
+	s.Require().NoError(err)
+	r2, err := ResolveTransientScoped[*scopedResource](ctx2, c)
+	s.Require().NoError(err)
+	s.NotEqual(r1.id, r2.id)
+
+	cancel2()
+	select {
+	case <-r2.closed:
+	case <-time.After(time.Second):
+		s.FailNow("second cleanup did not run")
+	}
+	select {
+	case <-r1.closed:
+		s.FailNow("first instance cleaned up by the second context")
+	default:
+	}
+
+	cancel1()
+	select {
+	case <-r1.closed:
+	case <-time.After(time.Second):
+		s.FailNow("first cleanup did not run")
+	}
+}
+
+func (s *ResolutionSuite) TestResolveTransientScoped_PlainResolveSkipsCleanup() {
+	c := New()
+	s.Require().NoError(registerScopedResource(c))
+
+	r, err := Resolve[*scopedResource](c)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	other, err := ResolveTransientScoped[*scopedResource](ctx, c)
+	s.Require().NoError(err)
+	<-other.closed
+
+	select {
+	case <-r.closed:
+		s.Fail("instance from Resolve was cleaned up")
+	default:
+	}
+}
+
+func (s *ResolutionSuite) TestResolveTransientScoped_SingletonBehavesLikeResolve() {
+	c := New()
+	s.Require().NoError(For[*testService](c).Instance(&testService{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc, err := ResolveTransientScoped[*testService](ctx, c)
+	s.Require().NoError(err)
+	s.NotNil(svc)
+}
+
+func (s *ResolutionSuite) TestWithCleanup_RequiresTransient() {
+	c := New()
+	err := For[*scopedResource](c).
+		WithCleanup(func(*scopedResource) {}).
+		Provider(func(_ *Container) (*scopedResource, error) { return &scopedResource{}, nil })
+	s.Require().ErrorIs(err, ErrInvalidProvider)
+}
+
+// =============================================================================
+// ResolveBatch Tests
+// =============================================================================
+
+func (s *ResolutionSuite) TestResolveBatch_PopulatesAllTargets() {
+	c := New()
+	s.Require().NoError(For[*testResolveServiceA](c).Instance(&testResolveServiceA{value: "a"}))
+	s.Require().NoError(For[*testResolveServiceB](c).Instance(&testResolveServiceB{}))
+	s.Require().NoError(For[string](c).Instance("hello"))
+
+	var (
+		a   *testResolveServiceA
+		b   *testResolveServiceB
+		str string
+	)
+	s.Require().NoError(ResolveBatch(c, &a, &b, &str))
+	s.Require().NotNil(a)
+	s.Equal("a", a.value)
+	s.NotNil(b)
+	s.Equal("hello", str)
+}
+
+func (s *ResolutionSuite) TestResolveBatch_ResolvesProviderDependencies() {
+	c := New()
+	s.Require().NoError(For[*testResolveDepC](c).Instance(&testResolveDepC{value: "leaf"}))
+	s.Require().NoError(For[*testResolveDepB](c).Provider(func(c *Container) (*testResolveDepB, error) {
+		dep, err := Resolve[*testResolveDepC](c)
+		if err != nil {
+			return nil, err
+		}
+		return &testResolveDepB{c: dep}, nil
+	}))
+
+	var (
+		b    *testResolveDepB
+		leaf *testResolveDepC
+	)
+	s.Require().NoError(ResolveBatch(c, &b, &leaf))
+	s.Same(leaf, b.c)
+}
+
+func (s *ResolutionSuite) TestResolveBatch_NotFoundAbortsBatch() {
+	c := New()
+	built := false
+	s.Require().NoError(For[*testResolveServiceA](c).Provider(func(_ *Container) (*testResolveServiceA, error) {
+		built = true
+		return &testResolveServiceA{value: "a"}, nil
+	}))
+
+	var (
+		a *testResolveServiceA
+		b *testResolveServiceB
+	)
+	err := ResolveBatch(c, &a, &b)
+	s.Require().ErrorIs(err, ErrNotFound)
+	s.Contains(err.Error(), "target 1")
+	s.Contains(err.Error(), TypeName[*testResolveServiceB]())
+	s.Nil(a, "targets must be left unchanged on failure")
+	s.False(built, "no provider should run when a lookup fails")
+}
+
+func (s *ResolutionSuite) TestResolveBatch_ProviderErrorLeavesTargetsUnchanged() {
+	c := New()
+	s.Require().NoError(For[*testResolveServiceA](c).Instance(&testResolveServiceA{value: "a"}))
+	s.Require().NoError(For[*testResolveServiceB](c).Provider(func(_ *Container) (*testResolveServiceB, error) {
+		return nil, errors.New("boom")
+	}))
+
+	var (
+		a *testResolveServiceA
+		b *testResolveServiceB
+	)
+	err := ResolveBatch(c, &a, &b)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "boom")
+	s.Nil(a)
+	s.Nil(b)
+}
+
+func (s *ResolutionSuite) TestResolveBatch_TypeMismatch() {
+	c := New()
+	aTypeName := TypeName[*testResolveServiceA]()
+	s.Require().NoError(For[*testResolveServiceB](c).Named(aTypeName).Instance(&testResolveServiceB{}))
+
+	var a *testResolveServiceA
+	err := ResolveBatch(c, &a)
+	s.Require().ErrorIs(err, ErrTypeMismatch)
+	s.Contains(err.Error(), "expected "+aTypeName)
+	s.Contains(err.Error(), "*di.testResolveServiceB")
+	s.Nil(a)
+}
+
+func (s *ResolutionSuite) TestResolveBatch_InvalidTarget() {
+	c := New()
+	s.Require().NoError(For[*testResolveServiceA](c).Instance(&testResolveServiceA{}))
+
+	var a *testResolveServiceA
+	err := ResolveBatch(c, a)
+	s.Require().ErrorIs(err, ErrTypeMismatch)
+	s.Contains(err.Error(), "target 0 must be a non-nil pointer")
+
+	err = ResolveBatch(c, nil)
+	s.Require().ErrorIs(err, ErrTypeMismatch)
+}
+
+func (s *ResolutionSuite) TestResolveBatch_Empty() {
+	s.NoError(ResolveBatch(New()))
+}
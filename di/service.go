@@ -49,6 +49,8 @@ type baseService struct {
 	serviceName     string
 	serviceTypeName string
 	groups          []string
+	order           int    // Position within groups (see RegistrationBuilder.Order)
+	seq             uint64 // Registration sequence, for stable group ordering
 }
 
 func (s *baseService) Name() string {
@@ -63,6 +65,26 @@ func (s *baseService) Groups() []string {
 	return s.groups
 }
 
+func (s *baseService) groupOrder() int             { return s.order }
+func (s *baseService) setGroupOrder(n int)         { s.order = n }
+func (s *baseService) registrationSeq() uint64     { return s.seq }
+func (s *baseService) setRegistrationSeq(n uint64) { s.seq = n }
+
+// groupOrdered is implemented by wrappers embedding baseService. It lets
+// ResolveGroup order members without extending the public ServiceWrapper.
+type groupOrdered interface {
+	groupOrder() int
+	setGroupOrder(n int)
+	registrationSeq() uint64
+	setRegistrationSeq(n uint64)
+}
+
+// preBuiltService is implemented by wrappers around a value registered with
+// Instance, which Services reports as ScopeInstance.
+type preBuiltService interface {
+	preBuilt()
+}
+
 func (s *baseService) runStartLifecycle(ctx context.Context, instance any) error {
 	if starter, ok := instance.(Starter); ok {
 		if err := starter.OnStart(ctx); err != nil {
@@ -105,11 +127,19 @@ func hasLifecycleImpl[T any]() bool {
 	return false
 }
 
+// runResolveHooks calls each OnResolve hook with instance, in order.
+func runResolveHooks[T any](hooks []func(T), instance T) {
+	for _, hook := range hooks {
+		hook(instance)
+	}
+}
+
 // lazySingleton is the default service type - creates instance on first resolve,
 // then caches it for all subsequent calls.
 type lazySingleton[T any] struct {
 	baseService
-	provider func(*Container) (T, error)
+	provider  func(*Container) (T, error)
+	onResolve []func(T) // called with the instance once it is injected
 
 	mu       sync.Mutex
 	instance T
@@ -149,26 +179,41 @@ func (s *lazySingleton[T]) ServiceType() reflect.Type {
 }
 
 func (s *lazySingleton[T]) GetInstance(c *Container, chain []string) (any, error) {
+	instance, created, err := s.getOrBuild(c, chain)
+	if err != nil {
+		return nil, err
+	}
+	// Hooks run after mu is released so a hook resolving this service
+	// fails the cycle check instead of deadlocking.
+	if created {
+		runResolveHooks(s.onResolve, instance)
+	}
+	return instance, nil
+}
+
+// getOrBuild returns the cached instance, building it on first use.
+// created reports whether this call built it.
+func (s *lazySingleton[T]) getOrBuild(c *Container, chain []string) (instance T, created bool, err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if s.built {
-		return s.instance, nil
+		return s.instance, false, nil
 	}
 
-	instance, err := s.provider(c)
+	instance, err = s.provider(c)
 	if err != nil {
-		return nil, err
+		return instance, false, err
 	}
 
 	// Auto-inject struct fields tagged with gaz:"inject"
 	if err = injectStruct(c, instance, chain); err != nil {
-		return nil, err
+		return instance, false, err
 	}
 
 	s.instance = instance
 	s.built = true
-	return instance, nil
+	return instance, true, nil
 }
 
 func (s *lazySingleton[T]) Start(ctx context.Context) error {
@@ -200,6 +245,8 @@ type transientService[T any] struct {
 	serviceTypeName string
 	groups          []string
 	provider        func(*Container) (T, error)
+	onResolve       []func(T) // called with each instance once it is injected
+	cleanup         []func(T) // run by ResolveTransientScoped when its context is done
 }
 
 // newTransient creates a new transient service wrapper.
@@ -247,10 +294,28 @@ func (s *transientService[T]) GetInstance(c *Container, chain []string) (any, er
 	if err = injectStruct(c, instance, chain); err != nil {
 		return nil, err
 	}
+	runResolveHooks(s.onResolve, instance)
 
 	return instance, nil
 }
 
+// scopedCleanup returns a func that runs the registered cleanups on
+// instance, or nil if there are none.
+func (s *transientService[T]) scopedCleanup(instance any) func() {
+	if len(s.cleanup) == 0 {
+		return nil
+	}
+	typed, ok := instance.(T)
+	if !ok {
+		return nil
+	}
+	return func() {
+		for _, fn := range s.cleanup {
+			fn(typed)
+		}
+	}
+}
+
 func (s *transientService[T]) Start(context.Context) error { return nil }
 func (s *transientService[T]) Stop(context.Context) error  { return nil }
 func (s *transientService[T]) HasLifecycle() bool          { return false }
@@ -263,7 +328,8 @@ func (s *transientService[T]) ServiceType() reflect.Type {
 // The IsEager() method returns true so Build() knows to instantiate it.
 type eagerSingleton[T any] struct {
 	baseService
-	provider func(*Container) (T, error)
+	provider  func(*Container) (T, error)
+	onResolve []func(T) // called with the instance once it is injected
 
 	mu       sync.Mutex
 	instance T
@@ -295,26 +361,41 @@ func (s *eagerSingleton[T]) IsTransient() bool {
 }
 
 func (s *eagerSingleton[T]) GetInstance(c *Container, chain []string) (any, error) {
+	instance, created, err := s.getOrBuild(c, chain)
+	if err != nil {
+		return nil, err
+	}
+	// Hooks run after mu is released so a hook resolving this service
+	// fails the cycle check instead of deadlocking.
+	if created {
+		runResolveHooks(s.onResolve, instance)
+	}
+	return instance, nil
+}
+
+// getOrBuild returns the cached instance, building it on first use.
+// created reports whether this call built it.
+func (s *eagerSingleton[T]) getOrBuild(c *Container, chain []string) (instance T, created bool, err error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if s.built {
-		return s.instance, nil
+		return s.instance, false, nil
 	}
 
-	instance, err := s.provider(c)
+	instance, err = s.provider(c)
 	if err != nil {
-		return nil, err
+		return instance, false, err
 	}
 
 	// Auto-inject struct fields tagged with gaz:"inject"
 	if err = injectStruct(c, instance, chain); err != nil {
-		return nil, err
+		return instance, false, err
 	}
 
 	s.instance = instance
 	s.built = true
-	return instance, nil
+	return instance, true, nil
 }
 
 func (s *eagerSingleton[T]) Start(ctx context.Context) error {
@@ -383,6 +464,8 @@ func (s *instanceService[T]) GetInstance(_ *Container, _ []string) (any, error)
 	return s.value, nil
 }
 
+func (s *instanceService[T]) preBuilt() {}
+
 func (s *instanceService[T]) Start(ctx context.Context) error {
 	return s.runStartLifecycle(ctx, s.value)
 }
@@ -434,6 +517,8 @@ func (s *instanceServiceAny) GetInstance(_ *Container, _ []string) (any, error)
 	return s.value, nil
 }
 
+func (s *instanceServiceAny) preBuilt() {}
+
 func (s *instanceServiceAny) Start(ctx context.Context) error {
 	return s.runStartLifecycle(ctx, s.value)
 }
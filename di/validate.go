@@ -0,0 +1,161 @@
+package di
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// prebuilt is implemented by wrappers around values registered with
+// Instance(). They are returned as-is, so their fields are never injected.
+type prebuilt interface {
+	isPrebuilt()
+}
+
+func (s *instanceService[T]) isPrebuilt() {}
+
+func (s *instanceServiceAny) isPrebuilt() {}
+
+// Validate checks the container's wiring without calling any provider.
+// It walks every registration's declared dependencies (struct fields tagged
+// gaz:"inject"), reports each required dependency that has no registration,
+// and runs cycle detection over the resulting graph. This makes it safe for
+// a CI lint step where Build() would instantiate eager services with side
+// effects.
+//
+// Dependencies a provider resolves inside its function body are only known
+// once it runs, so Validate cannot see them; Build() still catches those.
+//
+// Missing dependencies match ErrNotFound and cycles match ErrCycle (as a
+// *CycleError); all problems found are joined into one error.
+//
+// Example:
+//
+//	if err := c.Validate(); err != nil {
+//	    log.Fatalf("invalid wiring: %v", err)
+//	}
+func (c *Container) Validate() error {
+	c.mu.RLock()
+	deps := make(map[string][]string, len(c.services))
+	var errs []error
+	for name, wrappers := range c.services {
+		for _, svc := range wrappers {
+			if _, ok := svc.(prebuilt); ok {
+				continue
+			}
+			for _, dep := range declaredDependencies(svc.ServiceType()) {
+				if _, found := c.services[dep.name]; found {
+					deps[name] = append(deps[name], dep.name)
+					continue
+				}
+				if !dep.optional {
+					errs = append(errs, fmt.Errorf("%w: %s (injected into %s.%s)",
+						ErrNotFound, dep.name, name, dep.field))
+				}
+			}
+		}
+	}
+	c.mu.RUnlock()
+
+	if err := findCycle(deps); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Sort for deterministic output; map iteration order is random.
+	slices.SortFunc(errs, func(a, b error) int {
+		return cmp.Compare(a.Error(), b.Error())
+	})
+	return errors.Join(errs...)
+}
+
+// declaredDependency is a dependency declared by a gaz:"inject" struct field.
+type declaredDependency struct {
+	name     string // Service name to resolve
+	field    string // Field the dependency is injected into
+	optional bool   // Missing registrations are allowed
+}
+
+// declaredDependencies returns the dependencies injectStruct would resolve
+// for an instance of type t. Only pointers to structs are injected.
+func declaredDependencies(t reflect.Type) []declaredDependency {
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	structType := t.Elem()
+
+	var deps []declaredDependency
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		tagValue, hasTag := field.Tag.Lookup("gaz")
+		if !hasTag {
+			continue
+		}
+		opts := parseTag(tagValue)
+		if !opts.inject {
+			continue
+		}
+
+		name := opts.name
+		if name == "" {
+			name = typeName(field.Type)
+		}
+		deps = append(deps, declaredDependency{
+			name:     name,
+			field:    field.Name,
+			optional: opts.optional,
+		})
+	}
+	return deps
+}
+
+// findCycle returns a *CycleError for the first cycle found in deps,
+// visiting services in sorted order so the reported path is stable.
+func findCycle(deps map[string][]string) error {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(deps))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return append(slices.Clone(path[start:]), name)
+		case done:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if state[name] != unvisited {
+			continue
+		}
+		if cycle := visit(name); cycle != nil {
+			return &CycleError{Path: cycle}
+		}
+	}
+	return nil
+}
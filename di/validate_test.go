@@ -0,0 +1,102 @@
+package di
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// =============================================================================
+// ValidateSuite - Tests for Container.Validate
+// =============================================================================
+
+type ValidateSuite struct {
+	suite.Suite
+}
+
+func TestValidateSuite(t *testing.T) {
+	suite.Run(t, new(ValidateSuite))
+}
+
+type validateDB struct{}
+
+type validateRepo struct {
+	DB *validateDB `gaz:"inject"`
+}
+
+type validateCache struct {
+	Backend *validateDB `gaz:"inject,name=cache-backend,optional"`
+}
+
+type validateCycleA struct {
+	B *validateCycleB `gaz:"inject"`
+}
+
+type validateCycleB struct {
+	A *validateCycleA `gaz:"inject"`
+}
+
+func (s *ValidateSuite) TestValidWiring() {
+	c := New()
+	var calls int
+	s.Require().NoError(For[*validateDB](c).Eager().ProviderFunc(func(*Container) *validateDB {
+		calls++
+		return &validateDB{}
+	}))
+	s.Require().NoError(For[*validateRepo](c).Eager().ProviderFunc(func(*Container) *validateRepo {
+		calls++
+		return &validateRepo{}
+	}))
+	s.Require().NoError(For[*validateCache](c).ProviderFunc(func(*Container) *validateCache {
+		calls++
+		return &validateCache{}
+	}))
+
+	s.Require().NoError(c.Validate())
+	s.Zero(calls, "Validate must not invoke providers")
+}
+
+func (s *ValidateSuite) TestMissingDependency() {
+	c := New()
+	var called bool
+	s.Require().NoError(For[*validateRepo](c).Eager().ProviderFunc(func(*Container) *validateRepo {
+		called = true
+		return &validateRepo{}
+	}))
+
+	err := c.Validate()
+	s.Require().ErrorIs(err, ErrNotFound)
+	s.Contains(err.Error(), TypeName[*validateDB]())
+	s.Contains(err.Error(), TypeName[*validateRepo]()+".DB")
+	s.False(called, "Validate must not invoke providers")
+}
+
+func (s *ValidateSuite) TestCycle() {
+	c := New()
+	var called bool
+	s.Require().NoError(For[*validateCycleA](c).Eager().ProviderFunc(func(*Container) *validateCycleA {
+		called = true
+		return &validateCycleA{}
+	}))
+	s.Require().NoError(For[*validateCycleB](c).ProviderFunc(func(*Container) *validateCycleB {
+		called = true
+		return &validateCycleB{}
+	}))
+
+	err := c.Validate()
+	s.Require().ErrorIs(err, ErrCycle)
+
+	cycleErr, ok := errors.AsType[*CycleError](err)
+	s.Require().True(ok)
+	a, b := TypeName[*validateCycleA](), TypeName[*validateCycleB]()
+	s.Equal([]string{a, b, a}, cycleErr.Path)
+	s.False(called, "Validate must not invoke providers")
+}
+
+func (s *ValidateSuite) TestInstanceFieldsNotChecked() {
+	c := New()
+	// Instances are never injected, so their tagged fields are not dependencies.
+	s.Require().NoError(For[*validateRepo](c).Instance(&validateRepo{}))
+	s.NoError(c.Validate())
+}
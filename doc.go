@@ -46,7 +46,20 @@
 //
 // Hooks are called in dependency order: dependencies start first and stop last.
 // Shutdown timeout is configurable via [WithShutdownTimeout], with per-hook
-// limits via [WithPerHookTimeout].
+// limits via [WithPerHookTimeout]. OnStart hooks can be bounded with
+// [WithPerStartTimeout]; if startup fails, only the services that started are
+// stopped, in reverse start order.
+//
+// One-shot jobs use [App.RunOnce], which starts everything, runs a task, and
+// shuts down gracefully without waiting for a signal:
+//
+//	err := app.RunOnce(ctx, func(ctx context.Context) error {
+//	    return importUsers(ctx)
+//	})
+//
+// Lifecycle transitions are published on the App's EventBus as [AppStarting],
+// [ServiceStarted], [AppStarted], [AppStopping], [ServiceStopped], and
+// [AppStopped], so components can react to them.
 //
 // # Configuration
 //
@@ -63,9 +76,20 @@
 //	app.WithConfig(&Config{}, config.WithEnvPrefix("APP"))
 //
 // The config struct is automatically registered in the container. Use
-// [ConfigManager] for advanced scenarios. Config values are validated
+// [App.ConfigManager] for advanced scenarios. Config values are validated
 // using struct tags with go-playground/validator.
 //
+// Rules that span several fields go in [App.WithConfigValidationHook]. Hooks
+// run after the config is loaded and validated, and an error fails Build:
+//
+//	app.WithConfigValidationHook(func(cfg any) error {
+//	    c := cfg.(*Config)
+//	    if c.TLS && c.CertFile == "" {
+//	        return errors.New("cert_file is required when tls is enabled")
+//	    }
+//	    return nil
+//	})
+//
 // # Health Checks
 //
 // The health subpackage provides HTTP health check endpoints:
@@ -76,6 +100,9 @@
 //
 // See the health package for [health.Manager], readiness, and liveness probes.
 //
+// [App.Metrics] returns a snapshot of service, worker, cron, and EventBus
+// counters for debug pages and metrics endpoints.
+//
 // # Resolution
 //
 // Resolve dependencies from the container using [Resolve]:
@@ -103,5 +130,12 @@
 //	    },
 //	)
 //
+// Registration problems such as duplicate module names are collected and
+// reported by Build(). Use [App.TryUse] to get them back immediately:
+//
+//	if err := app.TryUse(databaseModule); err != nil {
+//	    return err
+//	}
+//
 // See [App.Module] for details.
 package gaz
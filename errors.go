@@ -51,6 +51,14 @@ var (
 	// Check with: errors.Is(err, gaz.ErrDIInvalidProvider).
 	ErrDIInvalidProvider = di.ErrInvalidProvider
 
+	// ErrDIProviderTimeout is returned when a provider does not return within its timeout.
+	// Check with: errors.Is(err, gaz.ErrDIProviderTimeout).
+	ErrDIProviderTimeout = di.ErrProviderTimeout
+
+	// ErrDIProviderPanic is returned by Build when an eager provider panics.
+	// Check with: errors.Is(err, gaz.ErrDIProviderPanic).
+	ErrDIProviderPanic = di.ErrProviderPanic
+
 	// ErrDIAmbiguous is returned when multiple services are registered for the same key.
 	// Check with: errors.Is(err, gaz.ErrDIAmbiguous).
 	ErrDIAmbiguous = di.ErrAmbiguous
@@ -97,6 +105,19 @@ var (
 	// ErrWorkerManagerRunning indicates an attempt to register a worker
 	// after the manager has started.
 	ErrWorkerManagerRunning = worker.ErrManagerAlreadyRunning
+
+	// ErrWorkerPoolClosed indicates an item was submitted to a worker.Pool
+	// that is stopping or has stopped.
+	ErrWorkerPoolClosed = worker.ErrPoolClosed
+
+	// ErrWorkerNotFound indicates no registered worker has the given name.
+	ErrWorkerNotFound = worker.ErrWorkerNotFound
+
+	// ErrWorkerManagerNotRunning indicates the worker manager has not been started.
+	ErrWorkerManagerNotRunning = worker.ErrManagerNotRunning
+
+	// ErrWorkerHeartbeatTimeout indicates a worker missed its heartbeat timeout.
+	ErrWorkerHeartbeatTimeout = worker.ErrHeartbeatTimeout
 )
 
 // Cron subsystem errors.
@@ -110,6 +131,17 @@ var (
 	// ErrCronNotRunning indicates an operation was attempted on a scheduler
 	// that is not running.
 	ErrCronNotRunning = cron.ErrNotRunning
+
+	// ErrCronJobNotFound indicates no registered cron job has the given name.
+	ErrCronJobNotFound = cron.ErrJobNotFound
+
+	// ErrCronJobRunning indicates a cron job could not be triggered because it
+	// is already running.
+	ErrCronJobRunning = cron.ErrJobRunning
+
+	// ErrCronJobLocked indicates a cron job was skipped because another
+	// replica holds its lock.
+	ErrCronJobLocked = cron.ErrJobLocked
 )
 
 // Module errors (gaz-specific).
@@ -117,10 +149,34 @@ var (
 	// ErrModuleDuplicate is returned when a module with the same name is registered twice.
 	ErrModuleDuplicate = errors.New("gaz: duplicate module")
 
+	// ErrModuleNil is returned when a nil module is passed to App.Use.
+	ErrModuleNil = errors.New("gaz: nil module")
+
+	// ErrModuleNilProvider is returned when a module has a nil provider function.
+	ErrModuleNilProvider = errors.New("gaz: nil module provider")
+
+	// ErrModuleDependencyMissing is returned when a module depends on a module
+	// that was never registered.
+	ErrModuleDependencyMissing = errors.New("gaz: missing module dependency")
+
+	// ErrModuleDependencyCycle is returned when module dependencies form a cycle.
+	ErrModuleDependencyCycle = errors.New("gaz: module dependency cycle")
+
 	// ErrConfigKeyCollision is returned when two providers register the same config key.
 	ErrConfigKeyCollision = errors.New("gaz: config key collision")
 )
 
+// Lifecycle errors (gaz-specific).
+var (
+	// ErrStartupGateFailed is returned by Run when WithStartupGate is enabled and
+	// the health startup checks do not pass within the gate timeout.
+	ErrStartupGateFailed = errors.New("gaz: startup checks did not pass")
+
+	// ErrStartTimeout is returned by Run when a service's OnStart hook does not
+	// return within the timeout set by WithPerStartTimeout.
+	ErrStartTimeout = errors.New("gaz: service start timed out")
+)
+
 // =============================================================================
 // Typed Errors
 // =============================================================================
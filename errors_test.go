@@ -166,4 +166,5 @@ func TestSentinelErrors_DIPackageCompatibility(t *testing.T) {
 	assert.True(t, errors.Is(gaz.ErrDITypeMismatch, di.ErrTypeMismatch))
 	assert.True(t, errors.Is(gaz.ErrDIAlreadyBuilt, di.ErrAlreadyBuilt))
 	assert.True(t, errors.Is(gaz.ErrDIInvalidProvider, di.ErrInvalidProvider))
+	assert.True(t, errors.Is(gaz.ErrDIProviderTimeout, di.ErrProviderTimeout))
 }
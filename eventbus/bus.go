@@ -2,10 +2,12 @@ package eventbus
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"reflect"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 )
 
 // subscriptionKey uniquely identifies a subscription target.
@@ -18,6 +20,9 @@ type subscriptionKey struct {
 type eventEnvelope struct {
 	ctx   context.Context //nolint:containedctx // Envelope carries publisher context through channel.
 	event any
+
+	stats  *eventStats // Per-event counters (see EventMetrics)
+	queued bool        // Sent on a subscription channel, counted in stats.queued
 }
 
 // asyncSubscription holds a subscription's channel and handler.
@@ -26,18 +31,87 @@ type asyncSubscription struct {
 	ch      chan eventEnvelope         // Buffered channel for events with context
 	done    chan struct{}              // Closed when handler goroutine exits
 	handler func(context.Context, any) // Type-erased handler
+	orderBy func(Event) string         // Optional per-key ordering (see WithOrderedBy)
+	filter  func(Event) bool           // Optional content filter (see WithFilter)
+	replay  []eventEnvelope            // Retained events delivered before live ones
+
+	delivered *atomic.Uint64 // Bus-wide delivery counter (see EventBus.Metrics)
+
+	// Ordering lanes (see runOrdered), exposed so a timed-out drain can
+	// discard their queued events.
+	laneMu sync.Mutex
+	lanes  map[string]*keyLane
+	slots  chan struct{}
 }
 
-// run processes events from the channel until it's closed.
+// run processes replayed events, then events from the channel until it's
+// closed.
 func (s *asyncSubscription) run(logger *slog.Logger) {
+	replay := s.replay
+	s.replay = nil
+
+	if s.orderBy != nil {
+		s.runOrdered(replay, logger)
+		return
+	}
+
 	defer close(s.done)
+	for _, env := range replay {
+		s.safeInvoke(env, logger)
+	}
 	for env := range s.ch {
-		s.safeInvoke(env.ctx, env.event, logger)
+		s.safeInvoke(env, logger)
 	}
 }
 
-// safeInvoke calls the handler with panic recovery.
-func (s *asyncSubscription) safeInvoke(ctx context.Context, event any, logger *slog.Logger) {
+// discard drops the events still queued for a closed subscription and returns
+// how many were dropped. The handler goroutine finishes its current event and
+// exits without taking the dropped ones.
+func (s *asyncSubscription) discard() int {
+	dropped := 0
+	for env := range s.ch {
+		dropEnvelope(env)
+		dropped++
+	}
+	if s.orderBy != nil {
+		dropped += s.discardLanes()
+	}
+	return dropped
+}
+
+// accepts reports whether event passes the subscription's filter. A filter
+// that panics is recovered like a handler panic and rejects the event.
+func (s *asyncSubscription) accepts(event any, logger *slog.Logger) (ok bool) {
+	if s.filter == nil {
+		return true
+	}
+	e, isEvent := event.(Event)
+	if !isEvent {
+		return false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("filter panic recovered",
+				"error", r,
+				"stack", string(debug.Stack()),
+			)
+			ok = false
+		}
+	}()
+	return s.filter(e)
+}
+
+// safeInvoke calls the handler for env with panic recovery.
+func (s *asyncSubscription) safeInvoke(env eventEnvelope, logger *slog.Logger) {
+	if s.delivered != nil {
+		defer s.delivered.Add(1)
+	}
+	if env.stats != nil {
+		if env.queued {
+			env.stats.queued.Add(-1)
+		}
+		defer env.stats.delivered.Add(1)
+	}
 	defer func() {
 		if r := recover(); r != nil {
 			logger.Error("handler panic recovered",
@@ -46,7 +120,7 @@ func (s *asyncSubscription) safeInvoke(ctx context.Context, event any, logger *s
 			)
 		}
 	}()
-	s.handler(ctx, event)
+	s.handler(env.ctx, env.event)
 }
 
 // EventBus provides type-safe in-process pub/sub.
@@ -71,17 +145,46 @@ type EventBus struct {
 	nextID   uint64
 	closed   bool
 	logger   *slog.Logger
+
+	// Replay buffer (see WithReplay)
+	replayMu   sync.Mutex
+	replaySize int
+	replay     map[reflect.Type][]replayEntry
+
+	// Correlation registry for Request/Reply
+	requestsMu  sync.Mutex
+	requests    map[string]*pendingRequest
+	nextRequest uint64
+
+	// Counters (see Metrics)
+	published atomic.Uint64
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+
+	// Per-event counters (see EventMetrics)
+	statsMu    sync.RWMutex
+	stats      map[eventKey]*eventStats
+	topicLimit int                 // see WithTopicMetrics
+	topics     map[string]struct{} // topics with their own counters
 }
 
 // New creates a new EventBus.
 //
 // The logger is used for panic recovery logging. Pass slog.Default() if
 // you don't have a custom logger.
-func New(logger *slog.Logger) *EventBus {
-	return &EventBus{
+func New(logger *slog.Logger, opts ...Option) *EventBus {
+	b := &EventBus{
 		handlers: make(map[subscriptionKey][]*asyncSubscription),
+		requests: make(map[string]*pendingRequest),
+		replay:   make(map[reflect.Type][]replayEntry),
+		stats:    make(map[eventKey]*eventStats),
+		topics:   make(map[string]struct{}),
 		logger:   logger.With("component", "eventbus.EventBus"),
 	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
 }
 
 // Subscribe registers a handler for events of type T.
@@ -93,7 +196,9 @@ func New(logger *slog.Logger) *EventBus {
 //
 // Options:
 //   - [WithTopic]: Filter to events with matching topic
+//   - [WithFilter]: Filter to events matching a predicate
 //   - [WithBufferSize]: Configure async buffer size (default 100)
+//   - [WithOrderedBy]: Handle events concurrently, serialized per key
 //
 // # Example
 //
@@ -126,6 +231,14 @@ func Subscribe[T Event](b *EventBus, handler Handler[T], opts ...SubscribeOption
 			//nolint:errcheck // Type is guaranteed by generic Subscribe[T]
 			handler(ctx, event.(T))
 		},
+		orderBy:   options.orderBy,
+		filter:    options.filter,
+		delivered: &b.delivered,
+	}
+	for _, env := range b.replayFor(eventType, options.topic) {
+		if sub.accepts(env.event, b.logger) {
+			sub.replay = append(sub.replay, env)
+		}
 	}
 
 	// Start handler goroutine
@@ -144,6 +257,7 @@ func Subscribe[T Event](b *EventBus, handler Handler[T], opts ...SubscribeOption
 //
 // Publish returns immediately (fire-and-forget). Events are queued
 // in each subscriber's buffer. Blocks if any subscriber's buffer is full.
+// With [WithReplay], the event is also retained for later subscribers.
 //
 // Publishing to a closed bus is a silent no-op (idempotent).
 //
@@ -159,6 +273,10 @@ func Publish[T Event](ctx context.Context, b *EventBus, event T, topic string) {
 	}
 
 	eventType := reflect.TypeOf(event)
+	stats := b.statsFor(event.EventName(), topic)
+	b.retain(ctx, eventType, event, topic, stats)
+	b.published.Add(1)
+	stats.published.Add(1)
 
 	// Find all matching handlers (exact topic + wildcard)
 	var handlers []*asyncSubscription
@@ -176,12 +294,22 @@ func Publish[T Event](ctx context.Context, b *EventBus, event T, topic string) {
 	// Deliver while holding RLock — Close() acquires write lock before closing
 	// channels, so channels cannot be closed while any Publish holds RLock.
 	// This prevents send-on-closed-channel panics.
-	env := eventEnvelope{ctx: ctx, event: event}
-	for _, h := range handlers {
+	env := eventEnvelope{ctx: ctx, event: event, stats: stats, queued: true}
+	for i, h := range handlers {
+		if !h.accepts(event, b.logger) {
+			continue
+		}
+		// Count the event as queued before sending so the handler cannot
+		// dequeue it first.
+		stats.queued.Add(1)
 		select {
 		case h.ch <- env:
 			// Delivered
 		case <-ctx.Done():
+			stats.queued.Add(-1)
+			dropped := uint64(countAccepting(handlers[i:], event, b.logger))
+			b.dropped.Add(dropped)
+			stats.dropped.Add(dropped)
 			b.mu.RUnlock()
 			return // Context cancelled, stop publishing
 		}
@@ -190,6 +318,69 @@ func Publish[T Event](ctx context.Context, b *EventBus, event T, topic string) {
 	b.mu.RUnlock()
 }
 
+// countAccepting returns how many of subs accept event.
+func countAccepting(subs []*asyncSubscription, event any, logger *slog.Logger) int {
+	n := 0
+	for _, sub := range subs {
+		if sub.accepts(event, logger) {
+			n++
+		}
+	}
+	return n
+}
+
+// Metrics is a snapshot of an EventBus's delivery counters.
+type Metrics struct {
+	// Published counts events accepted by Publish while the bus was open.
+	Published uint64
+
+	// Delivered counts handler invocations, including ones that panicked.
+	// An event published to several subscribers is delivered several times.
+	Delivered uint64
+
+	// Dropped counts events that never reached a subscriber: those abandoned
+	// when Publish's context ended on a full buffer, and those discarded when
+	// CloseContext timed out.
+	Dropped uint64
+
+	// Subscriptions is the number of active subscriptions.
+	Subscriptions int
+
+	// QueueDepth is the number of events buffered across all subscriptions,
+	// waiting for their handler.
+	QueueDepth int
+
+	// Events breaks the counters down by event name and topic, sorted by
+	// event name, then topic. Each distinct topic gets its own entry, so
+	// keep topics to a bounded set when exporting these as metric labels.
+	Events []EventMetrics
+}
+
+// Metrics returns a snapshot of the bus's delivery counters.
+//
+// Example:
+//
+//	m := bus.Metrics()
+//	log.Printf("published=%d delivered=%d dropped=%d", m.Published, m.Delivered, m.Dropped)
+func (b *EventBus) Metrics() Metrics {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	m := Metrics{
+		Published: b.published.Load(),
+		Delivered: b.delivered.Load(),
+		Dropped:   b.dropped.Load(),
+		Events:    b.eventMetrics(),
+	}
+	for _, subs := range b.handlers {
+		m.Subscriptions += len(subs)
+		for _, sub := range subs {
+			m.QueueDepth += len(sub.ch)
+		}
+	}
+	return m
+}
+
 // Name implements worker.Worker interface.
 func (b *EventBus) Name() string {
 	return "eventbus.EventBus"
@@ -198,17 +389,58 @@ func (b *EventBus) Name() string {
 // OnStart implements worker.Worker interface.
 //
 // EventBus is always ready - no initialization needed beyond New().
+// If the bus was closed by a previous OnStop (e.g. during App.Restart), it is
+// reopened and existing subscriptions resume with fresh buffers.
 // This method logs that the eventbus has started and returns nil.
 func (b *EventBus) OnStart(ctx context.Context) error {
+	b.reopen()
 	b.logger.InfoContext(ctx, "eventbus started")
 	return nil
 }
 
+// reopen restarts delivery for all existing subscriptions after Close.
+// No-op if the bus is not closed.
+func (b *EventBus) reopen() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.closed {
+		return
+	}
+
+	// Close() drained and exited every handler goroutine; give each
+	// subscription a new channel and goroutine.
+	for _, subs := range b.handlers {
+		for _, sub := range subs {
+			sub.ch = make(chan eventEnvelope, cap(sub.ch))
+			sub.done = make(chan struct{})
+			go sub.run(b.logger)
+		}
+	}
+	b.closed = false
+}
+
 // OnStop implements worker.Worker interface.
 //
-// Calls Close() to drain in-flight handlers. Returns nil as stop doesn't fail.
-func (b *EventBus) OnStop(_ context.Context) error {
-	b.Close()
+// Calls CloseContext to drain in-flight handlers, bounded by ctx. If ctx ends
+// before the handlers finish, the returned error wraps ErrDrainTimeout and
+// reports how many queued events were dropped.
+func (b *EventBus) OnStop(ctx context.Context) error {
+	return b.CloseContext(ctx)
+}
+
+// HealthCheck reports whether the EventBus is accepting events.
+// It returns ErrClosed once Close has been called (including while in-flight
+// handlers are still draining), or nil otherwise. gaz.App registers it as
+// the "eventbus" readiness check, so readiness drops as soon as shutdown
+// begins.
+func (b *EventBus) HealthCheck(_ context.Context) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return ErrClosed
+	}
 	return nil
 }
 
@@ -219,11 +451,24 @@ func (b *EventBus) OnStop(_ context.Context) error {
 //
 // Close waits for all handler goroutines to finish processing their
 // buffered events before returning. This ensures graceful shutdown.
+// Use [EventBus.CloseContext] to bound the wait.
 func (b *EventBus) Close() {
+	_ = b.CloseContext(context.Background())
+}
+
+// CloseContext shuts down the EventBus like Close, but stops waiting for
+// handlers when ctx is done.
+//
+// On timeout, events still queued for slow subscribers are dropped and
+// CloseContext returns an error wrapping both ErrDrainTimeout and ctx.Err()
+// that reports how many were lost. Handlers already running are not
+// interrupted; they finish in the background. Calling it on a closed bus
+// returns nil.
+func (b *EventBus) CloseContext(ctx context.Context) error {
 	b.mu.Lock()
 	if b.closed {
 		b.mu.Unlock()
-		return
+		return nil
 	}
 	b.closed = true
 
@@ -244,10 +489,38 @@ func (b *EventBus) Close() {
 
 	// Wait for handlers outside lock (they only read from ch, no lock needed)
 	for _, sub := range allSubs {
-		<-sub.done
+		select {
+		case <-sub.done:
+		case <-ctx.Done():
+			return b.abandonDrain(ctx, allSubs)
+		}
 	}
 
 	b.logger.Info("eventbus stopped", "subscriptions_drained", len(allSubs))
+	return nil
+}
+
+// abandonDrain discards the queued events of every subscription that has not
+// finished draining and reports the loss.
+func (b *EventBus) abandonDrain(ctx context.Context, subs []*asyncSubscription) error {
+	dropped, pending := 0, 0
+	for _, sub := range subs {
+		select {
+		case <-sub.done:
+			continue
+		default:
+		}
+		pending++
+		dropped += sub.discard()
+	}
+	b.dropped.Add(uint64(dropped))
+
+	b.logger.Warn("eventbus drain timed out",
+		"events_dropped", dropped,
+		"subscriptions_pending", pending,
+	)
+	return fmt.Errorf("%w: dropped %d queued events across %d subscriptions: %w",
+		ErrDrainTimeout, dropped, pending, ctx.Err())
 }
 
 // unsubscribe removes a subscription from the bus.
@@ -163,6 +163,92 @@ func TestCloseDrainsHandlers(t *testing.T) {
 	assert.True(t, completed.Load())
 }
 
+func TestCloseContextDrainTimeoutReportsDropped(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+	var handled atomic.Int32
+
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		started <- struct{}{}
+		<-release
+		handled.Add(1)
+	}, WithBufferSize(10))
+
+	for i := range 4 {
+		Publish(context.Background(), bus, testEvent{ID: strconv.Itoa(i)}, "")
+	}
+	<-started // First event is in flight, three are queued
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := bus.OnStop(ctx)
+
+	assert.Less(t, time.Since(start), time.Second, "stop should return soon after the timeout")
+	require.ErrorIs(t, err, ErrDrainTimeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "dropped 3 queued events across 1 subscriptions")
+	assert.ErrorIs(t, bus.HealthCheck(context.Background()), ErrClosed)
+	assert.Equal(t, int32(0), handled.Load())
+}
+
+func TestCloseContextDrainTimeoutOrdered(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	var handled atomic.Int32
+
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		started <- struct{}{}
+		<-release
+		handled.Add(1)
+	}, WithBufferSize(10), WithOrderedBy(func(e Event) string { return e.(testEvent).Message }))
+
+	// One key: the first event runs, the other two wait in its lane.
+	for i := range 3 {
+		Publish(context.Background(), bus, testEvent{ID: strconv.Itoa(i), Message: "k"}, "")
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := bus.CloseContext(ctx)
+	require.ErrorIs(t, err, ErrDrainTimeout)
+	assert.Contains(t, err.Error(), "dropped 2 queued events")
+
+	// The in-flight handler finishes; the dropped events never run.
+	close(release)
+	require.Eventually(t, func() bool { return handled.Load() == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(1), handled.Load())
+}
+
+func TestCloseContextDrainsWithinDeadline(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	var handled atomic.Int32
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		time.Sleep(5 * time.Millisecond)
+		handled.Add(1)
+	})
+	for range 3 {
+		Publish(context.Background(), bus, testEvent{ID: "1"}, "")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, bus.CloseContext(ctx))
+	assert.Equal(t, int32(3), handled.Load())
+	assert.NoError(t, bus.CloseContext(ctx), "closing twice is a no-op")
+}
+
 func TestPublishToClosedBus(t *testing.T) {
 	t.Parallel()
 	bus := New(testLogger())
@@ -205,6 +291,47 @@ func TestWorkerInterface(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	bus := New(testLogger())
+	require.NoError(t, bus.HealthCheck(context.Background()))
+
+	bus.Close()
+
+	err := bus.HealthCheck(context.Background())
+	require.ErrorIs(t, err, ErrClosed)
+}
+
+func TestReopenAfterClose(t *testing.T) {
+	t.Parallel()
+
+	bus := New(testLogger())
+
+	received := make(chan string, 1)
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		received <- e.ID
+	})
+
+	require.NoError(t, bus.OnStop(context.Background()))
+	require.ErrorIs(t, bus.HealthCheck(context.Background()), ErrClosed)
+
+	require.NoError(t, bus.OnStart(context.Background()))
+	require.NoError(t, bus.HealthCheck(context.Background()))
+
+	// Existing subscription resumes after reopen
+	Publish(context.Background(), bus, testEvent{ID: "after-reopen"}, "")
+
+	select {
+	case id := <-received:
+		assert.Equal(t, "after-reopen", id)
+	case <-time.After(time.Second):
+		t.Fatal("event not delivered after reopen")
+	}
+
+	bus.Close()
+}
+
 func TestBufferSizeOption(t *testing.T) {
 	t.Parallel()
 	bus := New(testLogger())
@@ -227,6 +354,88 @@ func TestBufferSizeOption(t *testing.T) {
 	assert.Equal(t, int32(5), received.Load())
 }
 
+func TestFilterOption(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	got := collect(bus, WithFilter(func(e testEvent) bool { return e.Message == "keep" }))
+
+	Publish(context.Background(), bus, testEvent{ID: "1", Message: "keep"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "2", Message: "drop"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "3", Message: "keep"}, "")
+	bus.Close() // Drains queued events
+
+	assert.Equal(t, []string{"1", "3"}, got())
+}
+
+func TestFilterOption_ComposesWithTopic(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	got := collect(bus, WithTopic("admin"), WithFilter(func(e testEvent) bool { return e.Message == "keep" }))
+
+	Publish(context.Background(), bus, testEvent{ID: "1", Message: "keep"}, "admin")
+	Publish(context.Background(), bus, testEvent{ID: "2", Message: "drop"}, "admin")
+	Publish(context.Background(), bus, testEvent{ID: "3", Message: "keep"}, "user")
+	bus.Close()
+
+	assert.Equal(t, []string{"1"}, got())
+}
+
+func TestFilterOption_PanicRecovered(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	got := collect(bus, WithFilter(func(e testEvent) bool {
+		if e.Message == "boom" {
+			panic("filter panic")
+		}
+		return true
+	}))
+
+	Publish(context.Background(), bus, testEvent{ID: "1", Message: "boom"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "2", Message: "ok"}, "")
+	bus.Close() // Would block if the panic left the read lock held
+
+	assert.Equal(t, []string{"2"}, got())
+}
+
+func TestFilterOption_RejectedEventsSkipBuffer(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	release := make(chan struct{})
+	var received atomic.Int32
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		<-release
+		received.Add(1)
+	}, WithBufferSize(1), WithFilter(func(e testEvent) bool { return e.Message == "keep" }))
+
+	// Fill the handler and its one-slot buffer.
+	Publish(context.Background(), bus, testEvent{ID: "1", Message: "keep"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "2", Message: "keep"}, "")
+
+	// Rejected events must not block on the full buffer.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 10 {
+			Publish(context.Background(), bus, testEvent{ID: "x", Message: "drop"}, "")
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publishing filtered-out events blocked on subscriber buffer")
+	}
+
+	close(release)
+	require.Eventually(t, func() bool { return received.Load() == 2 }, time.Second, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(2), received.Load())
+}
+
 func TestContextCancellation(t *testing.T) {
 	t.Parallel()
 	bus := New(testLogger())
@@ -533,3 +742,145 @@ func TestContextPropagationTraceIDInHandler(t *testing.T) {
 
 // Run: go test -coverprofile=coverage.out ./eventbus/...
 // Target: 70%+ coverage
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	Subscribe(bus, func(_ context.Context, _ testEvent) {
+		started <- struct{}{}
+		<-release
+	}, WithBufferSize(1))
+
+	Publish(context.Background(), bus, testEvent{ID: "1"}, "")
+	<-started // Handler is busy
+	Publish(context.Background(), bus, testEvent{ID: "2"}, "")
+
+	m := bus.Metrics()
+	assert.Equal(t, uint64(2), m.Published)
+	assert.Equal(t, 1, m.Subscriptions)
+	assert.Equal(t, 1, m.QueueDepth)
+
+	// The buffer is full, so this publish gives up when its context ends.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	Publish(ctx, bus, testEvent{ID: "3"}, "")
+	assert.Equal(t, uint64(1), bus.Metrics().Dropped)
+
+	close(release)
+	<-started
+	bus.Close()
+
+	m = bus.Metrics()
+	assert.Equal(t, uint64(3), m.Published)
+	assert.Equal(t, uint64(2), m.Delivered)
+	assert.Equal(t, uint64(1), m.Dropped)
+	assert.Zero(t, m.QueueDepth)
+}
+
+func TestMetricsByEvent(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithTopicMetrics(10))
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	Subscribe(bus, func(_ context.Context, _ testEvent) {
+		started <- struct{}{}
+		<-release
+	}, WithBufferSize(1))
+	delivered := make(chan struct{}, 1)
+	Subscribe(bus, func(_ context.Context, _ anotherEvent) {
+		delivered <- struct{}{}
+	})
+
+	Publish(context.Background(), bus, anotherEvent{Value: 1}, "")
+	<-delivered
+	Publish(context.Background(), bus, testEvent{ID: "1"}, "admin")
+	<-started // Handler is busy
+	Publish(context.Background(), bus, testEvent{ID: "2"}, "admin")
+
+	m := bus.Metrics()
+	require.Len(t, m.Events, 2)
+	assert.Equal(t, "anotherEvent", m.Events[0].Event)
+	assert.Equal(t, "testEvent", m.Events[1].Event)
+	assert.Equal(t, "admin", m.Events[1].Topic)
+	assert.Equal(t, uint64(2), m.Events[1].Published)
+	assert.Equal(t, 1, m.Events[1].QueueDepth)
+
+	// The buffer is full, so this publish gives up when its context ends.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	Publish(ctx, bus, testEvent{ID: "3"}, "admin")
+	assert.Equal(t, uint64(1), bus.Metrics().Events[1].Dropped)
+
+	close(release)
+	<-started
+	bus.Close()
+
+	m = bus.Metrics()
+	assert.Equal(t, EventMetrics{Event: "anotherEvent", Published: 1, Delivered: 1}, m.Events[0])
+	assert.Equal(t, EventMetrics{
+		Event: "testEvent", Topic: "admin", Published: 3, Delivered: 2, Dropped: 1,
+	}, m.Events[1])
+}
+
+func TestMetricsByEventAggregatesTopics(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	Subscribe(bus, func(_ context.Context, _ testEvent) {})
+
+	for _, topic := range []string{"", "user-1", "user-2"} {
+		Publish(context.Background(), bus, testEvent{ID: topic}, topic)
+	}
+	bus.Close()
+
+	assert.Equal(t, []EventMetrics{
+		{Event: "testEvent", Published: 3, Delivered: 3},
+	}, bus.Metrics().Events)
+}
+
+func TestMetricsByEventTopicLimit(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithTopicMetrics(2))
+	Subscribe(bus, func(_ context.Context, _ testEvent) {})
+
+	for _, topic := range []string{"a", "b", "c", "d", "a", ""} {
+		Publish(context.Background(), bus, testEvent{ID: topic}, topic)
+	}
+	bus.Close()
+
+	topics := make(map[string]uint64)
+	for _, e := range bus.Metrics().Events {
+		topics[e.Topic] = e.Published
+	}
+	assert.Equal(t, map[string]uint64{"": 1, "a": 2, "b": 1, OtherTopic: 2}, topics)
+}
+
+func TestMetricsByEventDrainTimeout(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+	Subscribe(bus, func(_ context.Context, _ testEvent) {
+		started <- struct{}{}
+		<-release
+	}, WithBufferSize(2))
+
+	Publish(context.Background(), bus, testEvent{ID: "1"}, "")
+	<-started
+	Publish(context.Background(), bus, testEvent{ID: "2"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "3"}, "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, bus.CloseContext(ctx), ErrDrainTimeout)
+
+	m := bus.Metrics()
+	require.Len(t, m.Events, 1)
+	assert.Equal(t, uint64(2), m.Events[0].Dropped)
+	assert.Zero(t, m.Events[0].QueueDepth)
+}
@@ -31,17 +31,59 @@
 // buffer is full, Publish blocks (backpressure). The default buffer size is 100.
 // Configure per subscription with [WithBufferSize].
 //
+// # Ordering
+//
+// Each subscription handles its events one at a time, in publish order. To
+// handle unrelated events concurrently while keeping related events ordered,
+// use [WithOrderedBy] with a key function (e.g. an aggregate ID): events with
+// the same key are serialized, events with different keys run in parallel.
+//
+// # Replay
+//
+// A subscriber that registers after events were published misses them. Create
+// the bus with [WithReplay] to retain the last n events of each type and
+// deliver them to new subscribers first, closing startup races between
+// publishing and subscribing workers.
+//
 // # Topic Filtering
 //
 // Events can be published with an optional topic string. Subscribers can filter
 // to receive only events matching a specific topic using [WithTopic]. Omitting
 // the topic option subscribes to all events of that type.
 //
+// To filter on event content, pass a predicate with [WithFilter]. It runs
+// before the event is queued, so rejected events never reach the handler or
+// its buffer:
+//
+//	eventbus.Subscribe[OrderPlaced](bus, handler, eventbus.WithFilter(func(e OrderPlaced) bool {
+//	    return e.Amount > 1000
+//	}))
+//
+// # Request/Reply
+//
+// [Request] publishes an event and waits for a handler to answer it with
+// [Reply], for simple in-process command/response. Each request carries a
+// correlation ID in the handler's context, so concurrent requests receive
+// only their own replies. The wait is bounded by the request context:
+//
+//	eventbus.Subscribe(bus, func(ctx context.Context, q GetPrice) {
+//	    _ = eventbus.Reply(ctx, bus, PriceQuote{Cents: 499})
+//	})
+//
+//	quote, err := eventbus.Request[GetPrice, PriceQuote](ctx, bus, GetPrice{SKU: "A1"}, "")
+//
 // # Lifecycle Integration
 //
 // The [EventBus] implements worker.Worker for integration with gaz's lifecycle
-// system. It starts automatically with app.Run() and stops gracefully on shutdown,
-// draining in-flight events before returning.
+// system. A gaz App opens it with app.Run() and closes it at the end of
+// shutdown, after services have stopped, draining in-flight events before
+// returning. The App publishes its own lifecycle events (gaz.AppStarting,
+// gaz.ServiceStarted, and so on) on the bus.
+//
+// Draining is bounded by the stop context: if handlers are still busy when it
+// ends, the remaining queued events are dropped and OnStop (or
+// [EventBus.CloseContext]) returns an error wrapping [ErrDrainTimeout] that
+// reports how many were lost.
 //
 // # Usage Example
 //
@@ -0,0 +1,24 @@
+package eventbus
+
+import "errors"
+
+// Sentinel errors for eventbus package.
+var (
+	// ErrClosed indicates the EventBus has been closed (or is draining) and
+	// no longer accepts subscriptions or delivers published events.
+	ErrClosed = errors.New("eventbus: bus is closed")
+
+	// ErrDrainTimeout indicates CloseContext (or OnStop) gave up waiting for
+	// handlers to drain; the error message reports how many queued events
+	// were dropped.
+	ErrDrainTimeout = errors.New("eventbus: drain timed out")
+
+	// ErrNoPendingRequest indicates Reply was called with a context that does
+	// not belong to a waiting Request, or whose Request was already answered
+	// or timed out.
+	ErrNoPendingRequest = errors.New("eventbus: no pending request")
+
+	// ErrReplyType indicates Reply was called with a response type other than
+	// the one the Request expects.
+	ErrReplyType = errors.New("eventbus: reply type mismatch")
+)
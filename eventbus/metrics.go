@@ -0,0 +1,144 @@
+package eventbus
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+// OtherTopic is the Topic of the EventMetrics that count events published on
+// topics beyond the limit set with WithTopicMetrics.
+const OtherTopic = "_other"
+
+// WithTopicMetrics breaks the per-event counters (see EventMetrics) down by
+// the topic events are published on, for up to limit distinct topics. Events
+// published on further topics are counted under OtherTopic.
+//
+// Without it, each event name has one set of counters covering all topics,
+// so topics derived from user or request IDs cannot grow the counters, or
+// the labels of exported metrics, without bound.
+//
+// # Example
+//
+//	bus := eventbus.New(logger, eventbus.WithTopicMetrics(20))
+func WithTopicMetrics(limit int) Option {
+	return func(b *EventBus) {
+		b.topicLimit = max(limit, 0)
+	}
+}
+
+// eventKey identifies per-event counters: the EventName of a published event
+// and the topic it was published on (see WithTopicMetrics).
+type eventKey struct {
+	name  string
+	topic string
+}
+
+// eventStats holds the counters for one eventKey (see EventMetrics).
+type eventStats struct {
+	published atomic.Uint64
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+	queued    atomic.Int64
+}
+
+// EventMetrics is a snapshot of the delivery counters for one event name and
+// topic. The counters mean the same as the bus-wide ones in Metrics.
+type EventMetrics struct {
+	// Event is the EventName of the published events.
+	Event string
+
+	// Topic is the topic the events were published on, "" for none, or
+	// OtherTopic for topics beyond the limit. It is always "" unless the
+	// bus was created with WithTopicMetrics.
+	Topic string
+
+	Published uint64
+	Delivered uint64
+	Dropped   uint64
+
+	// QueueDepth is the number of these events waiting for a handler,
+	// including those held in ordering lanes (see WithOrderedBy).
+	QueueDepth int
+}
+
+// statsFor returns the counters for events named name published on topic,
+// creating them on first use.
+func (b *EventBus) statsFor(name, topic string) *eventStats {
+	b.statsMu.RLock()
+	key := eventKey{name: name, topic: b.metricsTopic(topic, false)}
+	s, ok := b.stats[key]
+	b.statsMu.RUnlock()
+	if ok {
+		return s
+	}
+
+	b.statsMu.Lock()
+	defer b.statsMu.Unlock()
+	key.topic = b.metricsTopic(topic, true)
+	if s, ok = b.stats[key]; !ok {
+		s = &eventStats{}
+		b.stats[key] = s
+	}
+	return s
+}
+
+// metricsTopic returns the topic events published on topic are counted
+// under. With admit, a new topic within the limit gets its own counters;
+// b.statsMu must be held for writing then, and at least for reading
+// otherwise.
+func (b *EventBus) metricsTopic(topic string, admit bool) string {
+	if b.topicLimit == 0 || topic == "" {
+		return ""
+	}
+	if _, ok := b.topics[topic]; ok {
+		return topic
+	}
+	if len(b.topics) >= b.topicLimit {
+		return OtherTopic
+	}
+	if admit {
+		b.topics[topic] = struct{}{}
+	}
+	return topic
+}
+
+// eventMetrics returns a snapshot of the per-event counters, sorted by event
+// name, then topic.
+func (b *EventBus) eventMetrics() []EventMetrics {
+	b.statsMu.RLock()
+	defer b.statsMu.RUnlock()
+
+	if len(b.stats) == 0 {
+		return nil
+	}
+	events := make([]EventMetrics, 0, len(b.stats))
+	for key, s := range b.stats {
+		events = append(events, EventMetrics{
+			Event:      key.name,
+			Topic:      key.topic,
+			Published:  s.published.Load(),
+			Delivered:  s.delivered.Load(),
+			Dropped:    s.dropped.Load(),
+			QueueDepth: int(max(s.queued.Load(), 0)),
+		})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Event != events[j].Event {
+			return events[i].Event < events[j].Event
+		}
+		return events[i].Topic < events[j].Topic
+	})
+	return events
+}
+
+// dropEnvelope records that a queued or replayed envelope was discarded
+// before reaching its handler.
+func dropEnvelope(env eventEnvelope) {
+	if env.stats == nil {
+		return
+	}
+	if env.queued {
+		env.stats.queued.Add(-1)
+	}
+	env.stats.dropped.Add(1)
+}
@@ -3,16 +3,18 @@ package eventbus
 // SubscribeOption configures a subscription.
 //
 // Options are passed to Subscribe to customize the subscription behavior.
-// Use [WithTopic] to filter events by topic and [WithBufferSize] to control
-// the async delivery buffer.
+// Use [WithTopic] to filter events by topic, [WithFilter] to filter them by
+// content, and [WithBufferSize] to control the async delivery buffer.
 type SubscribeOption func(*subscribeOptions)
 
 // subscribeOptions holds subscription configuration.
 //
 // These are internal options applied via functional option pattern.
 type subscribeOptions struct {
-	topic      string // Optional topic filter (empty = all topics)
-	bufferSize int    // Buffer size for async delivery (default: 100)
+	topic      string             // Optional topic filter (empty = all topics)
+	bufferSize int                // Buffer size for async delivery (default: 100)
+	orderBy    func(Event) string // Optional ordering key (nil = one event at a time)
+	filter     func(Event) bool   // Optional content filter (nil = all events)
 }
 
 // defaultSubscribeOptions returns the default subscription configuration.
@@ -71,6 +73,55 @@ func WithBufferSize(size int) SubscribeOption {
 	}
 }
 
+// WithOrderedBy delivers events concurrently across keys while keeping events
+// with the same key in publish order.
+//
+// By default a subscription handles one event at a time. With WithOrderedBy,
+// key is computed for each event; events sharing a key are handled
+// sequentially, and events with different keys are handled in parallel. Use
+// it to feed per-entity state machines without serializing unrelated
+// entities. The buffer size bounds the number of events in flight across all
+// keys.
+//
+// # Example
+//
+//	// Events for the same order are processed in sequence.
+//	eventbus.Subscribe[OrderEvent](bus, handler, eventbus.WithOrderedBy(func(e eventbus.Event) string {
+//	    return e.(OrderEvent).OrderID
+//	}))
+func WithOrderedBy(key func(Event) string) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.orderBy = key
+	}
+}
+
+// WithFilter delivers only events for which match returns true.
+//
+// The predicate runs in Publish, before the event is queued, so events it
+// rejects never reach the handler and take no space in its buffer. Use it
+// instead of an early-return guard at the top of the handler. Retained
+// events replayed by [WithReplay] are filtered the same way. WithFilter
+// composes with [WithTopic]: an event must match both.
+//
+// match is called on the publisher's goroutine and must be safe for
+// concurrent use. A panic in match is recovered and logged, and the event
+// is not delivered to this subscriber. The type parameter must match the subscribed type;
+// events of any other type are rejected.
+//
+// # Example
+//
+//	eventbus.Subscribe[OrderPlaced](bus, handler, eventbus.WithFilter(func(e OrderPlaced) bool {
+//	    return e.Amount > 1000
+//	}))
+func WithFilter[T Event](match func(event T) bool) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.filter = func(event Event) bool {
+			typed, ok := event.(T)
+			return ok && match(typed)
+		}
+	}
+}
+
 // applyOptions applies the given options to the default configuration.
 //
 // This is an internal helper used by Subscribe to merge options.
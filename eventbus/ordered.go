@@ -0,0 +1,107 @@
+package eventbus
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// keyLane is the queue of events for one ordering key. A lane's goroutine
+// delivers its events in order and exits once the queue is empty.
+type keyLane struct {
+	queue []eventEnvelope
+}
+
+// runOrdered processes events from the channel with per-key ordering: events
+// with the same key are handled one at a time in publish order, while events
+// with different keys are handled concurrently.
+//
+// At most cap(ch) events (minimum 1) are in flight across all lanes, so a
+// slow key still applies backpressure to Publish.
+func (s *asyncSubscription) runOrdered(replay []eventEnvelope, logger *slog.Logger) {
+	defer close(s.done)
+
+	var wg sync.WaitGroup
+	s.laneMu.Lock()
+	s.lanes = make(map[string]*keyLane)
+	s.slots = make(chan struct{}, max(cap(s.ch), 1))
+	lanes, slots := s.lanes, s.slots
+	s.laneMu.Unlock()
+
+	drain := func(key string, lane *keyLane) {
+		defer wg.Done()
+		for {
+			s.laneMu.Lock()
+			if len(lane.queue) == 0 {
+				delete(lanes, key)
+				s.laneMu.Unlock()
+				return
+			}
+			env := lane.queue[0]
+			lane.queue = lane.queue[1:]
+			s.laneMu.Unlock()
+
+			s.safeInvoke(env, logger)
+			<-slots
+		}
+	}
+
+	dispatch := func(env eventEnvelope) {
+		slots <- struct{}{}
+		key := s.orderKey(env.event, logger)
+
+		s.laneMu.Lock()
+		lane, ok := lanes[key]
+		if !ok {
+			lane = &keyLane{}
+			lanes[key] = lane
+			wg.Add(1)
+			go drain(key, lane)
+		}
+		lane.queue = append(lane.queue, env)
+		s.laneMu.Unlock()
+	}
+
+	for _, env := range replay {
+		dispatch(env)
+	}
+	for env := range s.ch {
+		dispatch(env)
+	}
+
+	// Channel closed: wait for all lanes to finish their queued events.
+	wg.Wait()
+}
+
+// discardLanes drops the events waiting in the ordering lanes, releasing
+// their in-flight slots, and returns how many were dropped. Events already
+// being handled are left to finish.
+func (s *asyncSubscription) discardLanes() int {
+	s.laneMu.Lock()
+	defer s.laneMu.Unlock()
+
+	dropped := 0
+	for _, lane := range s.lanes {
+		for _, env := range lane.queue {
+			dropEnvelope(env)
+		}
+		dropped += len(lane.queue)
+		lane.queue = nil
+	}
+	for range dropped {
+		<-s.slots
+	}
+	return dropped
+}
+
+// orderKey computes the ordering key for event. A panicking key function is
+// logged and the event falls back to the empty key.
+func (s *asyncSubscription) orderKey(event any, logger *slog.Logger) (key string) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("ordering key panic recovered", "error", r)
+			key = ""
+		}
+	}()
+	//nolint:errcheck // Events are published as Event by Publish[T Event]
+	return s.orderBy(event.(Event))
+}
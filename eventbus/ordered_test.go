@@ -0,0 +1,114 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func orderByID(e Event) string {
+	return e.(testEvent).ID
+}
+
+func TestOrderedBy_SameKeyInPublishOrder(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	var (
+		mu  sync.Mutex
+		got = make(map[string][]int)
+	)
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		n, _ := strconv.Atoi(e.Message)
+		// Jitter so unordered delivery would reorder events.
+		time.Sleep(time.Duration(n%3) * time.Millisecond)
+		mu.Lock()
+		got[e.ID] = append(got[e.ID], n)
+		mu.Unlock()
+	}, WithOrderedBy(orderByID), WithBufferSize(8))
+
+	keys := []string{"a", "b", "c"}
+	for i := range 30 {
+		Publish(context.Background(), bus, testEvent{ID: keys[i%len(keys)], Message: strconv.Itoa(i)}, "")
+	}
+	bus.Close() // Drains all lanes
+
+	for k, key := range keys {
+		var want []int
+		for i := k; i < 30; i += len(keys) {
+			want = append(want, i)
+		}
+		assert.Equal(t, want, got[key], key)
+	}
+}
+
+func TestOrderedBy_DifferentKeysConcurrent(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	// The "a" handler blocks until "b" has been handled, which only succeeds
+	// if different keys are dispatched concurrently.
+	bHandled := make(chan struct{})
+	aDone := make(chan struct{})
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		switch e.ID {
+		case "a":
+			select {
+			case <-bHandled:
+				close(aDone)
+			case <-time.After(2 * time.Second):
+			}
+		case "b":
+			close(bHandled)
+		}
+	}, WithOrderedBy(orderByID))
+
+	Publish(context.Background(), bus, testEvent{ID: "a"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "b"}, "")
+
+	select {
+	case <-aDone:
+	case <-time.After(time.Second):
+		t.Fatal("event for key b was blocked behind key a")
+	}
+}
+
+func TestOrderedBy_SameKeyNotConcurrent(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	var (
+		mu         sync.Mutex
+		active     int
+		maxActive  int
+		handledAll sync.WaitGroup
+	)
+	handledAll.Add(10)
+	Subscribe(bus, func(_ context.Context, _ testEvent) {
+		defer handledAll.Done()
+		mu.Lock()
+		active++
+		maxActive = max(maxActive, active)
+		mu.Unlock()
+
+		time.Sleep(2 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+	}, WithOrderedBy(orderByID))
+
+	for range 10 {
+		Publish(context.Background(), bus, testEvent{ID: "same"}, "")
+	}
+	handledAll.Wait()
+	bus.Close()
+
+	require.Equal(t, 1, maxActive)
+}
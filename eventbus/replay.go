@@ -0,0 +1,76 @@
+package eventbus
+
+import (
+	"context"
+	"reflect"
+)
+
+// Option configures an EventBus.
+type Option func(*EventBus)
+
+// WithReplay retains the last n published events of each event type and
+// delivers them to new subscribers before live events. Topic filters apply
+// to replayed events as they do to live ones.
+//
+// Replay closes the startup window in which a publisher emits events before
+// a subscriber registers, e.g. when both start as workers. Replayed events
+// are delivered with the publisher's context values but without its
+// cancellation.
+//
+// # Example
+//
+//	bus := eventbus.New(logger, eventbus.WithReplay(100))
+func WithReplay(n int) Option {
+	return func(b *EventBus) {
+		b.replaySize = max(n, 0)
+	}
+}
+
+// replayEntry is a retained event with the topic it was published on.
+type replayEntry struct {
+	env   eventEnvelope
+	topic string
+}
+
+// retain records event in its type's replay buffer, dropping the oldest entry
+// beyond the configured size.
+//
+// Publish calls retain while holding b.mu.RLock and Subscribe snapshots the
+// buffer under b.mu.Lock, so a new subscriber receives each event either by
+// replay or live, never both.
+func (b *EventBus) retain(ctx context.Context, eventType reflect.Type, event any, topic string, stats *eventStats) {
+	if b.replaySize == 0 {
+		return
+	}
+
+	b.replayMu.Lock()
+	defer b.replayMu.Unlock()
+
+	entries := append(b.replay[eventType], replayEntry{
+		env:   eventEnvelope{ctx: context.WithoutCancel(ctx), event: event, stats: stats},
+		topic: topic,
+	})
+	if len(entries) > b.replaySize {
+		entries = append([]replayEntry(nil), entries[len(entries)-b.replaySize:]...)
+	}
+	b.replay[eventType] = entries
+}
+
+// replayFor returns the retained events a new subscription for eventType and
+// topic should receive, oldest first.
+func (b *EventBus) replayFor(eventType reflect.Type, topic string) []eventEnvelope {
+	if b.replaySize == 0 {
+		return nil
+	}
+
+	b.replayMu.Lock()
+	defer b.replayMu.Unlock()
+
+	var envs []eventEnvelope
+	for _, e := range b.replay[eventType] {
+		if topic == "" || e.topic == topic {
+			envs = append(envs, e.env)
+		}
+	}
+	return envs
+}
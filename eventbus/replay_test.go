@@ -0,0 +1,121 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collect subscribes to testEvent and returns a func reporting received IDs.
+func collect(bus *EventBus, opts ...SubscribeOption) func() []string {
+	var (
+		mu  sync.Mutex
+		ids []string
+	)
+	Subscribe(bus, func(_ context.Context, e testEvent) {
+		mu.Lock()
+		ids = append(ids, e.ID)
+		mu.Unlock()
+	}, opts...)
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), ids...)
+	}
+}
+
+func TestReplay_LateSubscriberReceivesLastN(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithReplay(3))
+	defer bus.Close()
+
+	for i := range 5 {
+		Publish(context.Background(), bus, testEvent{ID: strconv.Itoa(i)}, "")
+	}
+
+	got := collect(bus)
+	Publish(context.Background(), bus, testEvent{ID: "live"}, "")
+
+	// Buffer caps at 3: the two oldest events are dropped.
+	require.Eventually(t, func() bool { return len(got()) == 4 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"2", "3", "4", "live"}, got())
+}
+
+func TestReplay_RespectsTopicAndType(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithReplay(10))
+	defer bus.Close()
+
+	Publish(context.Background(), bus, testEvent{ID: "admin"}, "admin")
+	Publish(context.Background(), bus, testEvent{ID: "user"}, "user")
+	Publish(context.Background(), bus, anotherEvent{Value: 1}, "")
+
+	admin := collect(bus, WithTopic("admin"))
+	all := collect(bus)
+
+	require.Eventually(t, func() bool { return len(all()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"admin", "user"}, all())
+	assert.Equal(t, []string{"admin"}, admin())
+}
+
+func TestReplay_AppliesFilter(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithReplay(10))
+	defer bus.Close()
+
+	Publish(context.Background(), bus, testEvent{ID: "1", Message: "keep"}, "")
+	Publish(context.Background(), bus, testEvent{ID: "2", Message: "drop"}, "")
+
+	got := collect(bus, WithFilter(func(e testEvent) bool { return e.Message == "keep" }))
+	Publish(context.Background(), bus, testEvent{ID: "3", Message: "keep"}, "")
+
+	require.Eventually(t, func() bool { return len(got()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"1", "3"}, got())
+}
+
+func TestReplay_DisabledByDefault(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+
+	Publish(context.Background(), bus, testEvent{ID: "early"}, "")
+	got := collect(bus)
+	bus.Close()
+
+	assert.Empty(t, got())
+}
+
+func TestReplay_PublisherCancellationNotReplayed(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger(), WithReplay(1))
+	defer bus.Close()
+
+	type ctxKey string
+	const traceKey ctxKey = "trace_id"
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), traceKey, "trace-1"))
+	Publish(ctx, bus, testEvent{ID: "1"}, "")
+	cancel()
+
+	type seen struct {
+		trace string
+		err   error
+	}
+	ch := make(chan seen, 1)
+	Subscribe(bus, func(ctx context.Context, _ testEvent) {
+		trace, _ := ctx.Value(traceKey).(string)
+		ch <- seen{trace: trace, err: ctx.Err()}
+	})
+
+	select {
+	case s := <-ch:
+		assert.Equal(t, "trace-1", s.trace)
+		assert.NoError(t, s.err)
+	case <-time.After(time.Second):
+		t.Fatal("replayed event not delivered")
+	}
+}
@@ -0,0 +1,120 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// correlationKey is the context key carrying a request's correlation ID.
+type correlationKey struct{}
+
+// pendingRequest is a Request waiting for its reply.
+type pendingRequest struct {
+	respType reflect.Type
+	reply    chan any // Buffered (1); receives the first matching reply
+}
+
+// Request publishes req on topic and waits for a handler to answer it with
+// [Reply]. The request is tagged with a correlation ID carried in the
+// handler's context, so concurrent requests never receive each other's
+// replies.
+//
+// Request returns when a reply arrives or ctx is done; on timeout the error
+// wraps ctx.Err(). Always give ctx a deadline: if no handler replies, Request
+// blocks until ctx is cancelled. It returns ErrClosed if the bus is closed.
+//
+// # Example
+//
+//	eventbus.Subscribe(bus, func(ctx context.Context, q GetPrice) {
+//	    _ = eventbus.Reply(ctx, bus, PriceQuote{SKU: q.SKU, Cents: lookup(q.SKU)})
+//	})
+//
+//	ctx, cancel := context.WithTimeout(ctx, time.Second)
+//	defer cancel()
+//	quote, err := eventbus.Request[GetPrice, PriceQuote](ctx, bus, GetPrice{SKU: "A1"}, "")
+func Request[Req Event, Resp Event](ctx context.Context, b *EventBus, req Req, topic string) (Resp, error) {
+	var zero Resp
+
+	pending := &pendingRequest{
+		respType: reflect.TypeOf((*Resp)(nil)).Elem(),
+		reply:    make(chan any, 1),
+	}
+	id, err := b.registerRequest(pending)
+	if err != nil {
+		return zero, err
+	}
+	defer b.unregisterRequest(id)
+
+	Publish(context.WithValue(ctx, correlationKey{}, id), b, req, topic)
+
+	select {
+	case resp := <-pending.reply:
+		//nolint:errcheck // Type is checked against respType by Reply
+		return resp.(Resp), nil
+	case <-ctx.Done():
+		return zero, fmt.Errorf("eventbus: request %s: %w", req.EventName(), ctx.Err())
+	}
+}
+
+// Reply answers the request being handled. ctx must be the context passed to
+// the handler, which carries the request's correlation ID.
+//
+// Only the first reply to a request is delivered. Reply returns
+// ErrNoPendingRequest if ctx does not belong to a request, or the request has
+// already been answered or timed out, and ErrReplyType if resp is not the
+// type the requester expects.
+func Reply[Resp Event](ctx context.Context, b *EventBus, resp Resp) error {
+	id, ok := CorrelationID(ctx)
+	if !ok {
+		return ErrNoPendingRequest
+	}
+
+	b.requestsMu.Lock()
+	defer b.requestsMu.Unlock()
+
+	pending, ok := b.requests[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoPendingRequest, id)
+	}
+	if got := reflect.TypeOf(resp); got != pending.respType {
+		return fmt.Errorf("%w: got %s, want %s", ErrReplyType, got, pending.respType)
+	}
+
+	pending.reply <- resp
+	delete(b.requests, id)
+	return nil
+}
+
+// CorrelationID returns the correlation ID of the request being handled, if
+// ctx belongs to one. It is useful for logging.
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationKey{}).(string)
+	return id, ok
+}
+
+// registerRequest assigns a correlation ID to pending and records it.
+func (b *EventBus) registerRequest(pending *pendingRequest) (string, error) {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+	if closed {
+		return "", ErrClosed
+	}
+
+	b.requestsMu.Lock()
+	defer b.requestsMu.Unlock()
+
+	b.nextRequest++
+	id := strconv.FormatUint(b.nextRequest, 10)
+	b.requests[id] = pending
+	return id, nil
+}
+
+// unregisterRequest forgets a request once Request returns.
+func (b *EventBus) unregisterRequest(id string) {
+	b.requestsMu.Lock()
+	defer b.requestsMu.Unlock()
+	delete(b.requests, id)
+}
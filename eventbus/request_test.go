@@ -0,0 +1,120 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoRequest struct {
+	N int
+}
+
+func (e echoRequest) EventName() string { return "echoRequest" }
+
+type echoResponse struct {
+	N int
+}
+
+func (e echoResponse) EventName() string { return "echoResponse" }
+
+func TestRequestReply(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	Subscribe(bus, func(ctx context.Context, req echoRequest) {
+		_, ok := CorrelationID(ctx)
+		assert.True(t, ok)
+		assert.NoError(t, Reply(ctx, bus, echoResponse{N: req.N * 2}))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := Request[echoRequest, echoResponse](ctx, bus, echoRequest{N: 21}, "")
+	require.NoError(t, err)
+	assert.Equal(t, 42, resp.N)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	// A handler that never replies.
+	Subscribe(bus, func(_ context.Context, _ echoRequest) {})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := Request[echoRequest, echoResponse](ctx, bus, echoRequest{N: 1}, "")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "echoRequest")
+}
+
+func TestRequestConcurrentCorrelation(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	// Reply out of order so a missing correlation would mix up responses.
+	Subscribe(bus, func(ctx context.Context, req echoRequest) {
+		time.Sleep(time.Duration(10-req.N%10) * time.Millisecond)
+		_ = Reply(ctx, bus, echoResponse{N: req.N})
+	}, WithBufferSize(0))
+	Subscribe(bus, func(ctx context.Context, req echoRequest) {
+		_ = Reply(ctx, bus, echoResponse{N: req.N})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Go(func() {
+			resp, err := Request[echoRequest, echoResponse](ctx, bus, echoRequest{N: i}, "")
+			if assert.NoError(t, err, strconv.Itoa(i)) {
+				assert.Equal(t, i, resp.N)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestReplyErrors(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	defer bus.Close()
+
+	require.ErrorIs(t, Reply(context.Background(), bus, echoResponse{}), ErrNoPendingRequest)
+
+	errs := make(chan error, 2)
+	Subscribe(bus, func(ctx context.Context, _ echoRequest) {
+		errs <- Reply(ctx, bus, testEvent{ID: "wrong"})
+		errs <- Reply(ctx, bus, echoResponse{N: 1})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	resp, err := Request[echoRequest, echoResponse](ctx, bus, echoRequest{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.N)
+	require.ErrorIs(t, <-errs, ErrReplyType)
+	require.NoError(t, <-errs)
+}
+
+func TestRequestClosedBus(t *testing.T) {
+	t.Parallel()
+	bus := New(testLogger())
+	bus.Close()
+
+	_, err := Request[echoRequest, echoResponse](context.Background(), bus, echoRequest{}, "")
+	require.ErrorIs(t, err, ErrClosed)
+}
@@ -256,7 +256,9 @@ func (n *NotificationSubscriber) OnStop(ctx context.Context) error {
 var _ worker.Worker = (*NotificationSubscriber)(nil)
 
 func run(ctx context.Context) error {
-	app := gaz.New()
+	// Replay recent events so OrderProcessor sees orders published by
+	// OrderSimulator before it subscribed.
+	app := gaz.New(gaz.WithEventBusReplay(100))
 
 	// Register modules
 	// Health module: provides /live, /ready, /startup endpoints
@@ -208,24 +208,19 @@ func run() error {
 		},
 	)
 
-	// Build the container
-	if err := app.Build(); err != nil {
-		return fmt.Errorf("failed to build: %w", err)
-	}
-
-	// Resolve and run the application
-	application, err := gaz.Resolve[*Application](app.Container())
-	if err != nil {
-		return fmt.Errorf("failed to resolve Application: %w", err)
-	}
-
-	if err := application.Run(); err != nil {
-		return fmt.Errorf("application error: %w", err)
-	}
-
-	// Note: In this example we don't call app.Run() because there's no
-	// long-running service. For servers/workers, use app.Run(ctx) instead.
-	return app.Stop(context.Background())
+	// RunOnce builds the container, starts services, runs the task, and
+	// shuts down gracefully. For servers/workers, use app.Run(ctx) instead.
+	return app.RunOnce(context.Background(), func(_ context.Context) error {
+		application, err := gaz.Resolve[*Application](app.Container())
+		if err != nil {
+			return fmt.Errorf("failed to resolve Application: %w", err)
+		}
+
+		if err := application.Run(); err != nil {
+			return fmt.Errorf("application error: %w", err)
+		}
+		return nil
+	})
 }
 
 func main() {
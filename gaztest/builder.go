@@ -3,10 +3,13 @@ package gaztest
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"os"
 	"reflect"
 	"time"
 
 	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/cron"
 	"github.com/petabytecl/gaz/di"
 )
 
@@ -39,6 +42,8 @@ type Builder struct {
 	baseApp      *gaz.App
 	modules      []di.Module
 	configMap    map[string]any
+	env          map[string]string
+	clock        cron.Clock
 	errs         []error
 }
 
@@ -102,6 +107,41 @@ func (b *Builder) WithConfigMap(values map[string]any) *Builder {
 	return b
 }
 
+// WithEnv sets environment variables for the duration of the test.
+// The variables are set when Build is called, so env-based config binding
+// sees them, and are restored to their previous values by t.Cleanup.
+// Variables are set with t.Setenv, so WithEnv cannot be used in parallel
+// tests. Repeated calls add to the set of variables.
+//
+// Example:
+//
+//	app, err := gaztest.New(t).
+//	    WithEnv(map[string]string{"REDIS_HOST": "localhost"}).
+//	    Build()
+func (b *Builder) WithEnv(vars map[string]string) *Builder {
+	if b.env == nil {
+		b.env = make(map[string]string, len(vars))
+	}
+	maps.Copy(b.env, vars)
+	return b
+}
+
+// WithClock sets the clock the app's cron scheduler uses, typically a
+// cron.FakeClock, so scheduled jobs run when the test advances time. With
+// WithApp, the base app must not have been built yet.
+//
+// Example:
+//
+//	clock := cron.NewFakeClock(time.Now())
+//	app, err := gaztest.New(t).WithApp(baseApp).WithClock(clock).Build()
+//	require.NoError(t, err)
+//	app.RequireStart()
+//	gaztest.RequireJobRanWithin(t, app, "cleanup", clock, time.Minute)
+func (b *Builder) WithClock(clock cron.Clock) *Builder {
+	b.clock = clock
+	return b
+}
+
 // Replace registers a mock instance to replace a type in the container.
 // The type to replace is inferred from the instance using reflection.
 //
@@ -145,15 +185,24 @@ func (b *Builder) Build() (*App, error) {
 		panic("gaztest: cannot use WithApp and WithModules together - use either a pre-built app or module registration")
 	}
 
+	// Set env vars before the app binds its config
+	if err := b.setEnv(); err != nil {
+		return nil, err
+	}
+
 	var gazApp *gaz.App
 
 	// Use base app or create new one
 	if b.baseApp != nil {
 		gazApp = b.baseApp
+		if b.clock != nil {
+			gaz.WithCronClock(b.clock)(gazApp)
+		}
 	} else {
 		gazApp = gaz.New(
 			gaz.WithShutdownTimeout(b.timeout),
 			gaz.WithPerHookTimeout(b.timeout),
+			gaz.WithCronClock(b.clock),
 		)
 
 		// Register modules if provided
@@ -197,3 +246,34 @@ func (b *Builder) Build() (*App, error) {
 
 	return app, nil
 }
+
+// envSetter is implemented by testing.T and testing.B.
+type envSetter interface {
+	Setenv(key, value string)
+}
+
+// setEnv sets the WithEnv variables through tb.Setenv. For a TB without
+// Setenv it sets them directly and registers cleanups that restore their
+// previous values.
+func (b *Builder) setEnv() error {
+	if setter, ok := b.tb.(envSetter); ok {
+		for key, value := range b.env {
+			setter.Setenv(key, value)
+		}
+		return nil
+	}
+	for key, value := range b.env {
+		prev, existed := os.LookupEnv(key)
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("gaztest: WithEnv: set %s: %w", key, err)
+		}
+		b.tb.Cleanup(func() {
+			if existed {
+				_ = os.Setenv(key, prev)
+			} else {
+				_ = os.Unsetenv(key)
+			}
+		})
+	}
+	return nil
+}
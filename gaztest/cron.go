@@ -0,0 +1,84 @@
+package gaztest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/petabytecl/gaz/cron"
+)
+
+// jobPollInterval is how often RequireJobRanWithin re-checks a job's runs.
+const jobPollInterval = time.Millisecond
+
+// RequireJobRanWithin advances clock by window and asserts the cron job
+// named jobName ran at least once more as a result. The app must be built
+// with Builder.WithClock(clock). RequireStart does not run workers, so the
+// app's cron scheduler is started here if it is not running yet, and stopped
+// when the test ends. It waits, in real time and up to the app's timeout,
+// for the scheduler to wait on the clock and for the run to finish.
+//
+// Example:
+//
+//	clock := cron.NewFakeClock(time.Now())
+//	app, err := gaztest.New(t).WithApp(baseApp).WithClock(clock).Build()
+//	require.NoError(t, err)
+//	app.RequireStart()
+//	gaztest.RequireJobRanWithin(t, app, "cleanup", clock, time.Minute)
+func RequireJobRanWithin(tb testing.TB, app *App, jobName string, clock *cron.FakeClock, window time.Duration) {
+	tb.Helper()
+
+	before, ok := jobRuns(app, jobName)
+	if !ok {
+		tb.Fatalf("gaztest: no cron job named %q", jobName)
+		return
+	}
+	startScheduler(tb, app)
+	if !clock.WaitForTimers(1, app.timeout) {
+		tb.Fatalf("gaztest: cron scheduler is not waiting on the clock; was the app built with Builder.WithClock?")
+		return
+	}
+
+	clock.Advance(window)
+
+	deadline := time.Now().Add(app.timeout)
+	for {
+		if runs, _ := jobRuns(app, jobName); runs > before {
+			return
+		}
+		if time.Now().After(deadline) {
+			tb.Fatalf("gaztest: expected cron job %q to run within %s", jobName, window)
+			return
+		}
+		time.Sleep(jobPollInterval)
+	}
+}
+
+// startScheduler starts the app's cron scheduler unless it is running, and
+// stops it when the test ends.
+func startScheduler(tb testing.TB, app *App) {
+	tb.Helper()
+	scheduler := app.app.Scheduler()
+	if scheduler.IsRunning() {
+		return
+	}
+	if err := scheduler.OnStart(context.Background()); err != nil {
+		tb.Fatalf("gaztest: start cron scheduler: %v", err)
+		return
+	}
+	tb.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.timeout)
+		defer cancel()
+		_ = scheduler.OnStop(ctx)
+	})
+}
+
+// jobRuns returns the completed runs of the named cron job.
+func jobRuns(app *App, jobName string) (int64, bool) {
+	for _, job := range app.app.Metrics().Jobs {
+		if job.Name == jobName {
+			return job.Runs, true
+		}
+	}
+	return 0, false
+}
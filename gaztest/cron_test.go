@@ -0,0 +1,55 @@
+package gaztest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/cron"
+	"github.com/petabytecl/gaz/gaztest"
+)
+
+// newCronApp builds and starts a test app that schedules job on clock. It
+// also returns the underlying gaz.App.
+func newCronApp(t *testing.T, job *cron.SimpleJob, clock *cron.FakeClock) (*gaztest.App, *gaz.App) {
+	t.Helper()
+	base := gaz.New()
+	require.NoError(t, gaz.For[cron.CronJob](base.Container()).Named(job.Name()).Transient().
+		Provider(func(_ *gaz.Container) (cron.CronJob, error) { return job, nil }))
+
+	app, err := gaztest.New(t).WithApp(base).WithClock(clock).Build()
+	require.NoError(t, err)
+	app.RequireStart()
+	return app, base
+}
+
+func TestRequireJobRanWithin(t *testing.T) {
+	clock := cron.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	job := cron.NewSimpleJob("cleanup", "@every 1m")
+	app, _ := newCronApp(t, job, clock)
+
+	gaztest.RequireJobRanWithin(t, app, "cleanup", clock, time.Minute)
+
+	cron.RequireJobRunCount(t, job, 1)
+}
+
+func TestRequireJobRanWithin_NotBeforeSchedule(t *testing.T) {
+	clock := cron.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	job := cron.NewSimpleJob("cleanup", "@every 1m")
+	app, base := newCronApp(t, job, clock)
+
+	require.NoError(t, base.Scheduler().OnStart(context.Background()))
+	t.Cleanup(func() { _ = base.Scheduler().OnStop(context.Background()) })
+	require.True(t, clock.WaitForTimers(1, time.Second))
+	clock.Advance(59 * time.Second)
+
+	// The timer is not due, so the scheduler has nothing to run.
+	require.Equal(t, 1, clock.PendingTimers())
+	cron.RequireJobNotRan(t, job)
+
+	gaztest.RequireJobRanWithin(t, app, "cleanup", clock, time.Second)
+	cron.RequireJobRunCount(t, job, 1)
+}
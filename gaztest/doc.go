@@ -83,16 +83,38 @@
 //	    // ...
 //	}
 //
+// Use WithEnv to test environment variable binding. The variables are
+// restored when the test ends:
+//
+//	app, err := gaztest.New(t).
+//	    WithEnv(map[string]string{"REDIS_HOST": "localhost"}).
+//	    Build()
+//
 // # Subsystem Test Helpers
 //
 // Each subsystem provides test helpers in a testing.go file:
 //
 //   - health: TestConfig, MockRegistrar, RequireHealthy
 //   - worker: MockWorker, SimpleWorker, RequireWorkerStarted
-//   - cron: MockJob, SimpleJob, RequireJobRan
+//   - cron: MockJob, SimpleJob, FakeClock, RequireJobRan
 //   - config: MapBackend, TestManager, RequireConfigLoaded
 //   - eventbus: TestBus, TestSubscriber, RequireEventsReceived
 //
+// When readiness depends on background work, [RequireEventuallyHealthy]
+// polls the app's health.Manager until its readiness checks pass.
+//
+// # Scheduled Jobs
+//
+// [Builder.WithClock] gives the app's cron scheduler a cron.FakeClock, and
+// [RequireJobRanWithin] advances it and asserts a job ran, without waiting
+// for the real schedule:
+//
+//	clock := cron.NewFakeClock(time.Now())
+//	app, err := gaztest.New(t).WithApp(baseApp).WithClock(clock).Build()
+//	require.NoError(t, err)
+//	app.RequireStart()
+//	gaztest.RequireJobRanWithin(t, app, "cleanup", clock, time.Minute)
+//
 // # Custom Timeout
 //
 //	func TestWithTimeout(t *testing.T) {
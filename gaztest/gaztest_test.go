@@ -2,6 +2,7 @@ package gaztest_test
 
 import (
 	"context"
+	"os"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -651,3 +652,59 @@ func TestBuilder_WithConfigMap(t *testing.T) {
 	require.Equal(t, 123, pv.GetInt("test.number"))
 	require.Equal(t, true, pv.GetBool("test.enabled"))
 }
+
+// =============================================================================
+// TestBuilder_WithEnv
+// =============================================================================
+
+// cacheConfigProvider declares a config key with a default for env tests.
+type cacheConfigProvider struct{}
+
+func (p *cacheConfigProvider) ConfigNamespace() string { return "gaztestcache" }
+
+func (p *cacheConfigProvider) ConfigFlags() []gaz.ConfigFlag {
+	return []gaz.ConfigFlag{
+		{Key: "host", Type: gaz.ConfigFlagTypeString, Default: "default-host"},
+	}
+}
+
+func TestBuilder_WithEnv(t *testing.T) {
+	const envKey = "GAZTESTCACHE_HOST"
+	_, existed := os.LookupEnv(envKey)
+	require.False(t, existed, "%s must not be set before the test", envKey)
+
+	t.Run("overrides provider default", func(t *testing.T) {
+		baseApp := gaz.New()
+		err := gaz.For[*cacheConfigProvider](baseApp.Container()).
+			ProviderFunc(func(_ *gaz.Container) *cacheConfigProvider {
+				return &cacheConfigProvider{}
+			})
+		require.NoError(t, err)
+
+		app, err := gaztest.New(t).
+			WithApp(baseApp).
+			WithEnv(map[string]string{envKey: "env-host"}).
+			Build()
+		require.NoError(t, err)
+
+		pv := gaztest.RequireResolve[*gaz.ProviderValues](t, app)
+		require.Equal(t, "env-host", pv.GetString("gaztestcache.host"))
+	})
+
+	_, existed = os.LookupEnv(envKey)
+	require.False(t, existed, "WithEnv should unset the variable after the test")
+}
+
+func TestBuilder_WithEnv_RestoresPreviousValue(t *testing.T) {
+	t.Setenv("GAZTEST_RESTORE", "original")
+
+	t.Run("overrides", func(t *testing.T) {
+		_, err := gaztest.New(t).
+			WithEnv(map[string]string{"GAZTEST_RESTORE": "override"}).
+			Build()
+		require.NoError(t, err)
+		require.Equal(t, "override", os.Getenv("GAZTEST_RESTORE"))
+	})
+
+	require.Equal(t, "original", os.Getenv("GAZTEST_RESTORE"))
+}
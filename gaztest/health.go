@@ -0,0 +1,53 @@
+package gaztest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/petabytecl/gaz/health"
+)
+
+// healthPollInterval is how often RequireEventuallyHealthy re-runs the
+// readiness checks.
+const healthPollInterval = 10 * time.Millisecond
+
+// RequireEventuallyHealthy polls the app's health.Manager until the readiness
+// checks report up or degraded, failing the test if they still fail after
+// timeout.
+// Use it when services become ready asynchronously, such as after a
+// connection pool warms up.
+//
+// Example:
+//
+//	app, err := gaztest.New(t).WithApp(baseApp).Build()
+//	require.NoError(t, err)
+//	app.RequireStart()
+//	gaztest.RequireEventuallyHealthy(t, app, 2*time.Second)
+func RequireEventuallyHealthy(tb testing.TB, app *App, timeout time.Duration) {
+	tb.Helper()
+	manager := RequireResolve[*health.Manager](tb, app)
+	if manager == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+	for {
+		// Degraded instances still serve traffic, so they count as ready
+		status := manager.ReadinessChecker().Check(ctx).Status
+		if status == health.StatusUp || status == health.StatusDegraded {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			// One last check reports the final status through RequireHealthy
+			health.RequireHealthy(tb, manager)
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package gaztest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/gaztest"
+	"github.com/petabytecl/gaz/health"
+)
+
+// newHealthApp builds a test app whose health.Manager has the given
+// readiness check.
+func newHealthApp(t *testing.T, check health.CheckFunc) *gaztest.App {
+	t.Helper()
+	baseApp := gaz.New()
+	manager := health.NewManager()
+	manager.AddReadinessCheck("warmup", check)
+	require.NoError(t, gaz.For[*health.Manager](baseApp.Container()).Instance(manager))
+
+	app, err := gaztest.New(t).WithApp(baseApp).Build()
+	require.NoError(t, err)
+	return app
+}
+
+func TestRequireEventuallyHealthy_WaitsForReadiness(t *testing.T) {
+	readyAt := time.Now().Add(100 * time.Millisecond)
+	var calls atomic.Int32
+	app := newHealthApp(t, func(context.Context) error {
+		calls.Add(1)
+		if time.Now().Before(readyAt) {
+			return errors.New("pool warming up")
+		}
+		return nil
+	})
+
+	gaztest.RequireEventuallyHealthy(t, app, 2*time.Second)
+
+	require.False(t, time.Now().Before(readyAt), "should wait until the check passes")
+	require.Greater(t, calls.Load(), int32(1), "should poll more than once")
+}
+
+func TestRequireEventuallyHealthy_AcceptsDegraded(t *testing.T) {
+	baseApp := gaz.New()
+	manager := health.NewManager(health.WithDegradedThreshold(0.5))
+	manager.AddReadinessCheckWithOptions("cache", func(context.Context) error {
+		return errors.New("cache unavailable")
+	}, health.WithNonCritical())
+	require.NoError(t, gaz.For[*health.Manager](baseApp.Container()).Instance(manager))
+
+	app, err := gaztest.New(t).WithApp(baseApp).Build()
+	require.NoError(t, err)
+
+	mockT := &healthFatalfCatcher{TB: t}
+	gaztest.RequireEventuallyHealthy(mockT, app, 50*time.Millisecond)
+	require.False(t, mockT.fatalfCalled, "degraded readiness should pass")
+}
+
+func TestRequireEventuallyHealthy_FailsAtTimeout(t *testing.T) {
+	app := newHealthApp(t, func(context.Context) error {
+		return errors.New("never ready")
+	})
+
+	mockT := &healthFatalfCatcher{TB: t}
+	start := time.Now()
+	gaztest.RequireEventuallyHealthy(mockT, app, 50*time.Millisecond)
+
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	require.True(t, mockT.fatalfCalled, "Fatalf should have been called")
+	require.Contains(t, mockT.fatalfMessage, "RequireHealthy")
+}
+
+// healthFatalfCatcher captures Fatalf calls without terminating the test.
+// It embeds testing.TB because the health helpers require the full interface.
+type healthFatalfCatcher struct {
+	testing.TB
+
+	fatalfCalled  bool
+	fatalfMessage string
+}
+
+func (m *healthFatalfCatcher) Fatalf(format string, args ...any) {
+	m.fatalfCalled = true
+	m.fatalfMessage = fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,64 @@
+// Package grpcclient registers a gRPC client connection in the gaz DI
+// container for calling other services.
+//
+// # Quick Start
+//
+// Use the module to register a *grpc.ClientConn for a target service:
+//
+//	app := gaz.New()
+//	app.Use(grpcclient.NewModule(
+//	    grpcclient.WithTarget("dns:///billing:50051"),
+//	    grpcclient.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+//	))
+//
+// Services resolve the connection and build typed clients from it:
+//
+//	conn, err := gaz.Resolve[*grpc.ClientConn](c)
+//	client := billingv1.NewBillingServiceClient(conn)
+//
+// # Credentials
+//
+// Building the app fails with [ErrNoCredentials] unless the transport
+// security is chosen explicitly: [WithTransportCredentials] for TLS, or
+// [WithInsecure] for plaintext traffic, for example inside a service mesh
+// that already encrypts it:
+//
+//	grpcclient.NewModule(
+//	    grpcclient.WithTarget("billing:50051"),
+//	    grpcclient.WithInsecure(),
+//	)
+//
+// # Dial Options
+//
+// Options passed with [WithDialOptions] are applied after the module's own
+// and take precedence:
+//
+//	grpcclient.NewModule(
+//	    grpcclient.WithTarget("billing:50051"),
+//	    grpcclient.WithInsecure(),
+//	    grpcclient.WithDialOptions(grpc.WithUserAgent("orders/1.0")),
+//	)
+//
+// # Multiple Targets
+//
+// Without [WithName] the connection is registered by type, so an app can use
+// only one module. Name each module to dial several services:
+//
+//	app.Use(grpcclient.NewModule(grpcclient.WithName("billing"),
+//	    grpcclient.WithTarget("billing:50051"), grpcclient.WithInsecure()))
+//	app.Use(grpcclient.NewModule(grpcclient.WithName("users"),
+//	    grpcclient.WithTarget("users:50051"), grpcclient.WithInsecure()))
+//
+//	billing, err := gaz.Resolve[*grpc.ClientConn](c, gaz.Named("billing"))
+//
+// # Tracing
+//
+// When a *sdktrace.TracerProvider is registered (see the server/otel
+// package), the connection is instrumented with otelgrpc so outgoing calls
+// propagate trace context to the server.
+//
+// # Lifecycle
+//
+// The connection is created lazily by grpc.NewClient and closed when the
+// application stops.
+package grpcclient
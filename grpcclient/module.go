@@ -0,0 +1,201 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/petabytecl/gaz"
+)
+
+// ErrNoTarget is returned when the module is built without WithTarget.
+var ErrNoTarget = errors.New("grpcclient: target is required")
+
+// ErrNoCredentials is returned when the module is built without
+// WithTransportCredentials or WithInsecure.
+var ErrNoCredentials = errors.New("grpcclient: transport credentials are required (use WithTransportCredentials or WithInsecure)")
+
+// ModuleOption configures the gRPC client module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	name        string
+	target      string
+	creds       credentials.TransportCredentials
+	insecure    bool
+	dialOptions []grpc.DialOption
+}
+
+// WithName registers the connection under name, so an app can use one
+// module per target. Resolve it with gaz.Named(name). Without WithName the
+// connection is registered by type and only one module can be used.
+func WithName(name string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.name = name
+	}
+}
+
+// WithTarget sets the target the connection dials, in grpc.NewClient syntax
+// (e.g. "dns:///billing:50051" or "localhost:50051").
+func WithTarget(target string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.target = target
+	}
+}
+
+// WithTransportCredentials sets the credentials used to secure the
+// connection, such as credentials.NewTLS(tlsConfig).
+func WithTransportCredentials(creds credentials.TransportCredentials) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.creds = creds
+	}
+}
+
+// WithInsecure dials without transport security, for example for traffic
+// that a service mesh already encrypts. It is ignored when
+// WithTransportCredentials is set.
+func WithInsecure() ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.insecure = true
+	}
+}
+
+// WithDialOptions appends options used to create the connection. They are
+// applied after the module's defaults, so they take precedence.
+func WithDialOptions(opts ...grpc.DialOption) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.dialOptions = append(mc.dialOptions, opts...)
+	}
+}
+
+// connCloser wraps the ClientConn to implement di.Stopper.
+type connCloser struct {
+	conn *grpc.ClientConn
+}
+
+// OnStop closes the ClientConn.
+func (c *connCloser) OnStop(_ context.Context) error {
+	if err := c.conn.Close(); err != nil {
+		return fmt.Errorf("grpcclient: close connection: %w", err)
+	}
+	return nil
+}
+
+// NewModule creates a gRPC client module.
+// Returns a gaz.Module that registers a *grpc.ClientConn for the target.
+// Transport credentials must be chosen explicitly with
+// WithTransportCredentials or WithInsecure.
+//
+// Components registered:
+//   - *grpc.ClientConn, named by WithName if set (closed on app stop)
+//
+// Example:
+//
+//	app := gaz.New()
+//	app.Use(grpcclient.NewModule(
+//	    grpcclient.WithTarget("localhost:50051"),
+//	    grpcclient.WithInsecure(),
+//	))
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
+	moduleName := "grpcclient"
+	if mc.name != "" {
+		moduleName += "-" + mc.name
+	}
+
+	return gaz.NewModule(moduleName).
+		Provide(provideConn(mc)).
+		Provide(provideConnCloser(mc)).
+		Build()
+}
+
+// buildDialOptions returns the module defaults followed by the user's options.
+// The otelgrpc stats handler is attached when tp is non-nil.
+func (mc *moduleConfig) buildDialOptions(tp *sdktrace.TracerProvider) []grpc.DialOption {
+	creds := mc.creds
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if tp != nil {
+		opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler(
+			otelgrpc.WithTracerProvider(tp),
+		)))
+	}
+	return append(opts, mc.dialOptions...)
+}
+
+// resolveOptions returns the options that resolve this module's ClientConn.
+func (mc *moduleConfig) resolveOptions() []gaz.ResolveOption {
+	if mc.name == "" {
+		return nil
+	}
+	return []gaz.ResolveOption{gaz.Named(mc.name)}
+}
+
+// provideConn creates the ClientConn provider function.
+func provideConn(mc *moduleConfig) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		builder := gaz.For[*grpc.ClientConn](c)
+		if mc.name != "" {
+			builder = builder.Named(mc.name)
+		}
+		if err := builder.Provider(func(c *gaz.Container) (*grpc.ClientConn, error) {
+			if mc.target == "" {
+				return nil, ErrNoTarget
+			}
+			if mc.creds == nil && !mc.insecure {
+				return nil, ErrNoCredentials
+			}
+
+			var tp *sdktrace.TracerProvider
+			if resolved, resolveErr := gaz.Resolve[*sdktrace.TracerProvider](c); resolveErr == nil {
+				tp = resolved
+			}
+
+			conn, err := grpc.NewClient(mc.target, mc.buildDialOptions(tp)...)
+			if err != nil {
+				return nil, fmt.Errorf("grpcclient: create client for %s: %w", mc.target, err)
+			}
+			return conn, nil
+		}); err != nil {
+			return fmt.Errorf("register client conn: %w", err)
+		}
+		return nil
+	}
+}
+
+// provideConnCloser registers the stopper that closes the ClientConn.
+func provideConnCloser(mc *moduleConfig) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		builder := gaz.For[*connCloser](c)
+		if mc.name != "" {
+			builder = builder.Named("grpcclient-" + mc.name + "-closer")
+		}
+		if err := builder.
+			Eager().
+			Provider(func(c *gaz.Container) (*connCloser, error) {
+				conn, err := gaz.Resolve[*grpc.ClientConn](c, mc.resolveOptions()...)
+				if err != nil {
+					return nil, fmt.Errorf("resolve client conn: %w", err)
+				}
+				return &connCloser{conn: conn}, nil
+			}); err != nil {
+			return fmt.Errorf("register conn closer: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package grpcclient
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+func TestNewModule_RegistersConn(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithTarget("localhost:50051"), WithInsecure()).Apply(app))
+	require.NoError(t, app.Build())
+
+	conn, err := di.Resolve[*grpc.ClientConn](app.Container())
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:50051", conn.Target())
+}
+
+func TestNewModule_AppliesDialOptions(t *testing.T) {
+	var methods []string
+	interceptor := func(_ context.Context, method string, _, _ any, _ *grpc.ClientConn,
+		_ grpc.UnaryInvoker, _ ...grpc.CallOption,
+	) error {
+		methods = append(methods, method)
+		return nil // Short-circuit: no server needed.
+	}
+
+	app := gaz.New()
+	require.NoError(t, NewModule(
+		WithTarget("localhost:50051"),
+		WithInsecure(),
+		WithDialOptions(grpc.WithUnaryInterceptor(interceptor)),
+	).Apply(app))
+	require.NoError(t, app.Build())
+
+	conn, err := di.Resolve[*grpc.ClientConn](app.Container())
+	require.NoError(t, err)
+	require.NoError(t, conn.Invoke(context.Background(), "/echo.v1.EchoService/Echo", nil, nil))
+	assert.Equal(t, []string{"/echo.v1.EchoService/Echo"}, methods)
+}
+
+func TestNewModule_ClosesConnOnStop(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithTarget("localhost:50051"), WithInsecure()).Apply(app))
+	require.NoError(t, app.Build())
+
+	conn, err := di.Resolve[*grpc.ClientConn](app.Container())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, app.Stop(ctx))
+
+	assert.Equal(t, connectivity.Shutdown, conn.GetState())
+}
+
+func TestNewModule_RequiresTarget(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.ErrorIs(t, app.Build(), ErrNoTarget)
+}
+
+func TestNewModule_RequiresCredentials(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithTarget("localhost:50051")).Apply(app))
+	require.ErrorIs(t, app.Build(), ErrNoCredentials)
+}
+
+func TestNewModule_TransportCredentials(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(
+		WithTarget("localhost:50051"),
+		WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})),
+	).Apply(app))
+	require.NoError(t, app.Build())
+
+	_, err := di.Resolve[*grpc.ClientConn](app.Container())
+	require.NoError(t, err)
+}
+
+func TestNewModule_NamedClients(t *testing.T) {
+	app := gaz.New()
+	app.Use(NewModule(WithName("billing"), WithTarget("billing:50051"), WithInsecure()))
+	app.Use(NewModule(WithName("users"), WithTarget("users:50051"), WithInsecure()))
+	require.NoError(t, app.Build())
+
+	billing, err := gaz.Resolve[*grpc.ClientConn](app.Container(), gaz.Named("billing"))
+	require.NoError(t, err)
+	assert.Equal(t, "billing:50051", billing.Target())
+
+	users, err := gaz.Resolve[*grpc.ClientConn](app.Container(), gaz.Named("users"))
+	require.NoError(t, err)
+	assert.Equal(t, "users:50051", users.Target())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, app.Stop(ctx))
+	assert.Equal(t, connectivity.Shutdown, billing.GetState())
+	assert.Equal(t, connectivity.Shutdown, users.GetState())
+}
+
+func TestBuildDialOptions_Tracing(t *testing.T) {
+	mc := &moduleConfig{}
+	WithDialOptions(grpc.WithUserAgent("test"))(mc)
+
+	assert.Len(t, mc.buildDialOptions(nil), 2)
+	assert.Len(t, mc.buildDialOptions(sdktrace.NewTracerProvider()), 3)
+}
@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithCacheTTL reuses a successful result of the check for up to d, so rapid
+// probes do not hammer the dependency behind it. Failures are never cached:
+// the next probe after an error always runs the check again.
+//
+// While a stale result is being refreshed, concurrent probes are served the
+// last successful result instead of piling onto the dependency.
+// A non-positive d disables caching.
+func WithCacheTTL(d time.Duration) CheckOption {
+	return func(c *checkConfig) {
+		c.cacheTTL = d
+	}
+}
+
+// cachedCheck serves the last successful result of a check within its TTL.
+type cachedCheck struct {
+	check CheckFunc
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu         sync.Mutex
+	healthy    bool
+	checkedAt  time.Time
+	refreshing bool
+}
+
+func newCachedCheck(check CheckFunc, ttl time.Duration) *cachedCheck {
+	return &cachedCheck{check: check, ttl: ttl, now: time.Now}
+}
+
+// run returns the cached result while fresh, otherwise runs the check.
+func (c *cachedCheck) run(ctx context.Context) error {
+	c.mu.Lock()
+	if c.healthy && (c.refreshing || c.now().Sub(c.checkedAt) < c.ttl) {
+		c.mu.Unlock()
+		return nil
+	}
+	c.refreshing = true
+	c.mu.Unlock()
+
+	// Deferred so a panicking check (recovered by the checker) is not
+	// left marked as refreshing and served from cache forever.
+	var err error
+	completed := false
+	defer func() {
+		c.mu.Lock()
+		c.refreshing = false
+		c.healthy = completed && err == nil
+		c.checkedAt = c.now()
+		c.mu.Unlock()
+	}()
+
+	err = c.check(ctx)
+	completed = true
+	return err
+}
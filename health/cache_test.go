@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/petabytecl/gaz/health/internal"
+)
+
+// countingCheck returns a CheckFunc that counts invocations and returns *result.
+func countingCheck(calls *int, result *error) CheckFunc {
+	return func(_ context.Context) error {
+		*calls++
+		return *result
+	}
+}
+
+func TestCachedCheck_ReusesResultWithinTTL(t *testing.T) {
+	var calls int
+	var result error
+	now := time.Unix(0, 0)
+	c := newCachedCheck(countingCheck(&calls, &result), time.Second)
+	c.now = func() time.Time { return now }
+
+	for range 3 {
+		if err := c.run(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call within TTL, got %d", calls)
+	}
+
+	now = now.Add(time.Second)
+	if err := c.run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected refresh after TTL, got %d calls", calls)
+	}
+}
+
+func TestCachedCheck_ErrorsAreNotCached(t *testing.T) {
+	var calls int
+	result := errors.New("db down")
+	now := time.Unix(0, 0)
+	c := newCachedCheck(countingCheck(&calls, &result), time.Minute)
+	c.now = func() time.Time { return now }
+
+	if err := c.run(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	result = nil
+	if err := c.run(context.Background()); err != nil {
+		t.Fatalf("expected recovery to be observed, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected check to re-run after error, got %d calls", calls)
+	}
+
+	// A healthy result is now cached, so a new failure is only seen after TTL.
+	result = errors.New("db down again")
+	if err := c.run(context.Background()); err != nil {
+		t.Fatalf("expected cached success, got %v", err)
+	}
+	now = now.Add(time.Minute)
+	if err := c.run(context.Background()); err == nil {
+		t.Fatal("expected error after TTL")
+	}
+}
+
+func TestCachedCheck_ServesLastResultWhileRefreshing(t *testing.T) {
+	now := time.Unix(0, 0)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	calls := 0
+	c := newCachedCheck(func(_ context.Context) error {
+		calls++
+		if calls == 2 {
+			close(started)
+			<-release
+		}
+		return nil
+	}, time.Second)
+	c.now = func() time.Time { return now }
+
+	if err := c.run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now = now.Add(time.Second)
+
+	done := make(chan error, 1)
+	go func() { done <- c.run(context.Background()) }()
+	<-started
+
+	// The refresh is in flight; a concurrent probe gets the cached success.
+	if err := c.run(context.Background()); err != nil {
+		t.Fatalf("expected cached success while refreshing, got %v", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected refresh error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestManager_WithCacheTTL(t *testing.T) {
+	m := NewManager()
+
+	calls := 0
+	m.AddReadinessCheckWithOptions("db", func(_ context.Context) error {
+		calls++
+		return nil
+	}, WithCacheTTL(time.Hour))
+
+	checker := m.ReadinessChecker()
+	for range 3 {
+		if res := checker.Check(context.Background()); res.Status != internal.StatusUp {
+			t.Fatalf("expected up status, got %s", res.Status)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
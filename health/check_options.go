@@ -0,0 +1,46 @@
+package health
+
+import (
+	"time"
+
+	"github.com/petabytecl/gaz/health/internal"
+)
+
+// CheckOption configures a single check registered with [OptionsRegistrar].
+type CheckOption func(*checkConfig)
+
+// checkConfig holds per-check settings collected from CheckOption values.
+type checkConfig struct {
+	cacheTTL    time.Duration
+	nonCritical bool
+}
+
+// WithNonCritical marks the check as non-critical. A failing non-critical
+// check is reported in the details but does not take the probe down; see
+// [WithDegradedThreshold] to surface partial failures.
+func WithNonCritical() CheckOption {
+	return func(c *checkConfig) {
+		c.nonCritical = true
+	}
+}
+
+// newCheck builds the internal check for name and check according to opts.
+func newCheck(name string, check CheckFunc, opts []CheckOption) internal.Check {
+	var cfg checkConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.cacheTTL > 0 {
+		check = newCachedCheck(check, cfg.cacheTTL).run
+	}
+
+	c := internal.Check{
+		Name:     name,
+		Check:    check,
+		Critical: true, // Default to critical per existing behavior
+	}
+	if cfg.nonCritical {
+		c = internal.WithNonCritical(c)
+	}
+	return c
+}
@@ -29,4 +29,5 @@
 //   - runtime: Go runtime metrics (goroutines, memory, GC)
 //   - redis: Redis connectivity (requires go-redis/v9)
 //   - disk: Disk space monitoring (requires gopsutil/v4)
+//   - tls: TLS certificate expiry
 package checks
@@ -7,12 +7,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
 // DefaultTimeout is the default timeout for HTTP requests.
 const DefaultTimeout = 5 * time.Second
 
+// maxBodyBytes bounds how much of the response body is read when matching
+// ExpectedBodySubstring.
+const maxBodyBytes = 1 << 20
+
 // ErrEmptyURL is returned when the URL is empty.
 var ErrEmptyURL = errors.New("http: URL is empty")
 
@@ -23,23 +28,33 @@ type Config struct {
 	// Timeout for the HTTP request. Optional, defaults to 5s.
 	// The context deadline takes precedence if shorter.
 	Timeout time.Duration
-	// ExpectedStatusCode is the expected response status. Optional, defaults to 200.
+	// ExpectedStatusCode is the expected response status. Optional; by
+	// default any 2xx status is accepted.
 	ExpectedStatusCode int
+	// ExpectedBodySubstring, if set, must appear in the response body. Use it
+	// to catch upstreams that answer 200 with an error body. At most 1 MiB of
+	// the body is searched.
+	ExpectedBodySubstring string
+	// Method is the HTTP method to use. Optional, defaults to GET.
+	Method string
+	// Headers are added to the request, e.g. for authentication. Optional.
+	Headers http.Header
 	// Client is the HTTP client to use. Optional, a default client is created.
 	// Providing a custom client allows reusing connection pools and custom TLS config.
 	Client *http.Client
 }
 
 // New creates a new HTTP upstream health check.
-// Performs GET request and validates response status code.
+// Performs a request (GET by default) and validates the response status code
+// and, if configured, the response body.
 //
-// Returns nil if response matches expected status, error otherwise.
+// Returns nil if the response matches, error otherwise.
 func New(cfg Config) func(context.Context) error {
 	if cfg.Timeout == 0 {
 		cfg.Timeout = DefaultTimeout
 	}
-	if cfg.ExpectedStatusCode == 0 {
-		cfg.ExpectedStatusCode = http.StatusOK
+	if cfg.Method == "" {
+		cfg.Method = http.MethodGet
 	}
 
 	client := cfg.Client
@@ -57,10 +72,15 @@ func New(cfg Config) func(context.Context) error {
 			return ErrEmptyURL
 		}
 
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.URL, nil)
+		req, err := http.NewRequestWithContext(ctx, cfg.Method, cfg.URL, nil)
 		if err != nil {
 			return fmt.Errorf("http: failed to create request: %w", err)
 		}
+		for name, values := range cfg.Headers {
+			for _, v := range values {
+				req.Header.Add(name, v)
+			}
+		}
 		req.Header.Set("Connection", "close")
 
 		resp, err := client.Do(req) //nolint:gosec // URL is provided by application developer, not user input
@@ -73,10 +93,35 @@ func New(cfg Config) func(context.Context) error {
 			_ = resp.Body.Close()
 		}()
 
-		if resp.StatusCode != cfg.ExpectedStatusCode {
-			return fmt.Errorf("http: unexpected status %d (expected %d)",
-				resp.StatusCode, cfg.ExpectedStatusCode)
+		if err := checkStatus(resp.StatusCode, cfg.ExpectedStatusCode); err != nil {
+			return err
+		}
+		if cfg.ExpectedBodySubstring == "" {
+			return nil
+		}
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+		if err != nil {
+			return fmt.Errorf("http: failed to read body: %w", err)
+		}
+		if !strings.Contains(string(body), cfg.ExpectedBodySubstring) {
+			return fmt.Errorf("http: response body does not contain %q", cfg.ExpectedBodySubstring)
 		}
 		return nil
 	}
 }
+
+// checkStatus compares status against expected, or against the 2xx range
+// when expected is zero.
+func checkStatus(status, expected int) error {
+	if expected == 0 {
+		if status < 200 || status > 299 {
+			return fmt.Errorf("http: unexpected status %d (expected 2xx)", status)
+		}
+		return nil
+	}
+	if status != expected {
+		return fmt.Errorf("http: unexpected status %d (expected %d)", status, expected)
+	}
+	return nil
+}
@@ -61,7 +61,7 @@ func TestNew_UnexpectedStatus(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for unexpected status code")
 	}
-	expected := "http: unexpected status 503 (expected 200)"
+	expected := "http: unexpected status 503 (expected 2xx)"
 	if err.Error() != expected {
 		t.Errorf("got %q, want %q", err.Error(), expected)
 	}
@@ -161,3 +161,92 @@ func TestNew_ConnectionCloseHeader(t *testing.T) {
 		t.Errorf("expected Connection: close header, got %q", receivedConnection)
 	}
 }
+
+func TestNew_DefaultAcceptsAny2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	check := httpcheck.New(httpcheck.Config{URL: server.URL})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNew_BodyMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok","db":"up"}`))
+	}))
+	defer server.Close()
+
+	check := httpcheck.New(httpcheck.Config{
+		URL:                   server.URL,
+		ExpectedStatusCode:    http.StatusOK,
+		ExpectedBodySubstring: `"status":"ok"`,
+	})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNew_BodyMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"error","db":"down"}`))
+	}))
+	defer server.Close()
+
+	check := httpcheck.New(httpcheck.Config{
+		URL:                   server.URL,
+		ExpectedBodySubstring: `"status":"ok"`,
+	})
+	err := check(context.Background())
+	if err == nil {
+		t.Fatal("expected error for body mismatch")
+	}
+	expected := `http: response body does not contain "\"status\":\"ok\""`
+	if err.Error() != expected {
+		t.Errorf("got %q, want %q", err.Error(), expected)
+	}
+}
+
+func TestNew_WrongStatusWithMatchingBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	check := httpcheck.New(httpcheck.Config{
+		URL:                   server.URL,
+		ExpectedBodySubstring: "ok",
+	})
+	if err := check(context.Background()); err == nil {
+		t.Fatal("expected error for 500 status")
+	}
+}
+
+func TestNew_MethodAndHeaders(t *testing.T) {
+	var gotMethod, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	check := httpcheck.New(httpcheck.Config{
+		URL:     server.URL,
+		Method:  http.MethodHead,
+		Headers: http.Header{"Authorization": []string{"Bearer token"}},
+	})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodHead {
+		t.Errorf("method = %q, want HEAD", gotMethod)
+	}
+	if gotAuth != "Bearer token" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer token")
+	}
+}
@@ -0,0 +1,116 @@
+// Package tlscheck provides a health check for TLS certificate expiry.
+package tlscheck
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+var (
+	// ErrNoSource is returned when neither CertFile nor Certificate is set.
+	ErrNoSource = errors.New("tls: no certificate source")
+
+	// ErrExpiringSoon is returned when the certificate expires within
+	// MinRemaining.
+	ErrExpiringSoon = errors.New("tls: certificate expiring soon")
+
+	// ErrExpired is returned when the certificate has expired.
+	ErrExpired = errors.New("tls: certificate expired")
+)
+
+// Config configures the certificate expiry health check.
+// Exactly one of CertFile or Certificate must be set.
+type Config struct {
+	// CertFile is the path to a PEM-encoded certificate. The first
+	// certificate in the file (the leaf) is checked. The file is re-read on
+	// every check, so rotated certificates are picked up.
+	CertFile string
+	// Certificate returns the certificate to check, e.g. the leaf of a
+	// certificate held by a reloading tls.Config. Takes precedence over
+	// CertFile.
+	Certificate func() (*x509.Certificate, error)
+	// MinRemaining is how long before expiry the check starts failing with
+	// ErrExpiringSoon. Zero fails only once the certificate has expired.
+	MinRemaining time.Duration
+}
+
+// New creates a new certificate expiry health check.
+//
+// Returns nil while the certificate is valid for longer than MinRemaining,
+// an error wrapping ErrExpiringSoon within MinRemaining of expiry, and an
+// error wrapping ErrExpired once it has expired.
+//
+// To warn before expiry without taking the probe down, register the check
+// with health.WithNonCritical through a health.OptionsRegistrar, and register
+// a second check with zero MinRemaining to fail once the certificate has
+// actually expired:
+//
+//	registrar.AddReadinessCheckWithOptions("tls-expiring", tlscheck.New(tlscheck.Config{
+//	    CertFile:     "/etc/tls/tls.crt",
+//	    MinRemaining: 14 * 24 * time.Hour,
+//	}), health.WithNonCritical())
+//	registrar.AddReadinessCheck("tls-expired", tlscheck.New(tlscheck.Config{
+//	    CertFile: "/etc/tls/tls.crt",
+//	}))
+func New(cfg Config) func(context.Context) error {
+	return func(_ context.Context) error {
+		cert, err := loadCertificate(cfg)
+		if err != nil {
+			return err
+		}
+
+		remaining := time.Until(cert.NotAfter)
+		switch {
+		case remaining <= 0:
+			return fmt.Errorf("%w: %q expired at %s",
+				ErrExpired, cert.Subject.CommonName, cert.NotAfter.UTC().Format(time.RFC3339))
+		case remaining < cfg.MinRemaining:
+			return fmt.Errorf("%w: %q expires in %s (at %s)",
+				ErrExpiringSoon, cert.Subject.CommonName, remaining.Round(time.Second),
+				cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+}
+
+// loadCertificate returns the certificate configured by cfg.
+func loadCertificate(cfg Config) (*x509.Certificate, error) {
+	if cfg.Certificate != nil {
+		cert, err := cfg.Certificate()
+		if err != nil {
+			return nil, fmt.Errorf("tls: failed to get certificate: %w", err)
+		}
+		if cert == nil {
+			return nil, errors.New("tls: certificate source returned nil")
+		}
+		return cert, nil
+	}
+	if cfg.CertFile == "" {
+		return nil, ErrNoSource
+	}
+
+	data, err := os.ReadFile(cfg.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls: failed to read certificate: %w", err)
+	}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("tls: no certificate found in %s", cfg.CertFile)
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("tls: failed to parse certificate: %w", err)
+		}
+		return cert, nil
+	}
+}
@@ -0,0 +1,172 @@
+package tlscheck_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/petabytecl/gaz/health"
+	tlscheck "github.com/petabytecl/gaz/health/checks/tls"
+)
+
+// newCert creates a self-signed certificate valid until notAfter.
+func newCert(t *testing.T, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return cert
+}
+
+// writeCert writes cert as PEM to a temp file and returns its path.
+func writeCert(t *testing.T, cert *x509.Certificate) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tls.crt")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+	return path
+}
+
+func source(cert *x509.Certificate) func() (*x509.Certificate, error) {
+	return func() (*x509.Certificate, error) { return cert, nil }
+}
+
+func TestNew_NoSource(t *testing.T) {
+	err := tlscheck.New(tlscheck.Config{})(context.Background())
+	if !errors.Is(err, tlscheck.ErrNoSource) {
+		t.Fatalf("got %v, want ErrNoSource", err)
+	}
+}
+
+func TestNew_LongValid(t *testing.T) {
+	cert := newCert(t, time.Now().Add(90*24*time.Hour))
+	check := tlscheck.New(tlscheck.Config{
+		Certificate:  source(cert),
+		MinRemaining: 14 * 24 * time.Hour,
+	})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("expected healthy, got %v", err)
+	}
+}
+
+func TestNew_ExpiringSoon(t *testing.T) {
+	cert := newCert(t, time.Now().Add(3*24*time.Hour))
+	check := tlscheck.New(tlscheck.Config{
+		Certificate:  source(cert),
+		MinRemaining: 14 * 24 * time.Hour,
+	})
+	err := check(context.Background())
+	if !errors.Is(err, tlscheck.ErrExpiringSoon) {
+		t.Fatalf("got %v, want ErrExpiringSoon", err)
+	}
+	if errors.Is(err, tlscheck.ErrExpired) {
+		t.Error("expiring certificate should not report ErrExpired")
+	}
+}
+
+func TestNew_ExpiringSoon_ZeroMinRemaining(t *testing.T) {
+	cert := newCert(t, time.Now().Add(time.Hour))
+	check := tlscheck.New(tlscheck.Config{Certificate: source(cert)})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("zero MinRemaining should only fail on expiry, got %v", err)
+	}
+}
+
+func TestNew_Expired(t *testing.T) {
+	cert := newCert(t, time.Now().Add(-time.Hour))
+	check := tlscheck.New(tlscheck.Config{
+		Certificate:  source(cert),
+		MinRemaining: 14 * 24 * time.Hour,
+	})
+	err := check(context.Background())
+	if !errors.Is(err, tlscheck.ErrExpired) {
+		t.Fatalf("got %v, want ErrExpired", err)
+	}
+}
+
+func TestNew_CertFile(t *testing.T) {
+	path := writeCert(t, newCert(t, time.Now().Add(3*24*time.Hour)))
+
+	check := tlscheck.New(tlscheck.Config{CertFile: path, MinRemaining: 7 * 24 * time.Hour})
+	if err := check(context.Background()); !errors.Is(err, tlscheck.ErrExpiringSoon) {
+		t.Fatalf("got %v, want ErrExpiringSoon", err)
+	}
+
+	check = tlscheck.New(tlscheck.Config{CertFile: path, MinRemaining: 24 * time.Hour})
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("expected healthy, got %v", err)
+	}
+}
+
+func TestNew_CertFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.crt")
+	garbage := filepath.Join(dir, "garbage.crt")
+	if err := os.WriteFile(garbage, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{missing, garbage} {
+		err := tlscheck.New(tlscheck.Config{CertFile: path})(context.Background())
+		if err == nil {
+			t.Errorf("%s: expected error", filepath.Base(path))
+		}
+	}
+}
+
+func TestNew_SourceError(t *testing.T) {
+	sourceErr := errors.New("reload failed")
+	check := tlscheck.New(tlscheck.Config{
+		Certificate: func() (*x509.Certificate, error) { return nil, sourceErr },
+	})
+	if err := check(context.Background()); !errors.Is(err, sourceErr) {
+		t.Fatalf("got %v, want wrapped source error", err)
+	}
+}
+
+func TestNew_NonCriticalWarning(t *testing.T) {
+	cert := newCert(t, time.Now().Add(3*24*time.Hour))
+	manager := health.NewManager()
+	manager.AddReadinessCheckWithOptions("tls-expiring", tlscheck.New(tlscheck.Config{
+		Certificate:  source(cert),
+		MinRemaining: 14 * 24 * time.Hour,
+	}), health.WithNonCritical())
+	manager.AddReadinessCheck("tls-expired", tlscheck.New(tlscheck.Config{
+		Certificate: source(cert),
+	}))
+
+	result := manager.ReadinessChecker().Check(context.Background())
+	if result.Status != health.StatusUp {
+		t.Fatalf("expiring certificate should only warn, got status %s", result.Status)
+	}
+	if got := result.Details["tls-expiring"].Status; got != health.StatusDown {
+		t.Errorf("expiring check detail status = %s, want down", got)
+	}
+}
@@ -52,13 +52,56 @@
 //	    return nil
 //	})
 //
+// To configure a check with a [CheckOption], such as the ones below, use the
+// WithOptions variants from [OptionsRegistrar], which [Manager] implements.
+//
+// # Caching Results
+//
+// Checks against expensive dependencies can reuse a successful result with
+// [WithCacheTTL], so frequent probes do not hammer the dependency:
+//
+//	manager.AddReadinessCheckWithOptions("database", pingDB, health.WithCacheTTL(10*time.Second))
+//
+// Failures are never cached; the next probe after an error runs the check
+// again. While a stale result is refreshing, concurrent probes are served
+// the last successful result.
+//
+// # Degraded Status
+//
+// Checks registered with [WithNonCritical] report failures in the details
+// without taking the probe down. With [WithDegradedThreshold], a manager
+// reports [StatusDegraded] once that fraction of non-critical checks fail:
+//
+//	manager := health.NewManager(health.WithDegradedThreshold(0.5))
+//	manager.AddReadinessCheckWithOptions("cache-a", pingCacheA, health.WithNonCritical())
+//	manager.AddReadinessCheckWithOptions("cache-b", pingCacheB, health.WithNonCritical())
+//
+// Degraded probes answer 200 OK with an IETF "warn" body, so load balancers
+// can lower the instance's weight rather than remove it. Any critical
+// failure still reports down.
+//
+// # Combining Checks
+//
+// [Group] composes checks with AND ([GroupAll]) or OR ([GroupAny]) logic into
+// a single check. Groups nest, and sub-checks share the group's timeout:
+//
+//	manager.AddReadinessCheck("dependencies", health.Group(health.GroupAll,
+//	    pingDB,
+//	    health.Group(health.GroupAny, pingCacheA, pingCacheB),
+//	))
+//
 // # HTTP Endpoints
 //
 // The [ManagementServer] exposes health endpoints on a dedicated port (default 9090):
 //
 //   - /live - Liveness probe (always returns 200 OK)
 //   - /ready - Readiness probe (503 when unhealthy)
-//   - /startup - Startup probe (503 when not ready)
+//   - /startup - Startup probe (503 until started)
+//
+// The startup probe runs the startup and readiness checks until they first
+// pass together. From then on it permanently reports 200 and readiness takes
+// over, so a dependency outage after warm-up cannot trigger a restart through
+// the startup probe.
 //
 // # Graceful Shutdown
 //
@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// GroupMode selects how [Group] combines its sub-checks.
+type GroupMode int
+
+const (
+	// GroupAll passes only if every sub-check passes (AND).
+	GroupAll GroupMode = iota
+	// GroupAny passes if at least one sub-check passes (OR).
+	GroupAny
+)
+
+// errEmptyAnyGroup is returned by a GroupAny group without sub-checks.
+var errEmptyAnyGroup = errors.New("health: group has no checks")
+
+// Group combines checks into a single CheckFunc, so readiness rules such as
+// "database up AND (cache-a up OR cache-b up)" are declared rather than
+// hand-coded. Groups nest, since a group is itself a CheckFunc:
+//
+//	manager.AddReadinessCheck("dependencies", health.Group(health.GroupAll,
+//	    pingDB,
+//	    health.Group(health.GroupAny, pingCacheA, pingCacheB),
+//	))
+//
+// Sub-checks run concurrently and receive the group's context, so the
+// timeout applied to the registered check bounds all of them. Once the
+// outcome is decided (a failure for GroupAll, a success for GroupAny) the
+// remaining sub-checks' context is cancelled. A failing group returns the
+// sub-check errors joined with errors.Join; a panicking sub-check counts as
+// failed.
+//
+// An empty GroupAll group passes; an empty GroupAny group fails.
+func Group(mode GroupMode, checks ...CheckFunc) CheckFunc {
+	return func(ctx context.Context) error {
+		if len(checks) == 0 {
+			if mode == GroupAny {
+				return errEmptyAnyGroup
+			}
+			return nil
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make([]error, len(checks))
+		var wg sync.WaitGroup
+		for i, check := range checks {
+			wg.Go(func() {
+				err := runGroupCheck(ctx, check)
+				results[i] = err
+				if (err == nil) == (mode == GroupAny) {
+					cancel() // Outcome decided
+				}
+			})
+		}
+		wg.Wait()
+
+		var errs []error
+		for i, err := range results {
+			if err == nil {
+				if mode == GroupAny {
+					return nil
+				}
+				continue
+			}
+			errs = append(errs, fmt.Errorf("check %d: %w", i, err))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// runGroupCheck runs one sub-check, converting a panic into an error.
+func runGroupCheck(ctx context.Context, check CheckFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("health: check panicked: %v", r)
+		}
+	}()
+	return check(ctx)
+}
@@ -0,0 +1,139 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errCheckDown = errors.New("down")
+	passCheck    = CheckFunc(func(context.Context) error { return nil })
+	failCheck    = CheckFunc(func(context.Context) error { return errCheckDown })
+)
+
+// blockingCheck waits for ctx to end and returns its error.
+func blockingCheck(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestGroup_All(t *testing.T) {
+	if err := Group(GroupAll, passCheck, passCheck)(context.Background()); err != nil {
+		t.Fatalf("all passing: unexpected error: %v", err)
+	}
+
+	err := Group(GroupAll, passCheck, failCheck)(context.Background())
+	if !errors.Is(err, errCheckDown) {
+		t.Fatalf("one failing: got %v, want errCheckDown", err)
+	}
+	if err.Error() != "check 1: down" {
+		t.Errorf("error = %q, want index of failing check", err.Error())
+	}
+}
+
+func TestGroup_Any(t *testing.T) {
+	if err := Group(GroupAny, failCheck, passCheck)(context.Background()); err != nil {
+		t.Fatalf("one passing: unexpected error: %v", err)
+	}
+
+	err := Group(GroupAny, failCheck, failCheck)(context.Background())
+	if !errors.Is(err, errCheckDown) {
+		t.Fatalf("all failing: got %v, want errCheckDown", err)
+	}
+}
+
+func TestGroup_Empty(t *testing.T) {
+	if err := Group(GroupAll)(context.Background()); err != nil {
+		t.Errorf("empty GroupAll: unexpected error: %v", err)
+	}
+	if err := Group(GroupAny)(context.Background()); err == nil {
+		t.Error("empty GroupAny: expected error")
+	}
+}
+
+func TestGroup_Nested(t *testing.T) {
+	// db AND (cacheA OR cacheB)
+	readiness := func(db, cacheA, cacheB CheckFunc) CheckFunc {
+		return Group(GroupAll, db, Group(GroupAny, cacheA, cacheB))
+	}
+
+	tests := []struct {
+		name               string
+		db, cacheA, cacheB CheckFunc
+		wantHealthy        bool
+	}{
+		{"all up", passCheck, passCheck, passCheck, true},
+		{"one cache down", passCheck, failCheck, passCheck, true},
+		{"both caches down", passCheck, failCheck, failCheck, false},
+		{"db down", failCheck, passCheck, passCheck, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := readiness(tt.db, tt.cacheA, tt.cacheB)(context.Background())
+			if (err == nil) != tt.wantHealthy {
+				t.Errorf("healthy = %v, want %v (err: %v)", err == nil, tt.wantHealthy, err)
+			}
+		})
+	}
+}
+
+func TestGroup_TimeoutPropagates(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := Group(GroupAll, passCheck, blockingCheck)(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want DeadlineExceeded from sub-check", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("group took %s, want it bounded by the context timeout", elapsed)
+	}
+}
+
+func TestGroup_CancelsUndecidedChecks(t *testing.T) {
+	// GroupAny is decided by the passing check; the blocking one is cancelled.
+	done := make(chan error, 1)
+	go func() {
+		done <- Group(GroupAny, blockingCheck, passCheck)(context.Background())
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("GroupAny did not cancel remaining checks after one passed")
+	}
+
+	// GroupAll is decided by the failing check.
+	err := Group(GroupAll, blockingCheck, failCheck)(context.Background())
+	if !errors.Is(err, errCheckDown) {
+		t.Fatalf("got %v, want errCheckDown", err)
+	}
+}
+
+func TestGroup_PanicCountsAsFailure(t *testing.T) {
+	panicking := CheckFunc(func(context.Context) error { panic("boom") })
+
+	if err := Group(GroupAll, passCheck, panicking)(context.Background()); err == nil {
+		t.Error("expected panicking check to fail the group")
+	}
+	if err := Group(GroupAny, panicking, passCheck)(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGroup_WithManagerTimeout(t *testing.T) {
+	manager := NewManager()
+	manager.AddReadinessCheck("deps", Group(GroupAll, passCheck, blockingCheck))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	result := manager.ReadinessChecker().Check(ctx)
+	if result.Status != StatusDown {
+		t.Fatalf("status = %s, want down", result.Status)
+	}
+}
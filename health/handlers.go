@@ -37,9 +37,11 @@ func (m *Manager) NewReadinessHandler() http.Handler {
 }
 
 // NewStartupHandler creates an http.Handler for startup probes.
-// It returns 503 Service Unavailable on failure to hold off other probes.
+// It returns 503 Service Unavailable until the startup and readiness checks
+// first pass together, then 200 OK permanently: once started, readiness takes
+// over and a later failure must not make the orchestrator restart the pod.
 func (m *Manager) NewStartupHandler() http.Handler {
-	checker := m.StartupChecker()
+	checker := m.startupProbeChecker()
 	return internal.NewHandler(checker,
 		internal.WithResultWriter(internal.NewIETFResultWriter()),
 		internal.WithStatusCodeUp(http.StatusOK),
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 )
 
@@ -89,3 +90,57 @@ func TestHandlersSuccess(t *testing.T) {
 		}
 	})
 }
+
+func TestStartupHandler_LatchesAfterFirstSuccess(t *testing.T) {
+	m := NewManager()
+	var ready atomic.Bool
+	m.AddStartupCheck("warmup", func(_ context.Context) error { return nil })
+	m.AddReadinessCheck("db", func(_ context.Context) error {
+		if !ready.Load() {
+			return errors.New("not ready")
+		}
+		return nil
+	})
+
+	h := m.NewStartupHandler()
+	probe := func() int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/startup", nil))
+		return w.Code
+	}
+
+	if code := probe(); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 before initial readiness, got %d", code)
+	}
+
+	ready.Store(true)
+	if code := probe(); code != http.StatusOK {
+		t.Fatalf("expected 200 after initial readiness, got %d", code)
+	}
+
+	// Readiness takes over: a later failure does not affect startup.
+	ready.Store(false)
+	if code := probe(); code != http.StatusOK {
+		t.Errorf("expected startup to stay 200 after readiness failure, got %d", code)
+	}
+	if code := probe(); code != http.StatusOK {
+		t.Errorf("expected startup to stay 200, got %d", code)
+	}
+}
+
+func TestReadinessHandler_Degraded(t *testing.T) {
+	m := NewManager(WithDegradedThreshold(0.5))
+	m.AddReadinessCheck("db", func(_ context.Context) error { return nil })
+	m.AddReadinessCheckWithOptions("cache1", func(_ context.Context) error { return errors.New("fail") }, WithNonCritical())
+	m.AddReadinessCheckWithOptions("cache2", func(_ context.Context) error { return nil }, WithNonCritical())
+
+	w := httptest.NewRecorder()
+	m.NewReadinessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200 OK for degraded, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"status":"warn"`) {
+		t.Errorf("expected body to contain status:warn, got %s", w.Body.String())
+	}
+}
@@ -33,6 +33,14 @@ type Check struct {
 	criticalSet bool
 }
 
+// WithNonCritical returns a copy of check marked as non-critical, so a
+// failure reports as a warning instead of taking the overall status down.
+func WithNonCritical(check Check) Check {
+	check.Critical = false
+	check.criticalSet = true
+	return check
+}
+
 // CheckResult holds a single check's result.
 type CheckResult struct {
 	// Status is the check's availability status.
@@ -30,8 +30,9 @@ type CheckerOption func(*checkerConfig)
 
 // checkerConfig holds the configuration for a checker.
 type checkerConfig struct {
-	checks         map[string]*internalCheck
-	defaultTimeout time.Duration
+	checks            map[string]*internalCheck
+	defaultTimeout    time.Duration
+	degradedThreshold float64
 }
 
 // internalCheck wraps Check with critical flag defaulting.
@@ -44,8 +45,9 @@ type internalCheck struct {
 
 // checker implements the Checker interface.
 type checker struct {
-	checks         map[string]*internalCheck
-	defaultTimeout time.Duration
+	checks            map[string]*internalCheck
+	defaultTimeout    time.Duration
+	degradedThreshold float64
 }
 
 // NewChecker creates a new Checker with the given options.
@@ -60,8 +62,9 @@ func NewChecker(opts ...CheckerOption) Checker {
 		opt(cfg)
 	}
 	return &checker{
-		checks:         cfg.checks,
-		defaultTimeout: cfg.defaultTimeout,
+		checks:            cfg.checks,
+		defaultTimeout:    cfg.defaultTimeout,
+		degradedThreshold: cfg.degradedThreshold,
 	}
 }
 
@@ -97,6 +100,15 @@ func WithTimeout(timeout time.Duration) CheckerOption {
 	}
 }
 
+// WithDegradedThreshold reports StatusDegraded when at least fraction of the
+// non-critical checks fail and no critical check fails. Zero (the default)
+// disables degraded reporting; fraction is clamped to (0, 1].
+func WithDegradedThreshold(fraction float64) CheckerOption {
+	return func(cfg *checkerConfig) {
+		cfg.degradedThreshold = min(max(fraction, 0), 1)
+	}
+}
+
 // Check runs all configured health checks and returns the result.
 func (c *checker) Check(ctx context.Context) CheckerResult {
 	result := CheckerResult{
@@ -115,6 +127,7 @@ func (c *checker) Check(ctx context.Context) CheckerResult {
 	// Aggregate results
 	hasCritical := false
 	criticalFailed := false
+	nonCritical, nonCriticalFailed := 0, 0
 
 	for name, checkResult := range results {
 		result.Details[name] = checkResult
@@ -125,23 +138,37 @@ func (c *checker) Check(ctx context.Context) CheckerResult {
 			if checkResult.Status != StatusUp {
 				criticalFailed = true
 			}
+			continue
+		}
+		nonCritical++
+		if checkResult.Status != StatusUp {
+			nonCriticalFailed++
 		}
 	}
 
 	// Determine overall status
 	switch {
-	case !hasCritical:
-		// No critical checks, but we have checks - status is up (graceful degradation)
-		result.Status = StatusUp
-	case criticalFailed:
+	case hasCritical && criticalFailed:
 		result.Status = StatusDown
+	case c.degraded(nonCritical, nonCriticalFailed):
+		result.Status = StatusDegraded
 	default:
+		// No failing critical checks - status is up (graceful degradation)
 		result.Status = StatusUp
 	}
 
 	return result
 }
 
+// degraded reports whether enough non-critical checks failed to cross the
+// configured threshold.
+func (c *checker) degraded(total, failed int) bool {
+	if c.degradedThreshold <= 0 || total == 0 {
+		return false
+	}
+	return float64(failed)/float64(total) >= c.degradedThreshold
+}
+
 // runChecks executes all checks in parallel and returns results.
 func (c *checker) runChecks(ctx context.Context) map[string]CheckResult {
 	results := make(map[string]CheckResult)
@@ -487,3 +487,89 @@ func TestCheckerResult_DetailsContainsAllChecks(t *testing.T) {
 		}
 	}
 }
+
+func TestNewChecker_DegradedThreshold(t *testing.T) {
+	check := func(name string, critical, fail bool) CheckerOption {
+		c := Check{
+			Name: name,
+			Check: func(_ context.Context) error {
+				if fail {
+					return errors.New(name + " failed")
+				}
+				return nil
+			},
+			Critical: true,
+		}
+		if !critical {
+			c = WithNonCritical(c)
+		}
+		return WithCheck(c)
+	}
+
+	tests := []struct {
+		name   string
+		opts   []CheckerOption
+		expect AvailabilityStatus
+	}{
+		{
+			name: "below threshold is up",
+			opts: []CheckerOption{
+				check("db", true, false),
+				check("cache1", false, true),
+				check("cache2", false, false),
+				check("cache3", false, false),
+			},
+			expect: StatusUp,
+		},
+		{
+			name: "at threshold is degraded",
+			opts: []CheckerOption{
+				check("db", true, false),
+				check("cache1", false, true),
+				check("cache2", false, true),
+				check("cache3", false, false),
+			},
+			expect: StatusDegraded,
+		},
+		{
+			name: "above threshold is degraded",
+			opts: []CheckerOption{
+				check("cache1", false, true),
+				check("cache2", false, true),
+				check("cache3", false, true),
+			},
+			expect: StatusDegraded,
+		},
+		{
+			name: "critical failure is down regardless of warnings",
+			opts: []CheckerOption{
+				check("db", true, true),
+				check("cache1", false, true),
+				check("cache2", false, true),
+			},
+			expect: StatusDown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(append(tt.opts, WithDegradedThreshold(0.5))...)
+			if got := checker.Check(context.Background()).Status; got != tt.expect {
+				t.Errorf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestNewChecker_DegradedThresholdDisabled(t *testing.T) {
+	checker := NewChecker(
+		WithCheck(WithNonCritical(Check{
+			Name:  "cache",
+			Check: func(_ context.Context) error { return errors.New("down") },
+		})),
+	)
+
+	if got := checker.Check(context.Background()).Status; got != StatusUp {
+		t.Errorf("expected StatusUp without threshold, got %v", got)
+	}
+}
@@ -10,6 +10,9 @@ const (
 	StatusUp AvailabilityStatus = "up"
 	// StatusDown means the system/component is unavailable.
 	StatusDown AvailabilityStatus = "down"
+	// StatusDegraded means the system/component is available but enough
+	// non-critical checks are failing to warrant less traffic.
+	StatusDegraded AvailabilityStatus = "degraded"
 )
 
 // String returns the string representation of the status.
@@ -115,7 +115,7 @@ func mapStatusToIETF(s AvailabilityStatus) string {
 		return "pass"
 	case StatusDown:
 		return "fail"
-	case StatusUnknown:
+	case StatusUnknown, StatusDegraded:
 		return "warn"
 	default:
 		return "warn"
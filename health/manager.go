@@ -1,56 +1,71 @@
 package health
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/petabytecl/gaz/health/internal"
 )
 
-// Manager implements Registrar and manages health checkers.
+// Manager implements OptionsRegistrar and manages health checkers.
 type Manager struct {
 	mu sync.Mutex
 
 	livenessChecks  []internal.Check
 	readinessChecks []internal.Check
 	startupChecks   []internal.Check
+
+	// checkerOpts are applied to every Checker the Manager builds.
+	checkerOpts []CheckerOption
+
+	// started latches once the startup probe first passes.
+	started atomic.Bool
 }
 
-// NewManager creates a new Health Manager.
-func NewManager() *Manager {
-	return &Manager{}
+// NewManager creates a new Health Manager. The given options, such as
+// [WithDegradedThreshold], apply to every Checker it builds.
+func NewManager(opts ...CheckerOption) *Manager {
+	return &Manager{checkerOpts: opts}
 }
 
 // AddLivenessCheck registers a check for liveness probes.
 func (m *Manager) AddLivenessCheck(name string, check CheckFunc) {
+	m.AddLivenessCheckWithOptions(name, check)
+}
+
+// AddLivenessCheckWithOptions registers a check for liveness probes
+// configured by opts.
+func (m *Manager) AddLivenessCheckWithOptions(name string, check CheckFunc, opts ...CheckOption) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.livenessChecks = append(m.livenessChecks, internal.Check{
-		Name:     name,
-		Check:    check,
-		Critical: true, // Default to critical per existing behavior
-	})
+	m.livenessChecks = append(m.livenessChecks, newCheck(name, check, opts))
 }
 
 // AddReadinessCheck registers a check for readiness probes.
 func (m *Manager) AddReadinessCheck(name string, check CheckFunc) {
+	m.AddReadinessCheckWithOptions(name, check)
+}
+
+// AddReadinessCheckWithOptions registers a check for readiness probes
+// configured by opts.
+func (m *Manager) AddReadinessCheckWithOptions(name string, check CheckFunc, opts ...CheckOption) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.readinessChecks = append(m.readinessChecks, internal.Check{
-		Name:     name,
-		Check:    check,
-		Critical: true, // Default to critical per existing behavior
-	})
+	m.readinessChecks = append(m.readinessChecks, newCheck(name, check, opts))
 }
 
 // AddStartupCheck registers a check for startup probes.
 func (m *Manager) AddStartupCheck(name string, check CheckFunc) {
+	m.AddStartupCheckWithOptions(name, check)
+}
+
+// AddStartupCheckWithOptions registers a check for startup probes
+// configured by opts.
+func (m *Manager) AddStartupCheckWithOptions(name string, check CheckFunc, opts ...CheckOption) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.startupChecks = append(m.startupChecks, internal.Check{
-		Name:     name,
-		Check:    check,
-		Critical: true, // Default to critical per existing behavior
-	})
+	m.startupChecks = append(m.startupChecks, newCheck(name, check, opts))
 }
 
 // LivenessChecker builds the Checker for liveness checks.
@@ -59,10 +74,11 @@ func (m *Manager) LivenessChecker(opts ...CheckerOption) Checker {
 
 	defer m.mu.Unlock()
 
-	finalOpts := make([]CheckerOption, 0, len(m.livenessChecks)+len(opts))
+	finalOpts := make([]CheckerOption, 0, len(m.livenessChecks)+len(m.checkerOpts)+len(opts))
 	for _, c := range m.livenessChecks {
 		finalOpts = append(finalOpts, internal.WithCheck(c))
 	}
+	finalOpts = append(finalOpts, m.checkerOpts...)
 	finalOpts = append(finalOpts, opts...)
 
 	return internal.NewChecker(finalOpts...)
@@ -74,10 +90,11 @@ func (m *Manager) ReadinessChecker(opts ...CheckerOption) Checker {
 
 	defer m.mu.Unlock()
 
-	finalOpts := make([]CheckerOption, 0, len(m.readinessChecks)+len(opts))
+	finalOpts := make([]CheckerOption, 0, len(m.readinessChecks)+len(m.checkerOpts)+len(opts))
 	for _, c := range m.readinessChecks {
 		finalOpts = append(finalOpts, internal.WithCheck(c))
 	}
+	finalOpts = append(finalOpts, m.checkerOpts...)
 	finalOpts = append(finalOpts, opts...)
 
 	return internal.NewChecker(finalOpts...)
@@ -89,11 +106,48 @@ func (m *Manager) StartupChecker(opts ...CheckerOption) Checker {
 
 	defer m.mu.Unlock()
 
-	finalOpts := make([]CheckerOption, 0, len(m.startupChecks)+len(opts))
+	finalOpts := make([]CheckerOption, 0, len(m.startupChecks)+len(m.checkerOpts)+len(opts))
 	for _, c := range m.startupChecks {
 		finalOpts = append(finalOpts, internal.WithCheck(c))
 	}
+	finalOpts = append(finalOpts, m.checkerOpts...)
 	finalOpts = append(finalOpts, opts...)
 
 	return internal.NewChecker(finalOpts...)
 }
+
+// startupProbeChecker builds the Checker behind the startup endpoint. It runs
+// the startup and readiness checks until they first pass, then reports up
+// without re-evaluating, matching the Kubernetes startupProbe contract.
+func (m *Manager) startupProbeChecker() Checker {
+	m.mu.Lock()
+	opts := make([]CheckerOption, 0, len(m.startupChecks)+len(m.readinessChecks)+len(m.checkerOpts))
+	for _, c := range m.startupChecks {
+		opts = append(opts, internal.WithCheck(c))
+	}
+	for _, c := range m.readinessChecks {
+		opts = append(opts, internal.WithCheck(c))
+	}
+	opts = append(opts, m.checkerOpts...)
+	m.mu.Unlock()
+
+	return &startupProbe{manager: m, checker: internal.NewChecker(opts...)}
+}
+
+// startupProbe latches the startup probe result on the Manager.
+type startupProbe struct {
+	manager *Manager
+	checker Checker
+}
+
+// Check reports up once the probe has passed, otherwise runs the checks.
+func (p *startupProbe) Check(ctx context.Context) CheckerResult {
+	if p.manager.started.Load() {
+		return CheckerResult{Status: StatusUp}
+	}
+	result := p.checker.Check(ctx)
+	if result.Status == StatusUp || result.Status == StatusDegraded {
+		p.manager.started.Store(true)
+	}
+	return result
+}
@@ -8,6 +8,12 @@ import (
 	"github.com/petabytecl/gaz/health/internal"
 )
 
+// Manager keeps satisfying the original Registrar alongside OptionsRegistrar.
+var (
+	_ Registrar        = (*Manager)(nil)
+	_ OptionsRegistrar = (*Manager)(nil)
+)
+
 func TestManager_LivenessChecker(t *testing.T) {
 	m := NewManager()
 
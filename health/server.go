@@ -14,6 +14,7 @@ type ManagementServer struct {
 	config        Config
 	server        *http.Server
 	listener      net.Listener
+	manager       *Manager
 	shutdownCheck *ShutdownCheck
 	logger        *slog.Logger
 }
@@ -29,18 +30,13 @@ func NewManagementServer(
 		logger = slog.Default()
 	}
 
-	mux := http.NewServeMux()
-	mux.Handle(config.LivenessPath, manager.NewLivenessHandler())
-	mux.Handle(config.ReadinessPath, manager.NewReadinessHandler())
-	mux.Handle(config.StartupPath, manager.NewStartupHandler())
-
 	return &ManagementServer{
 		config: config,
 		server: &http.Server{
 			Addr:              fmt.Sprintf(":%d", config.Port),
-			Handler:           mux,
 			ReadHeaderTimeout: DefaultReadHeaderTimeout,
 		},
+		manager:       manager,
 		shutdownCheck: shutdownCheck,
 		logger:        logger,
 	}
@@ -49,8 +45,16 @@ func NewManagementServer(
 // OnStart starts the management server in a background goroutine.
 // The listener is created synchronously so port-bind errors are returned
 // immediately (and port 0 is resolved before the method returns).
+// Handlers are built here rather than in the constructor so that checks
+// added by other eager providers during Build are included.
 // Implements di.Starter interface.
 func (s *ManagementServer) OnStart(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(s.config.LivenessPath, s.manager.NewLivenessHandler())
+	mux.Handle(s.config.ReadinessPath, s.manager.NewReadinessHandler())
+	mux.Handle(s.config.StartupPath, s.manager.NewStartupHandler())
+	s.server.Handler = mux
+
 	lc := net.ListenConfig{}
 
 	lis, err := lc.Listen(ctx, "tcp", s.server.Addr)
@@ -2,6 +2,7 @@ package health
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -54,3 +55,41 @@ func TestManagementServer_StartStop(t *testing.T) {
 		return resp.StatusCode == http.StatusOK
 	}, 2*time.Second, 50*time.Millisecond)
 }
+
+func TestManagementServer_IncludesChecksAddedAfterConstruction(t *testing.T) {
+	config := Config{
+		Port:          0,
+		LivenessPath:  "/live",
+		ReadinessPath: "/ready",
+		StartupPath:   "/startup",
+	}
+	manager := NewManager()
+	server := NewManagementServer(config, manager, NewShutdownCheck(), nil)
+
+	// Added after construction, as an eager provider would during Build.
+	manager.AddReadinessCheck("late", func(context.Context) error {
+		return errors.New("not ready")
+	})
+
+	require.NoError(t, server.OnStart(context.Background()))
+	t.Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		require.NoError(t, server.OnStop(stopCtx))
+	})
+
+	url := fmt.Sprintf("http://localhost:%d/ready", server.Port())
+	require.Eventually(t, func() bool {
+		req, reqErr := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+		if reqErr != nil {
+			return false
+		}
+		resp, doErr := http.DefaultClient.Do(req)
+		if doErr != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+
+		return resp.StatusCode == http.StatusServiceUnavailable
+	}, 2*time.Second, 50*time.Millisecond)
+}
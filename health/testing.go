@@ -34,7 +34,7 @@ func NewTestConfig(opts ...func(*Config)) Config {
 	return cfg
 }
 
-// MockRegistrar is a test double for health.Registrar.
+// MockRegistrar is a test double for health.OptionsRegistrar.
 // It uses testify/mock for expectation setting and verification.
 type MockRegistrar struct {
 	mock.Mock
@@ -47,6 +47,9 @@ func NewMockRegistrar() *MockRegistrar {
 	m.On("AddLivenessCheck", mock.Anything, mock.Anything).Return()
 	m.On("AddReadinessCheck", mock.Anything, mock.Anything).Return()
 	m.On("AddStartupCheck", mock.Anything, mock.Anything).Return()
+	m.On("AddLivenessCheckWithOptions", mock.Anything, mock.Anything).Return()
+	m.On("AddReadinessCheckWithOptions", mock.Anything, mock.Anything).Return()
+	m.On("AddStartupCheckWithOptions", mock.Anything, mock.Anything).Return()
 	return m
 }
 
@@ -65,6 +68,21 @@ func (m *MockRegistrar) AddStartupCheck(name string, check CheckFunc) {
 	m.Called(name, check)
 }
 
+// AddLivenessCheckWithOptions records a liveness check registration.
+func (m *MockRegistrar) AddLivenessCheckWithOptions(name string, check CheckFunc, _ ...CheckOption) {
+	m.Called(name, check)
+}
+
+// AddReadinessCheckWithOptions records a readiness check registration.
+func (m *MockRegistrar) AddReadinessCheckWithOptions(name string, check CheckFunc, _ ...CheckOption) {
+	m.Called(name, check)
+}
+
+// AddStartupCheckWithOptions records a startup check registration.
+func (m *MockRegistrar) AddStartupCheckWithOptions(name string, check CheckFunc, _ ...CheckOption) {
+	m.Called(name, check)
+}
+
 // TestManager creates a Manager with no checks registered, suitable for testing.
 // The manager is ready to have checks added and can be used to create health checkers.
 func TestManager() *Manager {
@@ -46,6 +46,14 @@ func TestMockRegistrar(t *testing.T) {
 	m.AssertCalled(t, "AddStartupCheck", "migrations", mock.Anything)
 }
 
+func TestMockRegistrarWithOptions(t *testing.T) {
+	var r OptionsRegistrar = NewMockRegistrar()
+
+	r.AddReadinessCheckWithOptions("cache", func(ctx context.Context) error { return nil }, WithNonCritical())
+
+	r.(*MockRegistrar).AssertCalled(t, "AddReadinessCheckWithOptions", "cache", mock.Anything)
+}
+
 func TestTestManager(t *testing.T) {
 	m := TestManager()
 	require.NotNil(t, m)
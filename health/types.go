@@ -29,13 +29,22 @@ const (
 	StatusUp = internal.StatusUp
 	// StatusDown means the system/component is unavailable.
 	StatusDown = internal.StatusDown
+	// StatusDegraded means the system/component is available but partially failing.
+	StatusDegraded = internal.StatusDegraded
 )
 
 // CheckFunc is a function that performs a health check.
 // It returns an error if the check fails.
+//
+// The subsystem checks worker.Manager.HealthCheck,
+// cron.Scheduler.JobHealthCheck and eventbus.EventBus.HealthCheck have this
+// signature, so they can be registered directly.
 type CheckFunc func(context.Context) error
 
 // CheckOptions defines configuration for a specific check.
+//
+// Deprecated: CheckOptions is not read by the Manager. Configure checks with
+// CheckOption values such as [WithCacheTTL] and [WithNonCritical] instead.
 type CheckOptions struct {
 	Name    string
 	Timeout time.Duration
@@ -55,3 +64,27 @@ type Registrar interface {
 	// Failures here hold off liveness/readiness checks.
 	AddStartupCheck(name string, check CheckFunc)
 }
+
+// OptionsRegistrar is a Registrar that also accepts a CheckOption, such as
+// [WithCacheTTL] or [WithNonCritical], per check. Manager implements it.
+type OptionsRegistrar interface {
+	Registrar
+
+	// AddLivenessCheckWithOptions registers a liveness check configured by opts.
+	AddLivenessCheckWithOptions(name string, check CheckFunc, opts ...CheckOption)
+
+	// AddReadinessCheckWithOptions registers a readiness check configured by opts.
+	AddReadinessCheckWithOptions(name string, check CheckFunc, opts ...CheckOption)
+
+	// AddStartupCheckWithOptions registers a startup check configured by opts.
+	AddStartupCheckWithOptions(name string, check CheckFunc, opts ...CheckOption)
+}
+
+// WithDegradedThreshold reports [StatusDegraded] when at least fraction of the
+// non-critical checks fail while every critical check passes, e.g. 0.5 for
+// "half of the caches are down". Degraded probes still answer 200 OK with an
+// IETF "warn" body, so load balancers can lower the instance's weight instead
+// of removing it. Zero disables degraded reporting.
+func WithDegradedThreshold(fraction float64) CheckerOption {
+	return internal.WithDegradedThreshold(fraction)
+}
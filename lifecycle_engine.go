@@ -35,6 +35,11 @@ func ComputeStartupOrder(
 		pendingCounts[node] = len(deps)
 
 		for _, dep := range deps {
+			// Dependencies outside services (such as workers) still have to
+			// be processed to release their dependents.
+			if _, ok := pendingCounts[dep]; !ok {
+				pendingCounts[dep] = 0
+			}
 			reverseGraph[dep] = append(reverseGraph[dep], node)
 		}
 	}
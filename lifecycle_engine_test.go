@@ -108,6 +108,22 @@ func (s *LifecycleEngineSuite) TestComputeStartupOrder_FilterNoLifecycle() {
 	s.Equal([]string{"A"}, order[1])
 }
 
+func (s *LifecycleEngineSuite) TestComputeStartupOrder_DependencyOutsideServices() {
+	// Graph: A -> W, where W (e.g. a worker) is not a lifecycle service
+	// and has no entry of its own in the graph.
+	graph := map[string][]string{
+		"A": {"W"},
+	}
+
+	services := map[string]di.ServiceWrapper{
+		"A": &mockServiceWrapper{nameVal: "A", hasLifecycleVal: true},
+	}
+
+	order, err := ComputeStartupOrder(graph, services)
+	s.Require().NoError(err)
+	s.Equal([][]string{{"A"}}, order)
+}
+
 func (s *LifecycleEngineSuite) TestComputeShutdownOrder() {
 	startupOrder := [][]string{
 		{"C"},
@@ -4,6 +4,7 @@ package logger
 import (
 	"fmt"
 	"log/slog"
+	"maps"
 
 	"github.com/spf13/pflag"
 )
@@ -25,6 +26,13 @@ type Config struct {
 	// Values: "stdout" (default), "stderr", or a file path.
 	Output string
 
+	// StaticFields are attached to every record, e.g. service or region.
+	StaticFields map[string]any
+
+	// AddHostname attaches a "hostname" field to every record, identifying
+	// the instance (the pod name on Kubernetes).
+	AddHostname bool
+
 	// levelName is used for flag binding (internal).
 	levelName string
 }
@@ -40,6 +48,28 @@ func DefaultConfig() Config {
 	}
 }
 
+// Option configures a Config.
+type Option func(*Config)
+
+// WithStaticFields adds fields attached to every log record. Later calls
+// merge with earlier ones, overriding duplicate keys.
+func WithStaticFields(fields map[string]any) Option {
+	return func(c *Config) {
+		if c.StaticFields == nil {
+			c.StaticFields = make(map[string]any, len(fields))
+		}
+		maps.Copy(c.StaticFields, fields)
+	}
+}
+
+// WithHostname enables the hostname field by default.
+// The --log-hostname flag can still override it.
+func WithHostname() Option {
+	return func(c *Config) {
+		c.AddHostname = true
+	}
+}
+
 // Namespace returns the configuration namespace for config binding.
 func (c *Config) Namespace() string {
 	return "log"
@@ -55,6 +85,8 @@ func (c *Config) Flags(fs *pflag.FlagSet) {
 		"Log output: stdout, stderr, or file path")
 	fs.BoolVar(&c.AddSource, "log-add-source", c.AddSource,
 		"Include source file:line in logs")
+	fs.BoolVar(&c.AddHostname, "log-hostname", c.AddHostname,
+		"Include the hostname in every log record")
 }
 
 // Validate validates the configuration and converts levelName to Level.
@@ -45,6 +45,10 @@ func TestConfig_Flags(t *testing.T) {
 	flag = fs.Lookup("log-add-source")
 	require.NotNil(t, flag, "log-add-source flag should be registered")
 	require.Equal(t, "false", flag.DefValue)
+
+	flag = fs.Lookup("log-hostname")
+	require.NotNil(t, flag, "log-hostname flag should be registered")
+	require.Equal(t, "false", flag.DefValue)
 }
 
 func TestConfig_Validate(t *testing.T) {
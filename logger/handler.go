@@ -43,3 +43,12 @@ func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 func (h *ContextHandler) WithGroup(name string) slog.Handler {
 	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
 }
+
+// HandlerWrapper wraps the application logger's handler. Services
+// registered in the container that implement it are applied when the app
+// constructs its logger during Build, before the logger is registered or
+// set as the slog default, so every holder of the logger sees the wrapped
+// handler. Multiple wrappers are applied in no particular order.
+type HandlerWrapper interface {
+	WrapHandler(next slog.Handler) slog.Handler
+}
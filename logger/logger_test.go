@@ -162,3 +162,45 @@ func TestNewLogger_ContextPropagation(t *testing.T) {
 	assert.Equal(t, "req-456", logMap[RequestIDKey])
 	assert.Equal(t, "test context", logMap["msg"])
 }
+
+func TestNewLoggerWithWriter_StaticFields(t *testing.T) {
+	cfg := &Config{Level: slog.LevelInfo, Format: "json"}
+	WithStaticFields(map[string]any{"service": "api", "region": "eu"})(cfg)
+	WithStaticFields(map[string]any{"region": "us"})(cfg)
+
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter(cfg, &buf)
+	logger.Info("first", "key", "value")
+	logger.With("component", "db").Warn("second")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		var logMap map[string]any
+		require.NoError(t, json.Unmarshal(line, &logMap))
+		assert.Equal(t, "api", logMap["service"])
+		assert.Equal(t, "us", logMap["region"])
+		assert.NotContains(t, logMap, "hostname")
+	}
+
+	var first, second map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &first))
+	require.NoError(t, json.Unmarshal(lines[1], &second))
+	assert.Equal(t, "value", first["key"])
+	assert.Equal(t, "db", second["component"])
+}
+
+func TestNewLoggerWithWriter_Hostname(t *testing.T) {
+	host, err := os.Hostname()
+	require.NoError(t, err)
+
+	cfg := &Config{Level: slog.LevelInfo, Format: "json"}
+	WithHostname()(cfg)
+
+	var buf bytes.Buffer
+	NewLoggerWithWriter(cfg, &buf).Info("hello")
+
+	var logMap map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logMap))
+	assert.Equal(t, host, logMap["hostname"])
+}
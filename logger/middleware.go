@@ -31,15 +31,21 @@ func generateID() string {
 	return hex.EncodeToString(b)
 }
 
+// EnsureRequestID returns id if it is a safe request ID, or a newly
+// generated one if it is empty or could enable log injection.
+func EnsureRequestID(id string) string {
+	if id == "" || !isValidRequestID(id) {
+		return generateID()
+	}
+	return id
+}
+
 // RequestIDMiddleware checks for an incoming X-Request-ID header.
 // If missing, it generates a new ID.
 // It sets the X-Request-ID header on the response and adds the ID to the request context.
 func RequestIDMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		reqID := r.Header.Get("X-Request-ID")
-		if reqID == "" || !isValidRequestID(reqID) {
-			reqID = generateID()
-		}
+		reqID := EnsureRequestID(r.Header.Get("X-Request-ID"))
 
 		// Set the header on the response so the client knows the ID
 		w.Header().Set("X-Request-ID", reqID)
@@ -24,8 +24,16 @@ import (
 //	--log-format    Log format: text, json (default: text)
 //	--log-output    Log output: stdout, stderr, or file path (default: stdout)
 //	--log-add-source  Include source file:line in logs (default: false)
-func New() gaz.Module {
+//	--log-hostname    Include the hostname in every log record (default: false)
+//
+// Options such as logger.WithStaticFields set fields attached to every record:
+//
+//	app.Use(loggermod.New(logger.WithStaticFields(map[string]any{"service": "api"})))
+func New(opts ...logger.Option) gaz.Module {
 	defaultCfg := logger.DefaultConfig()
+	for _, opt := range opts {
+		opt(&defaultCfg)
+	}
 
 	return gaz.NewModule("logger").
 		Flags(defaultCfg.Flags).
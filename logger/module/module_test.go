@@ -41,6 +41,19 @@ func (s *LoggerModuleTestSuite) TestModuleRegistration() {
 	s.Equal("info", cfg.LevelName())
 }
 
+func (s *LoggerModuleTestSuite) TestModuleOptions() {
+	rootCmd := &cobra.Command{Use: "test", RunE: func(_ *cobra.Command, _ []string) error { return nil }}
+	app := gaz.New(gaz.WithCobra(rootCmd))
+	app.Use(loggermod.New(logger.WithStaticFields(map[string]any{"service": "api"})))
+
+	s.Require().NoError(app.Build())
+
+	cfg, err := gaz.Resolve[logger.Config](app.Container())
+	s.Require().NoError(err)
+	s.Equal(map[string]any{"service": "api"}, cfg.StaticFields)
+	s.False(cfg.AddHostname)
+}
+
 func (s *LoggerModuleTestSuite) TestDefaultConfig() {
 	cfg := logger.DefaultConfig()
 
@@ -60,6 +73,7 @@ func (s *LoggerModuleTestSuite) TestConfigFlags() {
 	s.NotNil(fs.Lookup("log-format"))
 	s.NotNil(fs.Lookup("log-output"))
 	s.NotNil(fs.Lookup("log-add-source"))
+	s.NotNil(fs.Lookup("log-hostname"))
 
 	// Parse custom values
 	err := fs.Parse([]string{
@@ -67,6 +81,7 @@ func (s *LoggerModuleTestSuite) TestConfigFlags() {
 		"--log-format=json",
 		"--log-output=stderr",
 		"--log-add-source",
+		"--log-hostname",
 	})
 	s.Require().NoError(err)
 
@@ -77,6 +92,7 @@ func (s *LoggerModuleTestSuite) TestConfigFlags() {
 	s.Equal("json", cfg.Format)
 	s.Equal("stderr", cfg.Output)
 	s.True(cfg.AddSource)
+	s.True(cfg.AddHostname)
 }
 
 func (s *LoggerModuleTestSuite) TestConfigValidation_InvalidLevel() {
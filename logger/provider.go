@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
+	"slices"
 
 	"github.com/petabytecl/gaz/logger/tint"
 )
@@ -58,6 +60,10 @@ func NewLoggerWithWriter(cfg *Config, w io.Writer) *slog.Logger {
 		})
 	}
 
+	if attrs := baseAttrs(cfg); len(attrs) > 0 {
+		handler = handler.WithAttrs(attrs)
+	}
+
 	// Wrap with ContextHandler to propagate context values
 	handler = NewContextHandler(handler)
 
@@ -67,6 +73,21 @@ func NewLoggerWithWriter(cfg *Config, w io.Writer) *slog.Logger {
 	return logger
 }
 
+// baseAttrs returns the static fields and optional hostname attached to
+// every record, sorted by key so output is stable.
+func baseAttrs(cfg *Config) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(cfg.StaticFields)+1)
+	if cfg.AddHostname {
+		if host, err := os.Hostname(); err == nil {
+			attrs = append(attrs, slog.String("hostname", host))
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(cfg.StaticFields)) {
+		attrs = append(attrs, slog.Any(k, cfg.StaticFields[k]))
+	}
+	return attrs
+}
+
 // resolveOutputWithCloser resolves the output destination and returns both the writer
 // and a closer. For file outputs, the closer closes the file handle. For stdout/stderr,
 // the closer is a no-op.
@@ -0,0 +1,37 @@
+// Package middleware provides cross-transport middleware shared by the HTTP,
+// gateway, and gRPC layers.
+//
+// # Request IDs
+//
+// A request ID is generated at the HTTP edge, or taken from a valid incoming
+// X-Request-ID header, and carried through the request so every log line and
+// downstream call can be correlated:
+//
+//   - [RequestID] is HTTP middleware that sets the ID on the request context
+//     (for logger.ContextHandler), the request headers, and the response.
+//   - [RequestIDTransport] applies RequestID to Vanguard when registered in
+//     the container. Vanguard forwards the header to gRPC handlers as
+//     x-request-id metadata.
+//   - [RequestIDMetadata] annotates outgoing metadata in a grpc-gateway
+//     (runtime.WithMetadata).
+//   - [NewRequestIDServerInterceptor] reads the ID from incoming metadata into
+//     the handler context.
+//   - [NewRequestIDClientInterceptor] forwards the context's ID on outgoing
+//     gRPC calls.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	handler := middleware.RequestID(mux)
+//
+//	// Forward the ID on calls to other services
+//	unary, stream := middleware.NewRequestIDClientInterceptor()
+//	app.Use(grpcclient.NewModule(
+//	    grpcclient.WithTarget("billing:50051"),
+//	    grpcclient.WithInsecure(),
+//	    grpcclient.WithDialOptions(
+//	        grpc.WithChainUnaryInterceptor(unary),
+//	        grpc.WithChainStreamInterceptor(stream),
+//	    ),
+//	))
+package middleware
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	grpcmw "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/petabytecl/gaz/logger"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the request ID.
+	RequestIDHeader = "X-Request-ID"
+
+	// RequestIDMetadataKey is the gRPC metadata key carrying the request ID.
+	RequestIDMetadataKey = "x-request-id"
+
+	// PriorityRequestID is the RequestIDTransport priority: after CORS and
+	// before OTEL in the Vanguard middleware chain.
+	PriorityRequestID = 50
+)
+
+// RequestID is HTTP middleware that keeps a valid incoming X-Request-ID or
+// generates a new one. The ID is stored in the request context (see
+// logger.GetRequestID), set on the response, and set on the request headers
+// so in-process gRPC handlers receive it as metadata.
+func RequestID(next http.Handler) http.Handler {
+	return logger.RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Replace an invalid incoming ID with the one the context carries
+		r.Header.Set(RequestIDHeader, logger.GetRequestID(r.Context()))
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// RequestIDTransport applies RequestID as a Vanguard transport middleware.
+// Register it in the container to enable it:
+//
+//	gaz.For[*middleware.RequestIDTransport](c).Instance(&middleware.RequestIDTransport{})
+type RequestIDTransport struct{}
+
+// Name returns the middleware identifier.
+func (*RequestIDTransport) Name() string {
+	return "request-id"
+}
+
+// Priority returns PriorityRequestID.
+func (*RequestIDTransport) Priority() int {
+	return PriorityRequestID
+}
+
+// Wrap applies RequestID to next.
+func (*RequestIDTransport) Wrap(next http.Handler) http.Handler {
+	return RequestID(next)
+}
+
+// RequestIDMetadata returns the request ID of r as outgoing gRPC metadata.
+// Its signature matches grpc-gateway's runtime.WithMetadata annotator:
+//
+//	runtime.NewServeMux(runtime.WithMetadata(middleware.RequestIDMetadata))
+//
+// Wrap the gateway mux with RequestID so the ID is set. Requests without
+// one get no metadata.
+func RequestIDMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	id := logger.GetRequestID(ctx)
+	if id == "" {
+		id = logger.GetRequestID(r.Context())
+	}
+	if id == "" {
+		return nil
+	}
+	return metadata.Pairs(RequestIDMetadataKey, id)
+}
+
+// NewRequestIDServerInterceptor creates interceptors that read the request
+// ID from incoming metadata into the handler context, generating one if it
+// is missing or invalid.
+//
+// Returns both unary and stream server interceptors.
+func NewRequestIDServerInterceptor() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(incomingRequestID(ctx), req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcmw.WrapServerStream(ss)
+		wrapped.WrappedContext = incomingRequestID(ss.Context())
+		return handler(srv, wrapped)
+	}
+
+	return unary, stream
+}
+
+// incomingRequestID stores the request ID from incoming metadata in ctx.
+func incomingRequestID(ctx context.Context) context.Context {
+	var id string
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIDMetadataKey); len(values) > 0 {
+		id = values[0]
+	}
+	return logger.WithRequestID(ctx, logger.EnsureRequestID(id))
+}
+
+// NewRequestIDClientInterceptor creates interceptors that add the context's
+// request ID to outgoing metadata. Calls without a request ID are unchanged.
+//
+// Returns both unary and stream client interceptors.
+func NewRequestIDClientInterceptor() (grpc.UnaryClientInterceptor, grpc.StreamClientInterceptor) {
+	unary := func(
+		ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		return invoker(outgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+
+	stream := func(
+		ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+		method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(outgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+
+	return unary, stream
+}
+
+// outgoingRequestID appends the context's request ID to outgoing metadata.
+func outgoingRequestID(ctx context.Context) context.Context {
+	id := logger.GetRequestID(ctx)
+	if id == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, id)
+}
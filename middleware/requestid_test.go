@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/petabytecl/gaz/logger"
+)
+
+// serveRequestID runs req through RequestID and returns the response and
+// the request ID the handler saw in its context and headers.
+func serveRequestID(t *testing.T, req *http.Request) (*httptest.ResponseRecorder, string, string) {
+	t.Helper()
+	var ctxID, headerID string
+	handler := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctxID = logger.GetRequestID(r.Context())
+		headerID = r.Header.Get(RequestIDHeader)
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec, ctxID, headerID
+}
+
+func TestRequestID_GeneratesMissingID(t *testing.T) {
+	rec, ctxID, headerID := serveRequestID(t, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	require.NotEmpty(t, ctxID)
+	assert.Equal(t, ctxID, headerID)
+	assert.Equal(t, ctxID, rec.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_PreservesIncomingID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "edge-123")
+
+	rec, ctxID, headerID := serveRequestID(t, req)
+
+	assert.Equal(t, "edge-123", ctxID)
+	assert.Equal(t, "edge-123", headerID)
+	assert.Equal(t, "edge-123", rec.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_ReplacesInvalidID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "bad id\nwith newline")
+
+	_, ctxID, headerID := serveRequestID(t, req)
+
+	assert.NotEqual(t, "bad id\nwith newline", ctxID)
+	assert.Equal(t, ctxID, headerID, "the request header should carry the replacement ID")
+}
+
+func TestRequestIDTransport(t *testing.T) {
+	transport := &RequestIDTransport{}
+	assert.Equal(t, "request-id", transport.Name())
+	assert.Equal(t, PriorityRequestID, transport.Priority())
+
+	var ctxID string
+	handler := transport.Wrap(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctxID = logger.GetRequestID(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NotEmpty(t, ctxID)
+}
+
+func TestRequestIDMetadata(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Nil(t, RequestIDMetadata(req.Context(), req), "no metadata without a request ID")
+
+	ctx := logger.WithRequestID(context.Background(), "gw-1")
+	md := RequestIDMetadata(ctx, req.WithContext(ctx))
+	assert.Equal(t, []string{"gw-1"}, md.Get(RequestIDMetadataKey))
+}
+
+// startHealthServer starts an in-memory gRPC server with the request ID
+// server interceptors and returns a client connection using the client
+// interceptors. seen receives the metadata and context IDs of each call.
+func startHealthServer(t *testing.T, seen func(metadataID, ctxID string)) *grpc.ClientConn {
+	t.Helper()
+	serverUnary, serverStream := NewRequestIDServerInterceptor()
+	capture := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var metadataID string
+		if values := metadata.ValueFromIncomingContext(ctx, RequestIDMetadataKey); len(values) > 0 {
+			metadataID = values[0]
+		}
+		seen(metadataID, logger.GetRequestID(ctx))
+		return handler(ctx, req)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(serverUnary, capture),
+		grpc.ChainStreamInterceptor(serverStream),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	clientUnary, clientStream := NewRequestIDClientInterceptor()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(clientUnary),
+		grpc.WithChainStreamInterceptor(clientStream),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestRequestID_ReachesGRPCMetadata(t *testing.T) {
+	var metadataID, serverCtxID string
+	conn := startHealthServer(t, func(m, c string) { metadataID, serverCtxID = m, c })
+	client := healthpb.NewHealthClient(conn)
+
+	var edgeID string
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		edgeID = logger.GetRequestID(r.Context())
+		if _, err := client.Check(r.Context(), &healthpb.HealthCheckRequest{}); err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, edgeID)
+	assert.Equal(t, edgeID, metadataID, "the edge ID should reach gRPC metadata")
+	assert.Equal(t, edgeID, serverCtxID, "the server interceptor should expose the ID for logging")
+}
+
+func TestRequestIDServerInterceptor_GeneratesMissingID(t *testing.T) {
+	var metadataID, serverCtxID string
+	conn := startHealthServer(t, func(m, c string) { metadataID, serverCtxID = m, c })
+
+	_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	assert.Empty(t, metadataID, "calls without a request ID send no metadata")
+	assert.NotEmpty(t, serverCtxID)
+}
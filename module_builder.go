@@ -36,6 +36,7 @@ type ModuleBuilder struct {
 	childModules []Module
 	flagsFn      func(*pflag.FlagSet) // CLI flags registration function
 	envPrefix    string               // config key prefix for the module
+	dependsOn    []string             // names of modules that must be applied first
 }
 
 // NewModule creates a new ModuleBuilder with the given name.
@@ -127,6 +128,26 @@ func (b *ModuleBuilder) WithEnvPrefix(prefix string) *ModuleBuilder {
 	return b
 }
 
+// DependsOn declares modules that must be applied before this one.
+// A module with dependencies is applied during Build() instead of
+// immediately in App.Use(), after all of its dependencies, regardless of
+// the order in which modules were registered.
+//
+// Build() fails with ErrModuleDependencyMissing if a dependency is never
+// registered, or ErrModuleDependencyCycle if dependencies form a cycle.
+// Flags declared via Flags() are still registered immediately.
+//
+// Example:
+//
+//	module := gaz.NewModule("api").
+//	    DependsOn("logger", "database").
+//	    Provide(APIProvider).
+//	    Build()
+func (b *ModuleBuilder) DependsOn(names ...string) *ModuleBuilder {
+	b.dependsOn = append(b.dependsOn, names...)
+	return b
+}
+
 // Build creates the final Module.
 // After Build() is called, the ModuleBuilder should not be reused.
 func (b *ModuleBuilder) Build() Module {
@@ -136,6 +157,7 @@ func (b *ModuleBuilder) Build() Module {
 		childModules: b.childModules,
 		flagsFn:      b.flagsFn,
 		envPrefix:    b.envPrefix,
+		dependsOn:    b.dependsOn,
 	}
 }
 
@@ -146,6 +168,7 @@ type builtModule struct {
 	childModules []Module
 	flagsFn      func(*pflag.FlagSet)
 	envPrefix    string
+	dependsOn    []string
 }
 
 // Name returns the module name.
@@ -157,6 +180,13 @@ func (m *builtModule) Name() string {
 // Child modules are applied FIRST (in order), then the parent's providers.
 // Each module's name is registered in app.modules for duplicate detection.
 func (m *builtModule) Apply(app *App) error {
+	// Reject nil providers before anything is registered
+	for i, p := range m.providers {
+		if p == nil {
+			return fmt.Errorf("%w: index %d", ErrModuleNilProvider, i)
+		}
+	}
+
 	// Apply child modules FIRST (composition)
 	for _, child := range m.childModules {
 		childName := child.Name()
@@ -173,9 +203,7 @@ func (m *builtModule) Apply(app *App) error {
 	}
 
 	// Register module flags if present
-	if m.flagsFn != nil {
-		app.AddFlagsFn(m.flagsFn)
-	}
+	m.registerFlags(app)
 
 	// Then apply this module's providers
 	for _, p := range m.providers {
@@ -187,6 +215,17 @@ func (m *builtModule) Apply(app *App) error {
 	return nil
 }
 
+// registerFlags adds the module's flags function to the app, at most once
+// per app. Registration is tracked on the App so the same module value can
+// be used with several apps.
+func (m *builtModule) registerFlags(app *App) {
+	if m.flagsFn == nil || app.moduleFlags[m.name] {
+		return
+	}
+	app.AddFlagsFn(m.flagsFn)
+	app.moduleFlags[m.name] = true
+}
+
 // FlagsFn returns the flags registration function, or nil if none was set.
 // This is used by App.Use() to apply module flags to the cobra command.
 func (m *builtModule) FlagsFn() func(*pflag.FlagSet) {
@@ -197,3 +236,8 @@ func (m *builtModule) FlagsFn() func(*pflag.FlagSet) {
 func (m *builtModule) EnvPrefix() string {
 	return m.envPrefix
 }
+
+// DependsOn returns the names of modules that must be applied before this one.
+func (m *builtModule) DependsOn() []string {
+	return m.dependsOn
+}
@@ -374,3 +374,88 @@ func (s *ModuleBuilderSuite) TestModuleBuilder_FlagsApplied_MultipleModules() {
 	err := app.Build()
 	s.Require().NoError(err)
 }
+
+func (s *ModuleBuilderSuite) TestModuleBuilder_DependsOn_ReordersApplication() {
+	var applied []string
+	record := func(name string) func(*Container) error {
+		return func(_ *Container) error {
+			applied = append(applied, name)
+			return nil
+		}
+	}
+
+	api := NewModule("api").DependsOn("database", "cache").Provide(record("api")).Build()
+	database := NewModule("database").DependsOn("logging").Provide(record("database")).Build()
+	cache := NewModule("cache").Provide(record("cache")).Build()
+	logging := NewModule("logging").Provide(record("logging")).Build()
+
+	// Registered in reverse dependency order
+	app := New()
+	app.Use(api).Use(database).Use(cache).Use(logging)
+
+	s.Require().NoError(app.Build())
+	s.Equal([]string{"cache", "logging", "database", "api"}, applied)
+}
+
+func (s *ModuleBuilderSuite) TestModuleBuilder_DependsOn_MissingDependency() {
+	app := New()
+	app.Use(NewModule("api").DependsOn("database").Build())
+
+	err := app.Build()
+	s.Require().ErrorIs(err, ErrModuleDependencyMissing)
+	s.Contains(err.Error(), "api depends on database")
+}
+
+func (s *ModuleBuilderSuite) TestModuleBuilder_DependsOn_Cycle() {
+	app := New()
+	app.Use(NewModule("a").DependsOn("b").Build())
+	app.Use(NewModule("b").DependsOn("c").Build())
+	app.Use(NewModule("c").DependsOn("a").Build())
+
+	err := app.Build()
+	s.Require().ErrorIs(err, ErrModuleDependencyCycle)
+	s.Contains(err.Error(), "a -> b -> c -> a")
+}
+
+func (s *ModuleBuilderSuite) TestModuleBuilder_DependsOn_FlagsRegisteredOnce() {
+	cmd := &cobra.Command{Use: "test"}
+	app := New(WithCobra(cmd))
+
+	m := NewModule("api").
+		DependsOn("database").
+		Flags(func(fs *pflag.FlagSet) {
+			fs.String("api-addr", ":8080", "API address")
+		}).
+		Build()
+
+	app.Use(m)
+	app.Use(NewModule("database").Build())
+
+	// Flags are available before Build for CLI parsing
+	s.NotNil(cmd.PersistentFlags().Lookup("api-addr"))
+
+	// Applying the module in Build must not redefine the flag
+	s.Require().NotPanics(func() {
+		s.Require().NoError(app.Build())
+	})
+}
+
+func (s *ModuleBuilderSuite) TestModuleBuilder_DependsOn_FlagsRegisteredPerApp() {
+	m := NewModule("api").
+		DependsOn("database").
+		Flags(func(fs *pflag.FlagSet) {
+			fs.String("api-addr", ":8080", "API address")
+		}).
+		Build()
+
+	// The same module value used by two apps registers flags on both
+	for _, name := range []string{"first", "second"} {
+		cmd := &cobra.Command{Use: name}
+		app := New(WithCobra(cmd))
+		app.Use(m)
+		app.Use(NewModule("database").Build())
+
+		s.NotNil(cmd.PersistentFlags().Lookup("api-addr"), "app %s should have api-addr", name)
+		s.Require().NoError(app.Build())
+	}
+}
@@ -45,9 +45,17 @@ const (
 type ConfigFlag struct {
 	// Key is the config key relative to the provider's namespace.
 	// For example, if a provider declares namespace "redis" and key "host",
-	// the full config key becomes "redis.host".
+	// the full config key becomes "redis.host". Keys may be dotted
+	// (e.g., "http.port") to place them in a sub-namespace.
 	Key string
 
+	// Group is an optional sub-namespace inserted between the provider's
+	// namespace and Key. A "server" provider can declare {Group: "http",
+	// Key: "port"} and {Group: "grpc", Key: "port"} to expose
+	// "server.http.port" and "server.grpc.port" (env SERVER_HTTP_PORT and
+	// SERVER_GRPC_PORT) without a second provider type.
+	Group string
+
 	// Type specifies how the config value should be parsed.
 	// String values are used as-is, while int, bool, duration, and float
 	// values are parsed from their string representation.
@@ -68,12 +76,63 @@ type ConfigFlag struct {
 	Description string
 }
 
+// relativeKey returns the key relative to the provider's namespace,
+// including the group if one is set.
+func (f ConfigFlag) relativeKey() string {
+	if f.Group == "" {
+		return f.Key
+	}
+	return f.Group + "." + f.Key
+}
+
+// fullConfigKey returns the full dot-notation key for a provider flag.
+func fullConfigKey(namespace string, f ConfigFlag) string {
+	return namespace + "." + f.relativeKey()
+}
+
+// toConfigFlags converts provider flags to config.ConfigFlag, with keys
+// relative to the provider's namespace.
+func toConfigFlags(flags []ConfigFlag) []config.ConfigFlag {
+	cfgFlags := make([]config.ConfigFlag, len(flags))
+	for i, f := range flags {
+		cfgFlags[i] = config.ConfigFlag{
+			Key:         f.relativeKey(),
+			Default:     f.Default,
+			Required:    f.Required,
+			Type:        string(f.Type),
+			Description: f.Description,
+		}
+	}
+	return cfgFlags
+}
+
+// DocumentEnvVars lists every environment variable the given providers read,
+// with its config key, type, default, and description, sorted by name.
+// Use it to generate ops documentation or a .env template.
+//
+// Example:
+//
+//	for _, v := range gaz.DocumentEnvVars(&RedisProvider{}) {
+//	    fmt.Printf("%s\t%s\t%v\t%s\n", v.Name, v.Type, v.Default, v.Description)
+//	}
+//	// REDIS_HOST	string	localhost	Redis server host
+func DocumentEnvVars(providers ...ConfigProvider) []config.EnvVarDoc {
+	flags := make([]config.ProviderFlags, len(providers))
+	for i, p := range providers {
+		flags[i] = config.ProviderFlags{
+			Namespace: p.ConfigNamespace(),
+			Flags:     toConfigFlags(p.ConfigFlags()),
+		}
+	}
+	return config.DocumentEnvVars(flags...)
+}
+
 // ConfigProvider is implemented by providers that need configuration.
 // When a provider implements this interface, the framework will:
 //
 //  1. Call ConfigNamespace() to get the prefix for all config keys
 //  2. Call ConfigFlags() to collect configuration requirements
-//  3. Auto-prefix each key with the namespace (e.g., "redis" + "host" = "redis.host")
+//  3. Auto-prefix each key with the namespace and optional group (e.g., "redis" + "host" = "redis.host")
 //  4. Translate keys for environment variables (e.g., "redis.host" → "REDIS_HOST")
 //  5. Validate required flags are set during Build()
 //
@@ -104,6 +104,49 @@ func (p *CollidingProvider2) ConfigFlags() []gaz.ConfigFlag {
 	}
 }
 
+// GroupedServerProvider exposes http and grpc sub-namespaces under "server".
+type GroupedServerProvider struct{}
+
+func (p *GroupedServerProvider) ConfigNamespace() string {
+	return "server"
+}
+
+func (p *GroupedServerProvider) ConfigFlags() []gaz.ConfigFlag {
+	return []gaz.ConfigFlag{
+		{Group: "http", Key: "port", Type: gaz.ConfigFlagTypeInt, Default: 8080, Description: "HTTP port"},
+		{Group: "grpc", Key: "port", Type: gaz.ConfigFlagTypeInt, Default: 9090, Description: "gRPC port"},
+		{Key: "admin.port", Type: gaz.ConfigFlagTypeInt, Default: 9100, Description: "Admin port"},
+	}
+}
+
+// OverlappingServerProvider declares "server.http" as a value, overlapping
+// the "server.http" group of GroupedServerProvider.
+type OverlappingServerProvider struct{}
+
+func (p *OverlappingServerProvider) ConfigNamespace() string {
+	return "server"
+}
+
+func (p *OverlappingServerProvider) ConfigFlags() []gaz.ConfigFlag {
+	return []gaz.ConfigFlag{
+		{Key: "http", Type: gaz.ConfigFlagTypeString, Description: "HTTP address"},
+	}
+}
+
+// DuplicateGroupProvider declares "server.grpc.port" via a dotted key,
+// colliding with GroupedServerProvider's grouped key.
+type DuplicateGroupProvider struct{}
+
+func (p *DuplicateGroupProvider) ConfigNamespace() string {
+	return "server"
+}
+
+func (p *DuplicateGroupProvider) ConfigFlags() []gaz.ConfigFlag {
+	return []gaz.ConfigFlag{
+		{Key: "grpc.port", Type: gaz.ConfigFlagTypeInt, Description: "gRPC port"},
+	}
+}
+
 // AllTypesProvider tests all config flag types.
 type AllTypesProvider struct{}
 
@@ -451,6 +494,64 @@ func (s *ProviderConfigSuite) TestEnvVarTranslation() {
 	s.Equal("env-translated-host", pv.GetString("redis.host"))
 }
 
+func (s *ProviderConfigSuite) TestGroupedKeys_EnvTranslation() {
+	s.T().Setenv("SERVER_HTTP_PORT", "8081")
+	s.T().Setenv("SERVER_ADMIN_PORT", "9101")
+
+	app := gaz.New().
+		WithConfig(&struct{}{}, config.WithEnvPrefix("TEST_GROUPED"))
+
+	err := gaz.For[*GroupedServerProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *GroupedServerProvider {
+		return &GroupedServerProvider{}
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	pv, err := gaz.Resolve[*gaz.ProviderValues](app.Container())
+	s.Require().NoError(err)
+
+	s.Equal(8081, pv.GetInt("server.http.port"))
+	s.Equal(9090, pv.GetInt("server.grpc.port"))
+	s.Equal(9101, pv.GetInt("server.admin.port"))
+}
+
+func (s *ProviderConfigSuite) TestGroupedKeys_Collision() {
+	app := gaz.New().
+		WithConfig(&struct{}{}, config.WithEnvPrefix("TEST_GROUPED_COLLISION"))
+
+	err := gaz.For[*GroupedServerProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *GroupedServerProvider {
+		return &GroupedServerProvider{}
+	})
+	s.Require().NoError(err)
+	err = gaz.For[*DuplicateGroupProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *DuplicateGroupProvider {
+		return &DuplicateGroupProvider{}
+	})
+	s.Require().NoError(err)
+
+	err = app.Build()
+	s.Require().ErrorIs(err, gaz.ErrConfigKeyCollision)
+	s.Contains(err.Error(), "server.grpc.port")
+}
+
+func (s *ProviderConfigSuite) TestGroupedKeys_OverlapCollision() {
+	app := gaz.New().
+		WithConfig(&struct{}{}, config.WithEnvPrefix("TEST_GROUPED_OVERLAP"))
+
+	err := gaz.For[*GroupedServerProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *GroupedServerProvider {
+		return &GroupedServerProvider{}
+	})
+	s.Require().NoError(err)
+	err = gaz.For[*OverlappingServerProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *OverlappingServerProvider {
+		return &OverlappingServerProvider{}
+	})
+	s.Require().NoError(err)
+
+	err = app.Build()
+	s.Require().ErrorIs(err, gaz.ErrConfigKeyCollision)
+	s.Contains(err.Error(), `"server.http"`)
+	s.Contains(err.Error(), `"server.http.port"`)
+}
+
 // =============================================================================
 // Unmarshal tests
 // =============================================================================
@@ -599,3 +700,51 @@ func (s *ProviderConfigSuite) TestProviderValues_Unmarshal() {
 	s.Require().NoError(err)
 	s.Contains(allConfig, "redis")
 }
+
+// =============================================================================
+// DocumentEnvVars tests
+// =============================================================================
+
+func (s *ProviderConfigSuite) TestDocumentEnvVars() {
+	docs := gaz.DocumentEnvVars(&RedisProvider{}, &RequiredConfigProvider{}, &GroupedServerProvider{})
+
+	names := make([]string, len(docs))
+	for i, d := range docs {
+		names[i] = d.Name
+	}
+	s.Equal([]string{
+		"REDIS_HOST", "REDIS_PORT", "REQUIRED_API_KEY",
+		"SERVER_ADMIN_PORT", "SERVER_GRPC_PORT", "SERVER_HTTP_PORT",
+	}, names)
+
+	s.Equal(config.EnvVarDoc{
+		Name:        "REDIS_HOST",
+		Key:         "redis.host",
+		Type:        "string",
+		Default:     "localhost",
+		Description: "Redis server host",
+	}, docs[0])
+	s.Equal(6379, docs[1].Default)
+
+	s.True(docs[2].Required)
+	s.Nil(docs[2].Default)
+	s.False(docs[0].Required)
+
+	s.Equal("server.grpc.port", docs[4].Key)
+	s.Equal(9090, docs[4].Default)
+}
+
+func (s *ProviderConfigSuite) TestDocumentEnvVars_MatchesBoundEnv() {
+	s.T().Setenv("REDIS_HOST", "redis.internal")
+
+	app := gaz.New()
+	err := gaz.For[*RedisProvider](app.Container()).ProviderFunc(func(_ *gaz.Container) *RedisProvider {
+		return &RedisProvider{}
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	pv := gaz.MustResolve[*gaz.ProviderValues](app.Container())
+	doc := gaz.DocumentEnvVars(&RedisProvider{})[0]
+	s.Equal("redis.internal", pv.GetString(doc.Key), "documented %s must be the variable read", doc.Name)
+}
@@ -0,0 +1,93 @@
+// Package cors provides CORS configuration and middleware shared by the
+// gaz HTTP and Vanguard servers.
+//
+// In dev mode every origin, method, and header is allowed. In production,
+// only the configured origins, methods, and headers are accepted:
+//
+//	handler := cors.Middleware(cors.DefaultConfig(false), false)(mux)
+package cors
+
+import (
+	"net/http"
+
+	rscors "github.com/rs/cors"
+)
+
+// DefaultMaxAge is the default max age for preflight request caching (24 hours in seconds).
+const DefaultMaxAge = 86400
+
+// Config holds CORS configuration.
+type Config struct {
+	// AllowedOrigins is a list of allowed origins.
+	// Use ["*"] to allow all origins (dev mode only, not with credentials).
+	// Outside dev mode, an empty list denies every cross-origin request.
+	AllowedOrigins []string `json:"allowed_origins" yaml:"allowed_origins" mapstructure:"allowed_origins"`
+
+	// AllowedMethods is a list of allowed HTTP methods.
+	AllowedMethods []string `json:"allowed_methods" yaml:"allowed_methods" mapstructure:"allowed_methods"`
+
+	// AllowedHeaders is a list of allowed request headers.
+	// Use ["*"] to allow all headers (dev mode only).
+	AllowedHeaders []string `json:"allowed_headers" yaml:"allowed_headers" mapstructure:"allowed_headers"`
+
+	// ExposedHeaders is a list of headers exposed to the browser.
+	ExposedHeaders []string `json:"exposed_headers" yaml:"exposed_headers" mapstructure:"exposed_headers"`
+
+	// AllowCredentials indicates whether credentials (cookies, auth headers) are allowed.
+	// Cannot be used with AllowedOrigins ["*"].
+	AllowCredentials bool `json:"allow_credentials" yaml:"allow_credentials" mapstructure:"allow_credentials"`
+
+	// MaxAge is the maximum age (in seconds) for preflight request caching.
+	MaxAge int `json:"max_age" yaml:"max_age" mapstructure:"max_age"`
+}
+
+// DefaultConfig returns a Config with appropriate defaults.
+// In dev mode, CORS is wide-open for convenience.
+// In prod mode, origins must be explicitly configured.
+func DefaultConfig(devMode bool) Config {
+	if devMode {
+		return Config{
+			AllowedOrigins:   []string{"*"},
+			AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			AllowedHeaders:   []string{"*"},
+			ExposedHeaders:   []string{},
+			AllowCredentials: false, // Cannot use * with credentials.
+			MaxAge:           DefaultMaxAge,
+		}
+	}
+	return Config{
+		AllowedOrigins:   []string{}, // Must be explicitly configured.
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type", "X-Request-ID"},
+		ExposedHeaders:   []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           DefaultMaxAge,
+	}
+}
+
+// Middleware returns middleware that handles preflight requests and sets
+// CORS response headers. In dev mode, all origins are allowed and cfg is
+// ignored. Otherwise, cfg origins, methods, and headers are enforced, and no
+// origin is allowed until cfg.AllowedOrigins lists one.
+func Middleware(cfg Config, devMode bool) func(http.Handler) http.Handler {
+	var c *rscors.Cors
+	if devMode {
+		c = rscors.AllowAll()
+	} else {
+		var denyAll func(string) bool
+		if len(cfg.AllowedOrigins) == 0 {
+			// rs/cors treats an empty origin list as "allow all".
+			denyAll = func(string) bool { return false }
+		}
+		c = rscors.New(rscors.Options{
+			AllowOriginFunc:  denyAll,
+			AllowedOrigins:   cfg.AllowedOrigins,
+			AllowedMethods:   cfg.AllowedMethods,
+			AllowedHeaders:   cfg.AllowedHeaders,
+			ExposedHeaders:   cfg.ExposedHeaders,
+			AllowCredentials: cfg.AllowCredentials,
+			MaxAge:           cfg.MaxAge,
+		})
+	}
+	return c.Handler
+}
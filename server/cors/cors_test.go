@@ -0,0 +1,55 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func preflight(h http.Handler, origin string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware_DevModeAllowsAll(t *testing.T) {
+	h := Middleware(DefaultConfig(false), true)(http.NotFoundHandler())
+
+	assert.Equal(t, "*", preflight(h, "https://any.example.com").Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMiddleware_ProdEnforcesOrigins(t *testing.T) {
+	cfg := DefaultConfig(false)
+	cfg.AllowedOrigins = []string{"https://app.example.com"}
+	h := Middleware(cfg, false)(http.NotFoundHandler())
+
+	rec := preflight(h, "https://app.example.com")
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+
+	assert.Empty(t, preflight(h, "https://evil.example.com").Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestMiddleware_ProdDeniesWithoutOrigins(t *testing.T) {
+	h := Middleware(DefaultConfig(false), false)(http.NotFoundHandler())
+
+	rec := preflight(h, "https://evil.example.com")
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestDefaultConfig(t *testing.T) {
+	dev := DefaultConfig(true)
+	assert.Equal(t, []string{"*"}, dev.AllowedOrigins)
+	assert.False(t, dev.AllowCredentials)
+
+	prod := DefaultConfig(false)
+	assert.Empty(t, prod.AllowedOrigins)
+	assert.True(t, prod.AllowCredentials)
+	assert.Equal(t, DefaultMaxAge, prod.MaxAge)
+}
@@ -0,0 +1,74 @@
+package debug
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultPort is the default debug server port.
+const DefaultPort = 6060
+
+// DefaultHost is the default debug server interface. The endpoints have no
+// authentication, so by default they are only reachable from the host.
+const DefaultHost = "127.0.0.1"
+
+// MaxPort is the maximum valid port number.
+const MaxPort = 65535
+
+// Config holds configuration for the debug server.
+type Config struct {
+	// Enabled starts the debug server. Defaults to false so profiling
+	// endpoints are never exposed by accident.
+	Enabled bool `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
+
+	// Host is the interface address the debug server listens on.
+	// Defaults to 127.0.0.1. An empty Host listens on all interfaces.
+	Host string `json:"host" yaml:"host" mapstructure:"host"`
+
+	// Port is the TCP port the debug server listens on.
+	// Defaults to 6060.
+	Port int `json:"port" yaml:"port" mapstructure:"port"`
+}
+
+// DefaultConfig returns a Config with the server disabled on
+// DefaultHost:DefaultPort.
+func DefaultConfig() Config {
+	return Config{
+		Enabled: false,
+		Host:    DefaultHost,
+		Port:    DefaultPort,
+	}
+}
+
+// Namespace returns the config namespace.
+func (c *Config) Namespace() string {
+	return "debug"
+}
+
+// Flags registers the config flags.
+func (c *Config) Flags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "debug-enabled", c.Enabled, "Serve pprof and expvar endpoints on the debug port")
+	fs.StringVar(&c.Host, "debug-host", c.Host, "Debug server interface address (empty for all interfaces)")
+	fs.IntVar(&c.Port, "debug-port", c.Port, "Debug server port")
+}
+
+// SetDefaults applies default values to zero-value fields.
+// Implements the config.Defaulter interface.
+func (c *Config) SetDefaults() {
+	if c.Port == 0 {
+		c.Port = DefaultPort
+	}
+}
+
+// Validate checks that the configuration is valid.
+// Implements the config.Validator interface.
+func (c *Config) Validate() error {
+	if c.Port < 0 {
+		return errors.New("debug: port must not be negative")
+	}
+	if c.Port > MaxPort {
+		return errors.New("debug: port must be less than or equal to 65535")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package debug serves runtime profiling and introspection endpoints on a
+// dedicated port, separate from the application servers.
+//
+// # Overview
+//
+// The debug server exposes:
+//
+//   - /debug/pprof/: net/http/pprof index, heap, allocs, block, mutex, and
+//     threadcreate profiles
+//   - /debug/pprof/profile: CPU profile (?seconds=N)
+//   - /debug/pprof/trace: execution trace (?seconds=N)
+//   - /debug/pprof/goroutine?debug=2: full goroutine dump
+//   - /debug/vars: expvar variables as JSON
+//
+// # Opt-In
+//
+// Profiling endpoints leak internals and can be expensive, so the server is
+// disabled unless explicitly enabled. When disabled, the module registers its
+// components but never binds a port:
+//
+//	app := gaz.New()
+//	app.Use(debug.NewModule())
+//	// Enable with --debug-enabled, or debug.enabled: true in config.
+//
+// Or enable it from code:
+//
+//	app.Use(debug.NewModule(debug.WithEnabled(true)))
+//
+// The endpoints have no authentication, so the server listens on 127.0.0.1
+// by default. To reach it from elsewhere, set host to a private interface,
+// or to "" for all interfaces, and keep the port behind a firewall.
+//
+// # Configuration
+//
+//	debug:
+//	  enabled: true
+//	  host: 127.0.0.1
+//	  port: 6060
+package debug
@@ -0,0 +1,104 @@
+package debug
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/petabytecl/gaz"
+)
+
+// ModuleOption configures the debug module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	enabled bool
+	host    string
+	port    int
+}
+
+// WithEnabled sets the default Config.Enabled. Config files and flags still
+// override it.
+func WithEnabled(enabled bool) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.enabled = enabled
+	}
+}
+
+// WithHost sets the default Config.Host. Config files and flags still
+// override it.
+func WithHost(host string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.host = host
+	}
+}
+
+// WithPort sets the default Config.Port. Config files and flags still
+// override it.
+func WithPort(port int) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.port = port
+	}
+}
+
+// NewModule creates a debug module.
+// Returns a gaz.Module that registers the debug server components.
+//
+// Components registered:
+//   - debug.Config (loaded from flags/config)
+//   - *debug.Server (eager, listens only when Config.Enabled is true)
+//
+// Example:
+//
+//	app := gaz.New()
+//	app.Use(debug.NewModule())
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{host: DefaultHost, port: DefaultPort}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
+	defaultCfg := DefaultConfig()
+	defaultCfg.Enabled = mc.enabled
+	defaultCfg.Host = mc.host
+	defaultCfg.Port = mc.port
+
+	return gaz.NewModule("debug").
+		Flags(defaultCfg.Flags).
+		Provide(func(c *gaz.Container) error {
+			return gaz.For[Config](c).Provider(func(c *gaz.Container) (Config, error) {
+				cfg := defaultCfg
+
+				if pv, err := gaz.Resolve[*gaz.ProviderValues](c); err == nil {
+					if unmarshalErr := pv.UnmarshalKey(defaultCfg.Namespace(), &cfg); unmarshalErr != nil {
+						// ignore error, use defaults
+						_ = unmarshalErr
+					}
+				}
+
+				if err := cfg.Validate(); err != nil {
+					return Config{}, fmt.Errorf("debug config validate: %w", err)
+				}
+
+				return cfg, nil
+			})
+		}).
+		Provide(func(c *gaz.Container) error {
+			return gaz.For[*Server](c).
+				Eager().
+				Provider(func(c *gaz.Container) (*Server, error) {
+					cfg, err := gaz.Resolve[Config](c)
+					if err != nil {
+						return nil, fmt.Errorf("resolve debug config: %w", err)
+					}
+
+					logger, err := gaz.Resolve[*slog.Logger](c)
+					if err != nil {
+						logger = slog.Default()
+					}
+
+					return NewServer(cfg, logger), nil
+				})
+		}).
+		Build()
+}
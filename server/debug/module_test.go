@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+func TestNewModule_DisabledByDefault(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.False(t, cfg.Enabled)
+	require.Equal(t, DefaultHost, cfg.Host)
+	require.Equal(t, DefaultPort, cfg.Port)
+
+	s, err := di.Resolve[*Server](app.Container())
+	require.NoError(t, err)
+	require.False(t, s.Enabled())
+}
+
+func TestNewModule_WithOptions(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithEnabled(true), WithHost("0.0.0.0"), WithPort(7070)).Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.True(t, cfg.Enabled)
+	require.Equal(t, "0.0.0.0", cfg.Host)
+	require.Equal(t, 7070, cfg.Port)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Port = -1
+	require.Error(t, cfg.Validate())
+
+	cfg.Port = MaxPort + 1
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,114 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"time"
+)
+
+// DefaultReadHeaderTimeout is the read header timeout for the debug server.
+// No write timeout is set so long CPU profiles and traces can complete.
+const DefaultReadHeaderTimeout = 5 * time.Second
+
+// Server serves pprof and expvar endpoints on a dedicated port.
+// It implements di.Starter and di.Stopper. When Config.Enabled is false,
+// OnStart and OnStop do nothing.
+type Server struct {
+	config   Config
+	server   *http.Server
+	listener net.Listener
+	logger   *slog.Logger
+}
+
+// NewServer creates a new debug server.
+// If logger is nil, slog.Default() is used.
+func NewServer(cfg Config, logger *slog.Logger) *Server {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Server{
+		config: cfg,
+		server: &http.Server{
+			Addr:              net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+			Handler:           Handler(),
+			ReadHeaderTimeout: DefaultReadHeaderTimeout,
+		},
+		logger: logger,
+	}
+}
+
+// Handler returns a handler serving the pprof endpoints under /debug/pprof/
+// and expvar under /debug/vars. It does not use http.DefaultServeMux.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// OnStart binds the port synchronously and serves in a background goroutine.
+// It does nothing when the server is disabled.
+// Implements di.Starter interface.
+func (s *Server) OnStart(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("debug server listen: %w", err)
+	}
+	s.listener = ln
+	s.logger.InfoContext(ctx, "Debug server starting", "addr", ln.Addr().String())
+
+	go func() {
+		if serveErr := s.server.Serve(ln); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Debug server error", "error", serveErr)
+		}
+	}()
+
+	return nil
+}
+
+// OnStop gracefully shuts down the debug server.
+// It does nothing when the server was never started.
+// Implements di.Stopper interface.
+func (s *Server) OnStop(ctx context.Context) error {
+	if s.listener == nil {
+		return nil
+	}
+
+	s.logger.InfoContext(ctx, "Debug server stopping")
+	if err := s.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown debug server: %w", err)
+	}
+	return nil
+}
+
+// Enabled reports whether the server is configured to start.
+func (s *Server) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Addr returns the server's bound address.
+// After OnStart, this returns the actual listener address (useful when port=0).
+// Before OnStart, or when disabled, it returns the configured address ":port".
+func (s *Server) Addr() string {
+	if s.listener != nil {
+		return s.listener.Addr().String()
+	}
+	return s.server.Addr
+}
@@ -0,0 +1,89 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer starts an enabled debug server on a random port.
+func startServer(t *testing.T) *Server {
+	t.Helper()
+	s := NewServer(Config{Enabled: true, Host: DefaultHost, Port: 0}, nil)
+	require.NoError(t, s.OnStart(context.Background()))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.OnStop(ctx)
+	})
+	return s
+}
+
+func get(t *testing.T, s *Server, path string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://"+s.Addr()+path, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestServer_ListensOnHost(t *testing.T) {
+	s := startServer(t)
+
+	host, _, err := net.SplitHostPort(s.Addr())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultHost, host)
+}
+
+func TestServer_ServesPprofIndex(t *testing.T) {
+	s := startServer(t)
+
+	code, body := get(t, s, "/debug/pprof/")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "goroutine")
+	assert.Contains(t, body, "heap")
+}
+
+func TestServer_ServesProfiles(t *testing.T) {
+	s := startServer(t)
+
+	code, body := get(t, s, "/debug/pprof/heap?debug=1")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "heap profile")
+
+	code, body = get(t, s, "/debug/pprof/goroutine?debug=2")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "goroutine ")
+}
+
+func TestServer_ServesExpvar(t *testing.T) {
+	s := startServer(t)
+
+	code, body := get(t, s, "/debug/vars")
+	assert.Equal(t, http.StatusOK, code)
+
+	var vars map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(body), &vars))
+	assert.Contains(t, vars, "memstats")
+	assert.Contains(t, vars, "cmdline")
+}
+
+func TestServer_DisabledIsNoOp(t *testing.T) {
+	s := NewServer(Config{Enabled: false, Port: 0}, nil)
+
+	require.NoError(t, s.OnStart(context.Background()))
+	assert.Nil(t, s.listener, "disabled server must not bind a port")
+	assert.Equal(t, ":0", s.Addr())
+	require.NoError(t, s.OnStop(context.Background()))
+}
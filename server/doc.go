@@ -28,6 +28,19 @@
 // The server module automatically sets gRPC SkipListener=true so that
 // Vanguard handles all connections. No additional configuration is needed.
 //
+// # Readiness
+//
+// NewModule registers a [Readiness] aggregator that reports ready only after
+// gRPC has registered its services and Vanguard is listening with every
+// registrar mounted on its transcoder. When the health module is in use, it
+// is added to the [health.Manager] as the "server" readiness check, so the
+// readiness endpoint fails until both servers are up and again once
+// shutdown begins:
+//
+//	app := gaz.New()
+//	app.Use(health.NewModule())
+//	app.Use(server.NewModule())
+//
 // # Configuration
 //
 // Configuration is handled via CLI flags and config files:
@@ -43,6 +56,9 @@
 //   - server/http: Standalone HTTP server with configurable timeouts and lifecycle
 //   - server/vanguard: Vanguard unified server (gRPC, Connect, gRPC-Web, REST transcoding)
 //   - server/connect: Connect interceptor bundles (auth, logging, recovery, validation, rate-limit)
+//   - server/cors: CORS configuration and middleware shared by server/http and server/vanguard
+//   - server/debug: Opt-in pprof and expvar endpoints on a dedicated port
+//   - server/metrics: Prometheus /metrics endpoint for gaz subsystem and request metrics
 //
 // # Lifecycle Integration
 //
@@ -0,0 +1,7 @@
+package server
+
+import "errors"
+
+// ErrNotReady is returned by Readiness.Check when one or more servers have not
+// finished starting.
+var ErrNotReady = errors.New("server: not ready")
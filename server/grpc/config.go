@@ -53,6 +53,15 @@ type Config struct {
 	// call server.Serve(). This is used when Vanguard handles connections.
 	// Defaults to false.
 	SkipListener bool `json:"skip_listener" yaml:"skip_listener" mapstructure:"skip_listener" gaz:"skip_listener"`
+
+	// MethodLimits overrides MaxRecvMsgSize and MaxSendMsgSize for specific
+	// methods, keyed by full method name. Set it with WithMethodLimits; it is
+	// not loaded from config files because method names contain dots.
+	MethodLimits map[string]MsgLimits `json:"-" yaml:"-" mapstructure:"-" gaz:"-"`
+
+	// ReflectionFilter limits the services listed by reflection. Set it with
+	// WithReflectionFilter; nil lists every service.
+	ReflectionFilter ReflectionFilter `json:"-" yaml:"-" mapstructure:"-" gaz:"-"`
 }
 
 // DefaultConfig returns a Config with safe defaults.
@@ -118,5 +127,5 @@ func (c *Config) Validate() error {
 	if c.HealthEnabled && c.HealthCheckInterval <= 0 {
 		return fmt.Errorf("grpc: invalid health_check_interval %s: must be positive", c.HealthCheckInterval)
 	}
-	return nil
+	return validateMethodLimits(c.MethodLimits)
 }
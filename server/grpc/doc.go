@@ -34,7 +34,8 @@
 //	di.For[*GreeterService](c).Provider(NewGreeterService)
 //
 // The gRPC server will discover and register all Registrar implementations
-// automatically on startup.
+// automatically on startup. [Server.RegisteredServices] lists the services
+// being served, which is useful for admin endpoints when reflection is off.
 //
 // # Interceptors
 //
@@ -42,6 +43,51 @@
 //   - Logging: Request/response logging with duration and status
 //   - Recovery: Panic recovery with stack trace logging
 //
+// Recovered panics return codes.Internal. Use WithPanicHandler to choose the
+// returned error:
+//
+//	grpc.NewModule(grpc.WithPanicHandler(func(_ context.Context, _ any) error {
+//	    return status.Error(codes.Unavailable, "temporarily unavailable")
+//	}))
+//
+// # Rate Limiting
+//
+// Pass a RateLimiter to reject calls over a rate with codes.ResourceExhausted.
+// The limiter runs right after logging and receives the full method name:
+//
+//	grpc.NewModule(grpc.WithRateLimit(grpc.NewTokenBucketLimiter(100, 20)))
+//
+// # Request Validation
+//
+// Incoming messages are checked against their buf.validate rules using
+// protovalidate. Invalid requests fail with codes.InvalidArgument and a
+// *validate.Violations detail listing each field violation. Pass validator
+// options with WithProtoValidation, or turn validation off with
+// WithoutProtoValidation:
+//
+//	grpc.NewModule(grpc.WithProtoValidation(protovalidate.WithFailFast()))
+//	grpc.NewModule(grpc.WithoutProtoValidation())
+//
+// # Payload Logging
+//
+// WithPayloadLogging logs request and response messages as JSON at the
+// given level, truncated to a byte limit. It is off by default. Use
+// WithPayloadRedactor to clear sensitive fields before they are logged:
+//
+//	grpc.NewModule(grpc.WithPayloadLogging(slog.LevelDebug, 1024))
+//
+// # Message Size Limits
+//
+// MaxRecvMsgSize and MaxSendMsgSize apply to every method. To relax them for
+// a single method, pass per-method limits keyed by full method name:
+//
+//	grpc.NewModule(grpc.WithMethodLimits(map[string]grpc.MsgLimits{
+//	    "/upload.v1.UploadService/Upload": {MaxRecvMsgSize: 64 << 20},
+//	}))
+//
+// Messages over the limit fail with codes.ResourceExhausted. Unlisted methods
+// keep the global limits.
+//
 // # Reflection
 //
 // gRPC reflection is enabled by default, allowing tools like grpcurl to
@@ -54,6 +100,25 @@
 //
 //	grpc.NewModule(grpc.WithReflection(false))
 //
+// Or hide internal services from reflection while still serving them:
+//
+//	grpc.NewModule(grpc.WithReflectionFilter(func(name string) bool {
+//	    return !strings.HasPrefix(name, "admin.")
+//	}))
+//
+// # Testing
+//
+// WithListener serves on a provided listener instead of binding a port, so
+// tests can use an in-memory bufconn listener:
+//
+//	lis := bufconn.Listen(1 << 20)
+//	app.Use(grpc.NewModule(grpc.WithListener(lis)))
+//	conn, _ := grpc.NewClient("passthrough:///bufconn",
+//	    grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+//	        return lis.DialContext(ctx)
+//	    }),
+//	    grpc.WithTransportCredentials(insecure.NewCredentials()))
+//
 // # Configuration
 //
 // Configuration can be provided via config file or module options:
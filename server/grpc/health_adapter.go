@@ -115,9 +115,10 @@ func (s *healthAdapter) checkAndUpdate(ctx context.Context) {
 	checker := s.manager.ReadinessChecker()
 	result := checker.Check(ctx)
 
-	// Map internal status to gRPC health status.
+	// Map internal status to gRPC health status. Degraded instances still
+	// serve, matching the 200 OK of the HTTP readiness probe.
 	var newStatus healthpb.HealthCheckResponse_ServingStatus
-	if result.Status == gazhealth.StatusUp {
+	if result.Status == gazhealth.StatusUp || result.Status == gazhealth.StatusDegraded {
 		newStatus = healthpb.HealthCheckResponse_SERVING
 	} else {
 		newStatus = healthpb.HealthCheckResponse_NOT_SERVING
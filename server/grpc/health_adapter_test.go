@@ -289,6 +289,18 @@ func TestHealthAdapter_InitialUnknown(t *testing.T) {
 	assert.Equal(t, healthpb.HealthCheckResponse_UNKNOWN, adapter.lastStatus)
 }
 
+func TestHealthAdapter_DegradedIsServing(t *testing.T) {
+	manager := gazhealth.NewManager(gazhealth.WithDegradedThreshold(0.5))
+	manager.AddReadinessCheckWithOptions("cache", func(_ context.Context) error {
+		return errors.New("cache unavailable")
+	}, gazhealth.WithNonCritical())
+	require.Equal(t, gazhealth.StatusDegraded, manager.ReadinessChecker().Check(context.Background()).Status)
+
+	adapter := newHealthAdapter(manager, time.Second, slog.Default())
+	adapter.checkAndUpdate(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, adapter.lastStatus)
+}
+
 func TestHealthAdapter_NoChecks_Healthy(t *testing.T) {
 	// Manager with no checks should report as healthy.
 	manager := gazhealth.NewManager()
@@ -72,13 +72,15 @@ type InterceptorBundle interface {
 }
 
 // collectInterceptors discovers all InterceptorBundles from the container,
-// sorts them by priority, and returns the chained interceptors.
-func collectInterceptors(container *di.Container, logger *slog.Logger) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+// merges them with the given built-in bundles, sorts them by priority,
+// and returns the chained interceptors. It fails if any bundle cannot be
+// resolved, since serving without it could skip auth or validation.
+func collectInterceptors(container *di.Container, logger *slog.Logger, builtin ...InterceptorBundle) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
 	bundles, err := di.ResolveAll[InterceptorBundle](container)
 	if err != nil {
-		logger.Warn("failed to resolve interceptor bundles", slog.Any("error", err))
-		return nil, nil
+		return nil, nil, fmt.Errorf("resolve interceptor bundles: %w", err)
 	}
+	bundles = append(bundles, builtin...)
 
 	// Sort by priority (lower = earlier in chain).
 	sort.Slice(bundles, func(i, j int) bool {
@@ -102,7 +104,7 @@ func collectInterceptors(container *di.Container, logger *slog.Logger) ([]grpc.U
 		)
 	}
 
-	return unary, stream
+	return unary, stream, nil
 }
 
 // InterceptorLogger adapts slog.Logger to the go-grpc-middleware logging.Logger interface.
@@ -128,14 +130,35 @@ func NewLoggingInterceptor(logger *slog.Logger) (grpc.UnaryServerInterceptor, gr
 		logging.StreamServerInterceptor(loggerAdapter, opts...)
 }
 
+// PanicHandler maps a recovered panic to the error returned to the client.
+// Return a status error (e.g. status.Error(codes.Unavailable, "...")) to
+// control the code the client sees.
+type PanicHandler func(ctx context.Context, recovered any) error
+
 // NewRecoveryInterceptor creates panic recovery interceptors for gRPC handlers.
 // When a panic occurs:
 //   - Full stack trace is logged to the provided logger
 //   - In dev mode, panic details are returned in the error message
 //   - In production mode, a generic "internal server error" is returned
 //
+// Both modes return codes.Internal. Use NewRecoveryInterceptorWithHandler
+// to choose the returned error.
+//
 // Returns both unary and stream server interceptors.
 func NewRecoveryInterceptor(logger *slog.Logger, devMode bool) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	return NewRecoveryInterceptorWithHandler(logger, devMode, nil)
+}
+
+// NewRecoveryInterceptorWithHandler creates panic recovery interceptors that
+// return the error produced by handler. The stack trace is always logged
+// first. A nil handler falls back to the NewRecoveryInterceptor behavior.
+//
+// Returns both unary and stream server interceptors.
+func NewRecoveryInterceptorWithHandler(
+	logger *slog.Logger,
+	devMode bool,
+	handler PanicHandler,
+) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
 	recoveryHandler := recovery.WithRecoveryHandlerContext(
 		func(ctx context.Context, p any) error {
 			// Log full stack trace.
@@ -144,6 +167,10 @@ func NewRecoveryInterceptor(logger *slog.Logger, devMode bool) (grpc.UnaryServer
 				slog.String("stack", string(debug.Stack())),
 			)
 
+			if handler != nil {
+				return handler(ctx, p)
+			}
+
 			// Return error details only in dev mode.
 			if devMode {
 				return status.Errorf(codes.Internal, "panic: %v", p)
@@ -157,9 +184,11 @@ func NewRecoveryInterceptor(logger *slog.Logger, devMode bool) (grpc.UnaryServer
 }
 
 // LoggingBundle is the built-in logging interceptor bundle.
-// It logs request start, completion, duration, and status.
+// It logs request start, completion, duration, and status, plus payloads
+// when created with NewLoggingBundleWithPayloads.
 type LoggingBundle struct {
-	logger *slog.Logger
+	logger   *slog.Logger
+	payloads *PayloadLogging
 }
 
 // NewLoggingBundle creates a new logging interceptor bundle.
@@ -182,7 +211,21 @@ func (b *LoggingBundle) Priority() int {
 
 // Interceptors returns the logging interceptors.
 func (b *LoggingBundle) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
-	return NewLoggingInterceptor(b.logger)
+	unary, stream := NewLoggingInterceptor(b.logger)
+	if b.payloads == nil {
+		return unary, stream
+	}
+
+	payloadUnary, payloadStream := NewPayloadLoggingInterceptor(b.logger, *b.payloads)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return unary(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+				return payloadUnary(ctx, req, info, handler)
+			})
+		}, func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return stream(srv, ss, info, func(srv any, ss grpc.ServerStream) error {
+				return payloadStream(srv, ss, info, handler)
+			})
+		}
 }
 
 // AuthFunc is the authentication function type.
@@ -250,9 +293,11 @@ func (l AlwaysPassLimiter) Limit(_ context.Context) error {
 }
 
 // RateLimitBundle is the built-in rate limiting interceptor bundle.
-// It uses the registered Limiter to control request rates.
+// It uses the registered Limiter, or a RateLimiter set via WithRateLimit,
+// to control request rates.
 type RateLimitBundle struct {
-	limiter Limiter
+	limiter     Limiter
+	rateLimiter RateLimiter
 }
 
 // NewRateLimitBundle creates a new rate limit interceptor bundle.
@@ -275,6 +320,9 @@ func (b *RateLimitBundle) Priority() int {
 
 // Interceptors returns the rate limit interceptors.
 func (b *RateLimitBundle) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	if b.rateLimiter != nil {
+		return NewRateLimitInterceptor(b.rateLimiter)
+	}
 	return ratelimit.UnaryServerInterceptor(b.limiter),
 		ratelimit.StreamServerInterceptor(b.limiter)
 }
@@ -282,8 +330,9 @@ func (b *RateLimitBundle) Interceptors() (grpc.UnaryServerInterceptor, grpc.Stre
 // RecoveryBundle is the built-in panic recovery interceptor bundle.
 // It catches panics and returns appropriate error responses.
 type RecoveryBundle struct {
-	logger  *slog.Logger
-	devMode bool
+	logger       *slog.Logger
+	devMode      bool
+	panicHandler PanicHandler
 }
 
 // NewRecoveryBundle creates a new recovery interceptor bundle.
@@ -294,6 +343,14 @@ func NewRecoveryBundle(logger *slog.Logger, devMode bool) *RecoveryBundle {
 	return &RecoveryBundle{logger: logger, devMode: devMode}
 }
 
+// NewRecoveryBundleWithHandler creates a recovery interceptor bundle that maps
+// panics to errors using handler.
+func NewRecoveryBundleWithHandler(logger *slog.Logger, devMode bool, handler PanicHandler) *RecoveryBundle {
+	b := NewRecoveryBundle(logger, devMode)
+	b.panicHandler = handler
+	return b
+}
+
 // Name returns the bundle identifier.
 func (b *RecoveryBundle) Name() string {
 	return "recovery"
@@ -306,7 +363,7 @@ func (b *RecoveryBundle) Priority() int {
 
 // Interceptors returns the recovery interceptors.
 func (b *RecoveryBundle) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
-	return NewRecoveryInterceptor(b.logger, b.devMode)
+	return NewRecoveryInterceptorWithHandler(b.logger, b.devMode, b.panicHandler)
 }
 
 // ValidationBundle is the built-in protovalidate interceptor bundle.
@@ -316,9 +373,10 @@ type ValidationBundle struct {
 }
 
 // NewValidationBundle creates a new validation interceptor bundle.
+// Options are passed to protovalidate.New.
 // Returns an error if the validator cannot be created.
-func NewValidationBundle() (*ValidationBundle, error) {
-	v, err := protovalidate.New()
+func NewValidationBundle(opts ...protovalidate.ValidatorOption) (*ValidationBundle, error) {
+	v, err := protovalidate.New(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("create protovalidate validator: %w", err)
 	}
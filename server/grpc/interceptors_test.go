@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"testing"
@@ -52,6 +53,65 @@ func TestNewRecoveryInterceptor(t *testing.T) {
 		require.Equal(t, codes.Internal, st.Code())
 		require.Contains(t, st.Message(), "test panic in dev mode")
 	})
+
+	t.Run("custom panic handler sets status", func(t *testing.T) {
+		var recovered any
+		panicHandler := func(_ context.Context, p any) error {
+			recovered = p
+			return status.Error(codes.Unavailable, "try again later")
+		}
+		unary, stream := NewRecoveryInterceptorWithHandler(logger, true, panicHandler)
+
+		handler := func(_ context.Context, _ any) (any, error) {
+			panic("custom panic")
+		}
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.Unavailable, st.Code())
+		require.Equal(t, "try again later", st.Message())
+		require.Equal(t, "custom panic", recovered)
+
+		err = stream(nil, &fakeServerStream{}, &grpc.StreamServerInfo{}, func(_ any, _ grpc.ServerStream) error {
+			panic("stream panic")
+		})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("nil panic handler keeps default", func(t *testing.T) {
+		unary, _ := NewRecoveryInterceptorWithHandler(logger, false, nil)
+
+		handler := func(_ context.Context, _ any) (any, error) {
+			panic("test panic")
+		}
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.Internal, st.Code())
+		require.Equal(t, "internal server error", st.Message())
+	})
+}
+
+func TestProvideRecoveryBundle_WithPanicHandler(t *testing.T) {
+	c := di.New()
+	require.NoError(t, gaz.For[Config](c).Instance(DefaultConfig()))
+
+	mc := &moduleConfig{}
+	WithPanicHandler(func(_ context.Context, _ any) error {
+		return status.Error(codes.Aborted, "aborted")
+	})(mc)
+	require.NoError(t, provideRecoveryBundle(mc.panicHandler)(c))
+
+	bundle, err := gaz.Resolve[*RecoveryBundle](c)
+	require.NoError(t, err)
+
+	unary, _ := bundle.Interceptors()
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{}, func(_ context.Context, _ any) (any, error) {
+		panic("boom")
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
 }
 
 func TestInterceptorLogger(t *testing.T) {
@@ -133,7 +193,8 @@ func (s *InterceptorBundleTestSuite) TestCollectInterceptorsOrdering() {
 		priority: 500,
 	})
 
-	unary, stream := collectInterceptors(container, logger)
+	unary, stream, err := collectInterceptors(container, logger)
+	s.Require().NoError(err)
 
 	// Should have 4 interceptors (logging, validation, custom, recovery).
 	s.Len(unary, 4)
@@ -144,7 +205,8 @@ func (s *InterceptorBundleTestSuite) TestCollectInterceptorsEmptyContainer() {
 	logger := slog.Default()
 	container := di.New()
 
-	unary, stream := collectInterceptors(container, logger)
+	unary, stream, err := collectInterceptors(container, logger)
+	s.Require().NoError(err)
 
 	// No interceptors registered.
 	s.Empty(unary)
@@ -163,7 +225,8 @@ func (s *InterceptorBundleTestSuite) TestCollectInterceptorsNilInterceptor() {
 		streamOnly: false,
 	})
 
-	unary, stream := collectInterceptors(container, logger)
+	unary, stream, err := collectInterceptors(container, logger)
+	s.Require().NoError(err)
 
 	// Should have 1 unary, 0 stream.
 	s.Len(unary, 1)
@@ -195,7 +258,8 @@ func (s *InterceptorBundleTestSuite) TestCustomInterceptorPriorityBetweenBuiltin
 	}
 	_ = di.For[*mockInterceptorBundle](container).Instance(customBundle)
 
-	unary, _ := collectInterceptors(container, logger)
+	unary, _, err := collectInterceptors(container, logger)
+	s.Require().NoError(err)
 
 	// Verify ordering: logging (0) < validation (100) < custom (500) < recovery (1000).
 	s.Require().Len(unary, 4)
@@ -205,6 +269,23 @@ func (s *InterceptorBundleTestSuite) TestCustomInterceptorPriorityBetweenBuiltin
 	// Since collectInterceptors sorts by priority, the first should be logging.
 }
 
+func (s *InterceptorBundleTestSuite) TestCollectInterceptorsResolveError() {
+	logger := slog.Default()
+	container := di.New()
+
+	_ = di.For[*mockInterceptorBundle](container).Provider(func(*di.Container) (*mockInterceptorBundle, error) {
+		return nil, errors.New("bundle unavailable")
+	})
+
+	unary, stream, err := collectInterceptors(container, logger)
+	s.Require().ErrorContains(err, "bundle unavailable")
+	s.Nil(unary)
+	s.Nil(stream)
+
+	_, err = NewServer(DefaultConfig(), logger, container, nil)
+	s.Require().ErrorContains(err, "bundle unavailable")
+}
+
 // mockInterceptorBundle is a test double for InterceptorBundle.
 type mockInterceptorBundle struct {
 	name        string
@@ -340,7 +421,7 @@ func (s *InterceptorBundleTestSuite) TestProvideRateLimitBundle_WithoutLimiter()
 	c := di.New()
 
 	// No Limiter registered - should use AlwaysPassLimiter.
-	err := provideRateLimitBundle(c)
+	err := provideRateLimitBundle(nil)(c)
 	s.Require().NoError(err)
 
 	// RateLimitBundle should be registered.
@@ -359,7 +440,7 @@ func (s *InterceptorBundleTestSuite) TestProvideRateLimitBundle_WithLimiter() {
 	s.Require().NoError(err)
 
 	// Run provider.
-	err = provideRateLimitBundle(c)
+	err = provideRateLimitBundle(nil)(c)
 	s.Require().NoError(err)
 
 	// RateLimitBundle should be registered.
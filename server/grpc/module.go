@@ -3,12 +3,63 @@ package grpc
 import (
 	"fmt"
 	"log/slog"
+	"net"
 
+	"buf.build/go/protovalidate"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/petabytecl/gaz"
 )
 
+// ModuleOption configures the gRPC module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	methodLimits map[string]MsgLimits
+	rateLimiter  RateLimiter
+	panicHandler PanicHandler
+
+	reflectionFilter ReflectionFilter
+
+	noProtoValidation bool
+	validatorOpts     []protovalidate.ValidatorOption
+
+	payloadLogging  *PayloadLogging
+	payloadRedactor PayloadRedactor
+
+	listener net.Listener
+}
+
+// WithListener makes the server accept connections on ln instead of binding
+// Config.Port, e.g. a bufconn listener for port-free tests. The server closes
+// ln when it stops.
+//
+// Example:
+//
+//	lis := bufconn.Listen(1 << 20)
+//	app.Use(grpc.NewModule(grpc.WithListener(lis)))
+func WithListener(ln net.Listener) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.listener = ln
+	}
+}
+
+// WithPanicHandler sets the function that maps recovered panics to the error
+// returned to clients. The stack trace is still logged. Without it, panics
+// return codes.Internal.
+//
+// Example:
+//
+//	grpc.NewModule(grpc.WithPanicHandler(func(_ context.Context, _ any) error {
+//	    return status.Error(codes.Unavailable, "temporarily unavailable")
+//	}))
+func WithPanicHandler(handler PanicHandler) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.panicHandler = handler
+	}
+}
+
 // resolveLogger attempts to resolve a logger from the container, falling back to slog.Default().
 func resolveLogger(c *gaz.Container) *slog.Logger {
 	if resolved, err := gaz.Resolve[*slog.Logger](c); err == nil {
@@ -39,37 +90,36 @@ func provideConfig(defaultCfg Config) func(*gaz.Container) error {
 }
 
 // provideLoggingBundle creates a LoggingBundle provider function.
-func provideLoggingBundle(c *gaz.Container) error {
-	if err := gaz.For[*LoggingBundle](c).Provider(func(c *gaz.Container) (*LoggingBundle, error) {
-		return NewLoggingBundle(resolveLogger(c)), nil
-	}); err != nil {
-		return fmt.Errorf("register logging bundle: %w", err)
+// A nil payloads config leaves payload logging off.
+func provideLoggingBundle(payloads *PayloadLogging) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if err := gaz.For[*LoggingBundle](c).Provider(func(c *gaz.Container) (*LoggingBundle, error) {
+			if payloads == nil {
+				return NewLoggingBundle(resolveLogger(c)), nil
+			}
+			return NewLoggingBundleWithPayloads(resolveLogger(c), *payloads), nil
+		}); err != nil {
+			return fmt.Errorf("register logging bundle: %w", err)
+		}
+		return nil
 	}
-	return nil
 }
 
 // provideRecoveryBundle creates a RecoveryBundle provider function.
-func provideRecoveryBundle(c *gaz.Container) error {
-	if err := gaz.For[*RecoveryBundle](c).Provider(func(c *gaz.Container) (*RecoveryBundle, error) {
-		cfg, err := gaz.Resolve[Config](c)
-		if err != nil {
-			return nil, fmt.Errorf("resolve grpc config: %w", err)
+// A nil handler keeps the default codes.Internal response.
+func provideRecoveryBundle(handler PanicHandler) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if err := gaz.For[*RecoveryBundle](c).Provider(func(c *gaz.Container) (*RecoveryBundle, error) {
+			cfg, err := gaz.Resolve[Config](c)
+			if err != nil {
+				return nil, fmt.Errorf("resolve grpc config: %w", err)
+			}
+			return NewRecoveryBundleWithHandler(resolveLogger(c), cfg.DevMode, handler), nil
+		}); err != nil {
+			return fmt.Errorf("register recovery bundle: %w", err)
 		}
-		return NewRecoveryBundle(resolveLogger(c), cfg.DevMode), nil
-	}); err != nil {
-		return fmt.Errorf("register recovery bundle: %w", err)
-	}
-	return nil
-}
-
-// provideValidationBundle creates a ValidationBundle provider function.
-func provideValidationBundle(c *gaz.Container) error {
-	if err := gaz.For[*ValidationBundle](c).Provider(func(_ *gaz.Container) (*ValidationBundle, error) {
-		return NewValidationBundle()
-	}); err != nil {
-		return fmt.Errorf("register validation bundle: %w", err)
+		return nil
 	}
-	return nil
 }
 
 // provideAuthBundle creates an AuthBundle provider function.
@@ -96,9 +146,26 @@ func provideAuthBundle(c *gaz.Container) error {
 }
 
 // provideRateLimitBundle creates a RateLimitBundle provider function.
-// If a Limiter is registered in DI, it uses that limiter.
-// Otherwise, it registers a bundle with AlwaysPassLimiter (allows all requests).
-func provideRateLimitBundle(c *gaz.Container) error {
+// A RateLimiter passed via WithRateLimit takes precedence. Otherwise, if a
+// Limiter is registered in DI, it uses that limiter; if neither is set, it
+// registers a bundle with AlwaysPassLimiter (allows all requests).
+func provideRateLimitBundle(rateLimiter RateLimiter) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if rateLimiter == nil {
+			return provideLimiterBundle(c)
+		}
+		if err := gaz.For[*RateLimitBundle](c).Provider(func(_ *gaz.Container) (*RateLimitBundle, error) {
+			return NewMethodRateLimitBundle(rateLimiter), nil
+		}); err != nil {
+			return fmt.Errorf("register ratelimit bundle: %w", err)
+		}
+		return nil
+	}
+}
+
+// provideLimiterBundle registers a RateLimitBundle using the Limiter from DI,
+// falling back to AlwaysPassLimiter.
+func provideLimiterBundle(c *gaz.Container) error {
 	var limiter Limiter
 	if gaz.Has[Limiter](c) {
 		resolved, resolveErr := gaz.Resolve[Limiter](c)
@@ -117,26 +184,36 @@ func provideRateLimitBundle(c *gaz.Container) error {
 	return nil
 }
 
-// provideServer creates a Server provider function.
-func provideServer(c *gaz.Container) error {
-	if err := gaz.For[*Server](c).
-		Eager().
-		Provider(func(c *gaz.Container) (*Server, error) {
-			cfg, err := gaz.Resolve[Config](c)
-			if err != nil {
-				return nil, fmt.Errorf("resolve grpc config: %w", err)
-			}
+// provideServer creates a Server provider function. A non-nil listener
+// replaces binding Config.Port (see WithListener).
+func provideServer(listener net.Listener) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if err := gaz.For[*Server](c).
+			Eager().
+			Provider(func(c *gaz.Container) (*Server, error) {
+				cfg, err := gaz.Resolve[Config](c)
+				if err != nil {
+					return nil, fmt.Errorf("resolve grpc config: %w", err)
+				}
 
-			var tp *sdktrace.TracerProvider
-			if resolved, resolveErr := gaz.Resolve[*sdktrace.TracerProvider](c); resolveErr == nil {
-				tp = resolved
-			}
+				var tp *sdktrace.TracerProvider
+				if resolved, resolveErr := gaz.Resolve[*sdktrace.TracerProvider](c); resolveErr == nil {
+					tp = resolved
+				}
 
-			return NewServer(cfg, resolveLogger(c), c, tp), nil
-		}); err != nil {
-		return fmt.Errorf("register server: %w", err)
+				server, err := NewServer(cfg, resolveLogger(c), c, tp)
+				if err != nil {
+					return nil, fmt.Errorf("create grpc server: %w", err)
+				}
+				if listener != nil {
+					server.SetListener(listener)
+				}
+				return server, nil
+			}); err != nil {
+			return fmt.Errorf("register server: %w", err)
+		}
+		return nil
 	}
-	return nil
 }
 
 // NewModule creates a gRPC module.
@@ -144,10 +221,10 @@ func provideServer(c *gaz.Container) error {
 //
 // Components registered:
 //   - grpc.Config (loaded from flags/config)
-//   - *grpc.LoggingBundle (logging interceptor)
-//   - *grpc.RateLimitBundle (rate limit interceptor, uses AlwaysPassLimiter unless Limiter registered)
+//   - *grpc.LoggingBundle (logging interceptor, payloads only with WithPayloadLogging)
+//   - *grpc.RateLimitBundle (rate limit interceptor, uses WithRateLimit, a registered Limiter, or AlwaysPassLimiter)
 //   - *grpc.AuthBundle (auth interceptor, only if AuthFunc registered)
-//   - *grpc.ValidationBundle (protovalidate interceptor)
+//   - *grpc.ValidationBundle (protovalidate interceptor, unless WithoutProtoValidation)
 //   - *grpc.RecoveryBundle (panic recovery interceptor)
 //   - *grpc.Server (eager, starts on app start)
 //
@@ -160,6 +237,12 @@ func provideServer(c *gaz.Container) error {
 //	app := gaz.New()
 //	app.Use(grpc.NewModule())
 //
+// Allowing one streaming method larger frames than the rest:
+//
+//	app.Use(grpc.NewModule(grpc.WithMethodLimits(map[string]grpc.MsgLimits{
+//	    "/upload.v1.UploadService/Upload": {MaxRecvMsgSize: 64 << 20},
+//	})))
+//
 // Adding custom interceptors:
 //
 //	// Register your interceptor bundle
@@ -172,17 +255,30 @@ func provideServer(c *gaz.Container) error {
 //	func (m *MyInterceptor) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
 //	    return myUnaryInterceptor, myStreamInterceptor
 //	}
-func NewModule() gaz.Module {
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
 	defaultCfg := DefaultConfig()
+	defaultCfg.MethodLimits = mc.methodLimits
+	defaultCfg.ReflectionFilter = mc.reflectionFilter
+	if mc.payloadLogging != nil {
+		mc.payloadLogging.Redact = mc.payloadRedactor
+	}
 
-	return gaz.NewModule("grpc").
+	b := gaz.NewModule("grpc").
 		Flags(defaultCfg.Flags).
 		Provide(provideConfig(defaultCfg)).
-		Provide(provideLoggingBundle).
-		Provide(provideRateLimitBundle).
-		Provide(provideAuthBundle).
-		Provide(provideValidationBundle).
-		Provide(provideRecoveryBundle).
-		Provide(provideServer).
+		Provide(provideLoggingBundle(mc.payloadLogging)).
+		Provide(provideRateLimitBundle(mc.rateLimiter)).
+		Provide(provideAuthBundle)
+	if !mc.noProtoValidation {
+		b.Provide(provideValidationBundle(mc.validatorOpts))
+	}
+	return b.
+		Provide(provideRecoveryBundle(mc.panicHandler)).
+		Provide(provideServer(mc.listener)).
 		Build()
 }
@@ -1,9 +1,17 @@
 package grpc
 
 import (
+	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/petabytecl/gaz"
 	"github.com/petabytecl/gaz/di"
@@ -35,6 +43,48 @@ func TestNewModule(t *testing.T) {
 	})
 }
 
+// healthRegistrar registers grpc-go's health service under a fixed status.
+type healthRegistrar struct{}
+
+func (healthRegistrar) RegisterService(server grpc.ServiceRegistrar) {
+	hs := grpchealth.NewServer()
+	hs.SetServingStatus("bufconn", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, hs)
+}
+
+func TestNewModuleWithListener(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+
+	app := gaz.New()
+	require.NoError(t, NewModule(WithListener(lis)).Apply(app))
+	require.NoError(t, gaz.For[Registrar](app.Container()).Instance(healthRegistrar{}))
+	require.NoError(t, app.Build())
+
+	server, err := di.Resolve[*Server](app.Container())
+	require.NoError(t, err)
+	require.NoError(t, server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: "bufconn"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+}
+
 func TestConfigSetDefaults(t *testing.T) {
 	cfg := Config{}
 	cfg.SetDefaults()
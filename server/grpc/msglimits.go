@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PriorityMsgLimits is the priority for the per-method message size interceptor
+// (after rate limiting, before auth).
+const PriorityMsgLimits = 30
+
+// MsgLimits overrides the global message size limits for a single method.
+// A zero value for either field falls back to the corresponding global limit
+// (Config.MaxRecvMsgSize or Config.MaxSendMsgSize).
+type MsgLimits struct {
+	// MaxRecvMsgSize is the maximum request message size for the method.
+	MaxRecvMsgSize int
+
+	// MaxSendMsgSize is the maximum response message size for the method.
+	MaxSendMsgSize int
+}
+
+// WithMethodLimits sets per-method message size limits, keyed by full method
+// name (e.g. "/upload.v1.UploadService/Upload").
+//
+// The transport-level limits are raised to the largest configured value so
+// the listed methods can exceed the global defaults; an interceptor then
+// enforces the global defaults for every other method. Requests or responses
+// over the limit fail with codes.ResourceExhausted.
+func WithMethodLimits(limits map[string]MsgLimits) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.methodLimits = limits
+	}
+}
+
+// validateMethodLimits checks that method names are full method names and
+// that no limit is negative.
+func validateMethodLimits(limits map[string]MsgLimits) error {
+	for method, l := range limits {
+		if !strings.HasPrefix(method, "/") {
+			return fmt.Errorf("grpc: invalid method limit key %q: must be a full method name like /pkg.Service/Method", method)
+		}
+		if l.MaxRecvMsgSize < 0 || l.MaxSendMsgSize < 0 {
+			return fmt.Errorf("grpc: invalid method limit for %s: sizes must not be negative", method)
+		}
+	}
+	return nil
+}
+
+// transportMsgLimits returns the server-wide receive and send limits: the
+// global limits raised to the largest per-method override.
+func transportMsgLimits(cfg Config) (int, int) {
+	recv, send := cfg.MaxRecvMsgSize, cfg.MaxSendMsgSize
+	for _, l := range cfg.MethodLimits {
+		recv = max(recv, l.MaxRecvMsgSize)
+		send = max(send, l.MaxSendMsgSize)
+	}
+	return recv, send
+}
+
+// MsgLimitsBundle is the built-in per-method message size interceptor bundle.
+// It is added by the server when Config.MethodLimits is not empty.
+type MsgLimitsBundle struct {
+	defaults MsgLimits
+	methods  map[string]MsgLimits
+}
+
+// NewMsgLimitsBundle creates a message size interceptor bundle from cfg.
+func NewMsgLimitsBundle(cfg Config) *MsgLimitsBundle {
+	return &MsgLimitsBundle{
+		defaults: MsgLimits{MaxRecvMsgSize: cfg.MaxRecvMsgSize, MaxSendMsgSize: cfg.MaxSendMsgSize},
+		methods:  cfg.MethodLimits,
+	}
+}
+
+// Name returns the bundle identifier.
+func (b *MsgLimitsBundle) Name() string {
+	return "msglimits"
+}
+
+// Priority returns the message size limit priority.
+func (b *MsgLimitsBundle) Priority() int {
+	return PriorityMsgLimits
+}
+
+// Interceptors returns the message size limit interceptors.
+func (b *MsgLimitsBundle) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		limits := b.limitsFor(info.FullMethod)
+		if err := checkMsgSize(req, limits.MaxRecvMsgSize, "received"); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if sizeErr := checkMsgSize(resp, limits.MaxSendMsgSize, "sent"); sizeErr != nil {
+			return nil, sizeErr
+		}
+		return resp, nil
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitedServerStream{ServerStream: ss, limits: b.limitsFor(info.FullMethod)})
+	}
+
+	return unary, stream
+}
+
+// limitsFor returns the effective limits for a method.
+func (b *MsgLimitsBundle) limitsFor(method string) MsgLimits {
+	limits := b.defaults
+	if l, ok := b.methods[method]; ok {
+		if l.MaxRecvMsgSize > 0 {
+			limits.MaxRecvMsgSize = l.MaxRecvMsgSize
+		}
+		if l.MaxSendMsgSize > 0 {
+			limits.MaxSendMsgSize = l.MaxSendMsgSize
+		}
+	}
+	return limits
+}
+
+// limitedServerStream enforces message size limits on each streamed message.
+type limitedServerStream struct {
+	grpc.ServerStream
+	limits MsgLimits
+}
+
+// RecvMsg receives a message and rejects it if it exceeds the receive limit.
+func (s *limitedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkMsgSize(m, s.limits.MaxRecvMsgSize, "received")
+}
+
+// SendMsg rejects a message that exceeds the send limit before sending it.
+func (s *limitedServerStream) SendMsg(m any) error {
+	if err := checkMsgSize(m, s.limits.MaxSendMsgSize, "sent"); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// checkMsgSize returns a ResourceExhausted status if m is a proto message
+// larger than limit. Non-proto messages are not checked.
+func checkMsgSize(m any, limit int, direction string) error {
+	msg, ok := m.(proto.Message)
+	if !ok || limit <= 0 {
+		return nil
+	}
+	if size := proto.Size(msg); size > limit {
+		return status.Errorf(codes.ResourceExhausted, "grpc: %s message larger than max (%d vs. %d)", direction, size, limit)
+	}
+	return nil
+}
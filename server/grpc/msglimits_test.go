@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	uploadMethod = "/upload.v1.UploadService/Upload"
+	echoMethod   = "/echo.v1.EchoService/Echo"
+)
+
+func msgLimitsTestConfig() Config {
+	cfg := DefaultConfig()
+	cfg.MaxRecvMsgSize = 64
+	cfg.MaxSendMsgSize = 64
+	cfg.MethodLimits = map[string]MsgLimits{
+		uploadMethod: {MaxRecvMsgSize: 1024},
+	}
+	return cfg
+}
+
+func echoHandler(_ context.Context, req any) (any, error) {
+	return req, nil
+}
+
+func TestMsgLimitsBundle_Unary(t *testing.T) {
+	unary, _ := NewMsgLimitsBundle(msgLimitsTestConfig()).Interceptors()
+
+	tests := []struct {
+		name   string
+		method string
+		size   int
+		code   codes.Code
+	}{
+		{name: "listed method within limit", method: uploadMethod, size: 512, code: codes.OK},
+		{name: "listed method over limit", method: uploadMethod, size: 2048, code: codes.ResourceExhausted},
+		{name: "unlisted method within global", method: echoMethod, size: 16, code: codes.OK},
+		{name: "unlisted method over global", method: echoMethod, size: 512, code: codes.ResourceExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := wrapperspb.Bytes(make([]byte, tt.size))
+			handler := func(_ context.Context, _ any) (any, error) {
+				return wrapperspb.Bool(true), nil
+			}
+
+			_, err := unary(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestMsgLimitsBundle_UnaryResponse(t *testing.T) {
+	unary, _ := NewMsgLimitsBundle(msgLimitsTestConfig()).Interceptors()
+
+	// Upload only relaxes the receive limit, so the global send limit still applies.
+	req := wrapperspb.Bytes(make([]byte, 512))
+	_, err := unary(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: uploadMethod}, echoHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	recv *wrapperspb.BytesValue
+	sent int
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeServerStream) RecvMsg(m any) error {
+	m.(*wrapperspb.BytesValue).Value = f.recv.GetValue()
+	return nil
+}
+
+func (f *fakeServerStream) SendMsg(_ any) error {
+	f.sent++
+	return nil
+}
+
+func TestMsgLimitsBundle_Stream(t *testing.T) {
+	_, stream := NewMsgLimitsBundle(msgLimitsTestConfig()).Interceptors()
+
+	run := func(method string, size int) (*fakeServerStream, error) {
+		ss := &fakeServerStream{recv: wrapperspb.Bytes(make([]byte, size))}
+		err := stream(nil, ss, &grpc.StreamServerInfo{FullMethod: method}, func(_ any, s grpc.ServerStream) error {
+			var msg wrapperspb.BytesValue
+			if err := s.RecvMsg(&msg); err != nil {
+				return err
+			}
+			return s.SendMsg(wrapperspb.Bool(true))
+		})
+		return ss, err
+	}
+
+	ss, err := run(uploadMethod, 512)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ss.sent)
+
+	ss, err = run(uploadMethod, 2048)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 0, ss.sent)
+
+	_, err = run(echoMethod, 512)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestTransportMsgLimits(t *testing.T) {
+	recv, send := transportMsgLimits(msgLimitsTestConfig())
+	assert.Equal(t, 1024, recv)
+	assert.Equal(t, 64, send)
+}
+
+func TestConfigValidate_MethodLimits(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MethodLimits = map[string]MsgLimits{"upload.v1.UploadService/Upload": {MaxRecvMsgSize: 1}}
+	require.ErrorContains(t, cfg.Validate(), "full method name")
+
+	cfg.MethodLimits = map[string]MsgLimits{uploadMethod: {MaxRecvMsgSize: -1}}
+	require.ErrorContains(t, cfg.Validate(), "must not be negative")
+
+	cfg.MethodLimits = map[string]MsgLimits{uploadMethod: {MaxRecvMsgSize: 1024}}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestWithMethodLimits(t *testing.T) {
+	mc := &moduleConfig{}
+	limits := map[string]MsgLimits{uploadMethod: {MaxRecvMsgSize: 1024}}
+
+	WithMethodLimits(limits)(mc)
+
+	assert.Equal(t, limits, mc.methodLimits)
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// PayloadRedactor clears sensitive fields before a payload is logged.
+// It receives a copy of the message, so it may modify msg in place.
+type PayloadRedactor func(fullMethod string, msg proto.Message)
+
+// PayloadLogging configures request and response payload logging.
+type PayloadLogging struct {
+	// Level is the slog level payloads are logged at.
+	Level slog.Level
+
+	// MaxBytes truncates each logged payload to at most this many bytes,
+	// backing off so a multi-byte character is never split.
+	// Zero or negative means no limit.
+	MaxBytes int
+
+	// Redact is applied to a copy of each proto payload before logging.
+	Redact PayloadRedactor
+}
+
+// WithPayloadLogging makes the logging interceptor log request and response
+// payloads as JSON at level, truncated to maxBytes. Payloads may contain
+// personal data and add encoding cost per message, so they are not logged
+// unless this option is given.
+//
+// Example:
+//
+//	app.Use(grpc.NewModule(grpc.WithPayloadLogging(slog.LevelDebug, 1024)))
+func WithPayloadLogging(level slog.Level, maxBytes int) ModuleOption {
+	return func(mc *moduleConfig) {
+		if mc.payloadLogging == nil {
+			mc.payloadLogging = &PayloadLogging{}
+		}
+		mc.payloadLogging.Level = level
+		mc.payloadLogging.MaxBytes = maxBytes
+	}
+}
+
+// WithPayloadRedactor sets the hook that clears sensitive fields from
+// payloads logged by WithPayloadLogging. It has no effect without
+// WithPayloadLogging.
+//
+// Example:
+//
+//	grpc.WithPayloadRedactor(func(_ string, msg proto.Message) {
+//	    if req, ok := msg.(*userv1.LoginRequest); ok {
+//	        req.Password = "[REDACTED]"
+//	    }
+//	})
+func WithPayloadRedactor(redact PayloadRedactor) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.payloadRedactor = redact
+	}
+}
+
+// NewLoggingBundleWithPayloads creates a logging interceptor bundle that also
+// logs request and response payloads as configured by payloads.
+func NewLoggingBundleWithPayloads(logger *slog.Logger, payloads PayloadLogging) *LoggingBundle {
+	b := NewLoggingBundle(logger)
+	b.payloads = &payloads
+	return b
+}
+
+// NewPayloadLoggingInterceptor creates interceptors that log each request
+// and response payload received or sent by a handler.
+//
+// Returns both unary and stream server interceptors.
+func NewPayloadLoggingInterceptor(logger *slog.Logger, cfg PayloadLogging) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	pl := &payloadLogger{logger: logger, cfg: cfg}
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		pl.log(ctx, info.FullMethod, "request received", "grpc.request.content", req)
+		resp, err := handler(ctx, req)
+		if err == nil {
+			pl.log(ctx, info.FullMethod, "response sent", "grpc.response.content", resp)
+		}
+		return resp, err
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &payloadServerStream{ServerStream: ss, pl: pl, method: info.FullMethod})
+	}
+
+	return unary, stream
+}
+
+// payloadLogger formats and logs individual payloads.
+type payloadLogger struct {
+	logger *slog.Logger
+	cfg    PayloadLogging
+}
+
+func (p *payloadLogger) log(ctx context.Context, method, msg, key string, payload any) {
+	if !p.logger.Enabled(ctx, p.cfg.Level) {
+		return
+	}
+
+	content := p.format(method, payload)
+	attrs := []slog.Attr{slog.String("grpc.method", method)}
+	if p.cfg.MaxBytes > 0 && len(content) > p.cfg.MaxBytes {
+		attrs = append(attrs, slog.Int(key+".size", len(content)))
+		content = truncateUTF8(content, p.cfg.MaxBytes)
+	}
+	attrs = append(attrs, slog.String(key, content))
+
+	p.logger.LogAttrs(ctx, p.cfg.Level, msg, attrs...)
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a rune.
+func truncateUTF8(s string, n int) string {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func (p *payloadLogger) format(method string, payload any) string {
+	msg, ok := payload.(proto.Message)
+	if !ok {
+		return fmt.Sprintf("%v", payload)
+	}
+	if p.cfg.Redact != nil {
+		msg = proto.Clone(msg)
+		p.cfg.Redact(method, msg)
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return fmt.Sprintf("<%T: marshal json: %v>", payload, err)
+	}
+	return string(b)
+}
+
+// payloadServerStream logs every message received or sent on a stream.
+type payloadServerStream struct {
+	grpc.ServerStream
+	pl     *payloadLogger
+	method string
+}
+
+func (s *payloadServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.pl.log(s.Context(), s.method, "request received", "grpc.request.content", m)
+	return nil
+}
+
+func (s *payloadServerStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.pl.log(s.Context(), s.method, "response sent", "grpc.response.content", m)
+	return nil
+}
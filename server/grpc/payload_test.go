@@ -0,0 +1,179 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+// logRecords decodes JSON log lines written to buf.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		records = append(records, rec)
+	}
+	return records
+}
+
+func findRecord(records []map[string]any, msg string) map[string]any {
+	for _, rec := range records {
+		if rec["msg"] == msg {
+			return rec
+		}
+	}
+	return nil
+}
+
+func stringHandler(value string) grpc.UnaryHandler {
+	return func(_ context.Context, _ any) (any, error) {
+		return wrapperspb.String(value), nil
+	}
+}
+
+func TestLoggingBundle_LogsPayloadsWhenEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	unary, _ := NewLoggingBundleWithPayloads(logger, PayloadLogging{Level: slog.LevelDebug}).Interceptors()
+
+	_, err := unary(context.Background(), wrapperspb.String("ping"), &grpc.UnaryServerInfo{FullMethod: echoMethod}, stringHandler("pong"))
+	require.NoError(t, err)
+
+	records := logRecords(t, &buf)
+	req := findRecord(records, "request received")
+	require.NotNil(t, req)
+	assert.Equal(t, "DEBUG", req["level"])
+	assert.Equal(t, echoMethod, req["grpc.method"])
+	assert.Equal(t, `"ping"`, req["grpc.request.content"])
+
+	resp := findRecord(records, "response sent")
+	require.NotNil(t, resp)
+	assert.Equal(t, `"pong"`, resp["grpc.response.content"])
+
+	assert.NotNil(t, findRecord(records, "finished call"), "call logging must still run")
+}
+
+func TestLoggingBundle_TruncatesPayloads(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	unary, _ := NewLoggingBundleWithPayloads(logger, PayloadLogging{Level: slog.LevelInfo, MaxBytes: 8}).Interceptors()
+
+	_, err := unary(context.Background(), wrapperspb.String(strings.Repeat("x", 100)), &grpc.UnaryServerInfo{FullMethod: echoMethod}, okHandler)
+	require.NoError(t, err)
+
+	req := findRecord(logRecords(t, &buf), "request received")
+	require.NotNil(t, req)
+	assert.Equal(t, `"xxxxxxx`, req["grpc.request.content"])
+	assert.InDelta(t, 102, req["grpc.request.content.size"], 0)
+}
+
+func TestLoggingBundle_TruncatesOnRuneBoundary(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	unary, _ := NewLoggingBundleWithPayloads(logger, PayloadLogging{Level: slog.LevelInfo, MaxBytes: 8}).Interceptors()
+
+	// `"` plus three 2-byte runes is 7 bytes; the 8-byte cut lands inside the fourth.
+	_, err := unary(context.Background(), wrapperspb.String(strings.Repeat("é", 10)), &grpc.UnaryServerInfo{FullMethod: echoMethod}, okHandler)
+	require.NoError(t, err)
+
+	req := findRecord(logRecords(t, &buf), "request received")
+	require.NotNil(t, req)
+	assert.Equal(t, `"ééé`, req["grpc.request.content"])
+}
+
+func TestLoggingBundle_RedactsPayloads(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	var redactedMethod string
+	unary, _ := NewLoggingBundleWithPayloads(logger, PayloadLogging{
+		Level: slog.LevelInfo,
+		Redact: func(method string, msg proto.Message) {
+			redactedMethod = method
+			msg.(*wrapperspb.StringValue).Value = "[REDACTED]"
+		},
+	}).Interceptors()
+
+	req := wrapperspb.String("hunter2")
+	_, err := unary(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: echoMethod},
+		func(_ context.Context, r any) (any, error) {
+			assert.Equal(t, "hunter2", r.(*wrapperspb.StringValue).GetValue(), "handler must see the original request")
+			return "ok", nil
+		})
+	require.NoError(t, err)
+
+	rec := findRecord(logRecords(t, &buf), "request received")
+	require.NotNil(t, rec)
+	assert.Equal(t, `"[REDACTED]"`, rec["grpc.request.content"])
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.Equal(t, echoMethod, redactedMethod)
+}
+
+func TestLoggingBundle_StreamLogsPayloads(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	_, stream := NewLoggingBundleWithPayloads(logger, PayloadLogging{Level: slog.LevelInfo}).Interceptors()
+
+	ss := &protoStream{recv: wrapperspb.String("chunk")}
+	err := stream(nil, ss, &grpc.StreamServerInfo{FullMethod: uploadMethod}, func(_ any, s grpc.ServerStream) error {
+		return s.RecvMsg(&wrapperspb.StringValue{})
+	})
+	require.NoError(t, err)
+
+	rec := findRecord(logRecords(t, &buf), "request received")
+	require.NotNil(t, rec)
+	assert.Equal(t, `"chunk"`, rec["grpc.request.content"])
+}
+
+func TestLoggingBundle_NoPayloadsByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	unary, _ := NewLoggingBundle(logger).Interceptors()
+
+	_, err := unary(context.Background(), wrapperspb.String("secret"), &grpc.UnaryServerInfo{FullMethod: echoMethod}, stringHandler("pong"))
+	require.NoError(t, err)
+
+	records := logRecords(t, &buf)
+	assert.Nil(t, findRecord(records, "request received"))
+	assert.Nil(t, findRecord(records, "response sent"))
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestNewModule_PayloadLoggingOption(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(
+		WithPayloadRedactor(func(_ string, _ proto.Message) {}),
+		WithPayloadLogging(slog.LevelDebug, 256),
+	).Apply(app))
+	require.NoError(t, app.Build())
+
+	bundle, err := di.Resolve[*LoggingBundle](app.Container())
+	require.NoError(t, err)
+	require.NotNil(t, bundle.payloads)
+	assert.Equal(t, slog.LevelDebug, bundle.payloads.Level)
+	assert.Equal(t, 256, bundle.payloads.MaxBytes)
+	assert.NotNil(t, bundle.payloads.Redact)
+
+	app = gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+	bundle, err = di.Resolve[*LoggingBundle](app.Container())
+	require.NoError(t, err)
+	assert.Nil(t, bundle.payloads)
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter decides whether a call may proceed.
+// Unlike Limiter, it receives the full method name (e.g. "/pkg.Service/Method"),
+// so implementations can apply different rates per method.
+type RateLimiter interface {
+	// Allow reports whether the call to fullMethod may proceed.
+	Allow(ctx context.Context, fullMethod string) bool
+}
+
+// WithRateLimit installs limiter as the rate limiting interceptor, running
+// right after logging. Denied calls fail with codes.ResourceExhausted.
+// It takes precedence over a Limiter registered in DI.
+//
+// Example:
+//
+//	app.Use(grpc.NewModule(grpc.WithRateLimit(grpc.NewTokenBucketLimiter(100, 20))))
+func WithRateLimit(limiter RateLimiter) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.rateLimiter = limiter
+	}
+}
+
+// NewMethodRateLimitBundle creates a rate limit interceptor bundle backed by
+// a RateLimiter.
+func NewMethodRateLimitBundle(limiter RateLimiter) *RateLimitBundle {
+	return &RateLimitBundle{rateLimiter: limiter}
+}
+
+// NewRateLimitInterceptor creates interceptors that consult limiter with the
+// full method name and reject denied calls with codes.ResourceExhausted.
+//
+// Returns both unary and stream server interceptors.
+func NewRateLimitInterceptor(limiter RateLimiter) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !limiter.Allow(ctx, info.FullMethod) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%s: rate limit exceeded", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.Allow(ss.Context(), info.FullMethod) {
+			return status.Errorf(codes.ResourceExhausted, "%s: rate limit exceeded", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+
+	return unary, stream
+}
+
+// TokenBucketLimiter is a RateLimiter that shares one token bucket across all
+// methods. The bucket refills at rps tokens per second up to burst tokens;
+// each call consumes one token.
+type TokenBucketLimiter struct {
+	mu     sync.Mutex
+	rps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewTokenBucketLimiter creates a token bucket allowing rps calls per second
+// on average with bursts of up to burst calls. The bucket starts full.
+func NewTokenBucketLimiter(rps float64, burst int) *TokenBucketLimiter {
+	return newTokenBucketLimiter(rps, burst, time.Now)
+}
+
+// newTokenBucketLimiter creates a token bucket with an injectable clock.
+func newTokenBucketLimiter(rps float64, burst int, now func() time.Time) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rps:    rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now(),
+		now:    now,
+	}
+}
+
+// Allow reports whether a token is available and consumes it if so.
+func (l *TokenBucketLimiter) Allow(_ context.Context, _ string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rps)
+	l.last = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
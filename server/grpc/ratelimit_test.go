@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+// recordingLimiter records the methods it is consulted with.
+type recordingLimiter struct {
+	mu      sync.Mutex
+	allow   bool
+	methods []string
+}
+
+func (r *recordingLimiter) Allow(_ context.Context, fullMethod string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.methods = append(r.methods, fullMethod)
+	return r.allow
+}
+
+func okHandler(_ context.Context, _ any) (any, error) {
+	return "ok", nil
+}
+
+func TestTokenBucketLimiter_RejectsBeyondRate(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := newTokenBucketLimiter(1, 2, func() time.Time { return now })
+	unary, _ := NewRateLimitInterceptor(limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: echoMethod}
+
+	// Burst of 2 passes, third is rejected.
+	for range 2 {
+		_, err := unary(context.Background(), nil, info, okHandler)
+		require.NoError(t, err)
+	}
+	_, err := unary(context.Background(), nil, info, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// One second refills one token.
+	now = now.Add(time.Second)
+	_, err = unary(context.Background(), nil, info, okHandler)
+	require.NoError(t, err)
+	_, err = unary(context.Background(), nil, info, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestTokenBucketLimiter_CapsAtBurst(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := newTokenBucketLimiter(10, 1, func() time.Time { return now })
+
+	now = now.Add(time.Minute)
+	assert.True(t, limiter.Allow(context.Background(), echoMethod))
+	assert.False(t, limiter.Allow(context.Background(), echoMethod))
+}
+
+func TestRateLimitInterceptor_ConsultsMethodName(t *testing.T) {
+	limiter := &recordingLimiter{allow: true}
+	unary, stream := NewRateLimitInterceptor(limiter)
+
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: echoMethod}, okHandler)
+	require.NoError(t, err)
+
+	ss := &fakeServerStream{}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: uploadMethod}, func(_ any, _ grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{echoMethod, uploadMethod}, limiter.methods)
+}
+
+func TestRateLimitInterceptor_Denied(t *testing.T) {
+	limiter := &recordingLimiter{allow: false}
+	unary, stream := NewRateLimitInterceptor(limiter)
+
+	called := false
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: echoMethod},
+		func(_ context.Context, _ any) (any, error) {
+			called = true
+			return nil, nil
+		})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.False(t, called)
+
+	err = stream(nil, &fakeServerStream{}, &grpc.StreamServerInfo{FullMethod: uploadMethod}, func(_ any, _ grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.False(t, called)
+}
+
+func TestProvideRateLimitBundle_WithRateLimit(t *testing.T) {
+	c := di.New()
+	limiter := &recordingLimiter{allow: false}
+
+	// WithRateLimit takes precedence over a Limiter registered in DI.
+	require.NoError(t, gaz.For[Limiter](c).Instance(Limiter(AlwaysPassLimiter{})))
+
+	mc := &moduleConfig{}
+	WithRateLimit(limiter)(mc)
+	require.NoError(t, provideRateLimitBundle(mc.rateLimiter)(c))
+
+	bundle, err := gaz.Resolve[*RateLimitBundle](c)
+	require.NoError(t, err)
+	assert.Equal(t, PriorityRateLimit, bundle.Priority())
+
+	unary, _ := bundle.Interceptors()
+	_, err = unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: echoMethod}, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{echoMethod}, limiter.methods)
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	v1reflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	v1alphareflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// ReflectionFilter reports whether a service, identified by its full name
+// (e.g. "admin.v1.AdminService"), is listed by gRPC reflection.
+type ReflectionFilter func(serviceName string) bool
+
+// WithReflectionFilter limits the services gRPC reflection lists to those
+// for which filter returns true. Use it to hide internal or admin services
+// from grpcurl while keeping public ones discoverable. The filter has no
+// effect when reflection is disabled, and it also sees the health and
+// reflection services themselves.
+//
+// Filtered services are still served; the filter only controls what
+// reflection advertises.
+//
+// Example:
+//
+//	app.Use(grpc.NewModule(grpc.WithReflectionFilter(func(name string) bool {
+//	    return !strings.HasPrefix(name, "admin.")
+//	})))
+func WithReflectionFilter(filter ReflectionFilter) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.reflectionFilter = filter
+	}
+}
+
+// registerReflection registers the v1 and v1alpha reflection services,
+// advertising only the services allowed by filter. A nil filter lists all.
+func registerReflection(server *grpc.Server, filter ReflectionFilter) {
+	if filter == nil {
+		reflection.Register(server)
+		return
+	}
+	opts := reflection.ServerOptions{Services: filteredServices{server: server, allow: filter}}
+	v1alphareflectiongrpc.RegisterServerReflectionServer(server, reflection.NewServer(opts))
+	v1reflectiongrpc.RegisterServerReflectionServer(server, reflection.NewServerV1(opts))
+}
+
+// filteredServices is a reflection.ServiceInfoProvider that hides the
+// services rejected by allow.
+type filteredServices struct {
+	server *grpc.Server
+	allow  ReflectionFilter
+}
+
+// GetServiceInfo returns the server's services that pass the filter.
+func (f filteredServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	info := f.server.GetServiceInfo()
+	for name := range info {
+		if !f.allow(name) {
+			delete(info, name)
+		}
+	}
+	return info
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+
+	"github.com/petabytecl/gaz/di"
+)
+
+// listReflectedServices starts a server for cfg with public and admin
+// services registered and returns the names reflection lists.
+func listReflectedServices(t *testing.T, cfg Config) []string {
+	t.Helper()
+	cfg.Port = getFreePort(t)
+	logger := slog.Default()
+	container := setupTestContainer(logger)
+	require.NoError(t, di.For[*namedServiceRegistrar](container).Named("public").
+		Instance(&namedServiceRegistrar{serviceName: "public.v1.Catalog"}))
+	require.NoError(t, di.For[*namedServiceRegistrar](container).Named("admin").
+		Instance(&namedServiceRegistrar{serviceName: "admin.v1.Maintenance"}))
+
+	server, err := NewServer(cfg, logger, container, nil)
+	require.NoError(t, err)
+	require.NoError(t, server.OnStart(context.Background()))
+	t.Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	})
+
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("localhost:%d", cfg.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+
+	var names []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		names = append(names, svc.GetName())
+	}
+	return names
+}
+
+func TestReflectionFilter_HidesRejectedServices(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ReflectionFilter = func(name string) bool {
+		return !strings.HasPrefix(name, "admin.")
+	}
+
+	names := listReflectedServices(t, cfg)
+
+	assert.Contains(t, names, "public.v1.Catalog")
+	assert.NotContains(t, names, "admin.v1.Maintenance")
+	assert.Contains(t, names, "grpc.reflection.v1.ServerReflection")
+}
+
+func TestReflectionFilter_NilListsAll(t *testing.T) {
+	names := listReflectedServices(t, DefaultConfig())
+
+	assert.Contains(t, names, "public.v1.Catalog")
+	assert.Contains(t, names, "admin.v1.Maintenance")
+}
+
+func TestReflectionFilter_DoesNotUnregisterServices(t *testing.T) {
+	server := grpc.NewServer()
+	(&namedServiceRegistrar{serviceName: "admin.v1.Maintenance"}).RegisterService(server)
+	registerReflection(server, func(string) bool { return false })
+
+	assert.Contains(t, server.GetServiceInfo(), "admin.v1.Maintenance")
+}
+
+func TestWithReflectionFilter(t *testing.T) {
+	mc := &moduleConfig{}
+	WithReflectionFilter(func(string) bool { return true })(mc)
+	assert.NotNil(t, mc.reflectionFilter)
+}
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net"
+	"slices"
+	"sync/atomic"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/stats"
 
 	"github.com/petabytecl/gaz/di"
@@ -39,10 +41,13 @@ type Server struct {
 	config        Config
 	server        *grpc.Server
 	listener      net.Listener
+	customLis     net.Listener // set by SetListener, used instead of binding Config.Port
 	container     *di.Container
 	logger        *slog.Logger
 	otelEnabled   bool
 	healthAdapter *healthAdapter
+	started       atomic.Bool
+	ready         atomic.Bool
 }
 
 // NewServer creates a new gRPC server with the given configuration.
@@ -56,18 +61,31 @@ type Server struct {
 //   - logger: Logger for request logging and error reporting
 //   - container: DI container for service and interceptor discovery
 //   - tp: Optional TracerProvider for OpenTelemetry instrumentation (may be nil)
-func NewServer(cfg Config, logger *slog.Logger, container *di.Container, tp *sdktrace.TracerProvider) *Server {
+//
+// Returns an error if the interceptor bundles cannot be resolved.
+func NewServer(cfg Config, logger *slog.Logger, container *di.Container, tp *sdktrace.TracerProvider) (*Server, error) {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
+	// Per-method size limits need an interceptor to enforce the global
+	// defaults once the transport limits are raised.
+	var builtin []InterceptorBundle
+	if len(cfg.MethodLimits) > 0 {
+		builtin = append(builtin, NewMsgLimitsBundle(cfg))
+	}
+
 	// Auto-discover and chain interceptors from DI container.
-	unaryInterceptors, streamInterceptors := collectInterceptors(container, logger)
+	unaryInterceptors, streamInterceptors, err := collectInterceptors(container, logger, builtin...)
+	if err != nil {
+		return nil, err
+	}
 
 	// Build server options.
+	maxRecv, maxSend := transportMsgLimits(cfg)
 	opts := []grpc.ServerOption{
-		grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize),
-		grpc.MaxSendMsgSize(cfg.MaxSendMsgSize),
+		grpc.MaxRecvMsgSize(maxRecv),
+		grpc.MaxSendMsgSize(maxSend),
 	}
 
 	// Add interceptor chains if any were discovered.
@@ -97,7 +115,18 @@ func NewServer(cfg Config, logger *slog.Logger, container *di.Container, tp *sdk
 		container:   container,
 		logger:      logger,
 		otelEnabled: otelEnabled,
+	}, nil
+}
+
+// SetListener makes OnStart serve on ln instead of binding Config.Port, for
+// example a bufconn listener in tests. It has no effect with SkipListener.
+// The server closes ln when it stops. This method panics if called after the
+// server has started.
+func (s *Server) SetListener(ln net.Listener) {
+	if s.started.Load() {
+		panic("grpc: cannot set listener after server started")
 	}
+	s.customLis = ln
 }
 
 // OnStart starts the gRPC server.
@@ -106,16 +135,22 @@ func NewServer(cfg Config, logger *slog.Logger, container *di.Container, tp *sdk
 // When SkipListener is true, services are registered but no port is bound.
 // Implements di.Starter.
 func (s *Server) OnStart(ctx context.Context) error {
+	s.started.Store(true)
 	if s.config.SkipListener {
 		return s.onStartSkipListener(ctx)
 	}
 
-	// Bind port first (fail fast if already in use).
-	addr := fmt.Sprintf(":%d", s.config.Port)
-	var lc net.ListenConfig
-	lis, err := lc.Listen(ctx, "tcp", addr)
-	if err != nil {
-		return fmt.Errorf("grpc: bind port %d: %w", s.config.Port, err)
+	// Bind port first (fail fast if already in use), unless a listener
+	// was provided.
+	lis := s.customLis
+	if lis == nil {
+		addr := fmt.Sprintf(":%d", s.config.Port)
+		var lc net.ListenConfig
+		var err error
+		lis, err = lc.Listen(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("grpc: bind port %d: %w", s.config.Port, err)
+		}
 	}
 	s.listener = lis
 
@@ -127,7 +162,7 @@ func (s *Server) OnStart(ctx context.Context) error {
 	}
 
 	s.logger.InfoContext(ctx, "gRPC server starting",
-		slog.Int("port", s.config.Port),
+		slog.String("addr", lis.Addr().String()),
 		slog.Bool("reflection", s.config.Reflection),
 		slog.Int("services", serviceCount),
 		slog.Bool("otel", s.otelEnabled),
@@ -141,6 +176,7 @@ func (s *Server) OnStart(ctx context.Context) error {
 		}
 	}()
 
+	s.ready.Store(true)
 	return nil
 }
 
@@ -159,6 +195,7 @@ func (s *Server) onStartSkipListener(ctx context.Context) error {
 		slog.Bool("health", s.config.HealthEnabled),
 	)
 
+	s.ready.Store(true)
 	return nil
 }
 
@@ -189,7 +226,7 @@ func (s *Server) registerServices(ctx context.Context) (int, error) {
 
 	// Enable reflection if configured.
 	if s.config.Reflection {
-		reflection.Register(s.server)
+		registerReflection(s.server, s.config.ReflectionFilter)
 	}
 
 	return len(registrars), nil
@@ -200,6 +237,7 @@ func (s *Server) registerServices(ctx context.Context) (int, error) {
 // When SkipListener is true, GracefulStop is called directly without listener management.
 // Implements di.Stopper.
 func (s *Server) OnStop(ctx context.Context) error {
+	s.ready.Store(false)
 	s.logger.InfoContext(ctx, "gRPC server stopping")
 
 	// Stop health adapter first.
@@ -233,8 +271,23 @@ func (s *Server) OnStop(ctx context.Context) error {
 	}
 }
 
+// Ready reports whether OnStart has completed: services are registered and,
+// unless SkipListener is set, the port is bound and serving.
+// It returns false again once OnStop begins.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
+
 // GRPCServer returns the underlying grpc.Server for direct access.
 // This is useful for registering services manually if needed.
 func (s *Server) GRPCServer() *grpc.Server {
 	return s.server
 }
+
+// RegisteredServices returns the sorted full names of the services the
+// server serves, such as "helloworld.Greeter". It includes services added
+// by Registrar auto-discovery as well as the health and reflection services
+// when enabled. It returns an empty list before OnStart.
+func (s *Server) RegisteredServices() []string {
+	return slices.Sorted(maps.Keys(s.server.GetServiceInfo()))
+}
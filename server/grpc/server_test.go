@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/petabytecl/gaz/di"
 )
@@ -41,11 +42,12 @@ func (s *GRPCServerTestSuite) TestGRPCServerStartStop() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	// Give server time to bind.
@@ -75,11 +77,12 @@ func (s *GRPCServerTestSuite) TestGRPCServerReflection() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	defer func() {
@@ -146,7 +149,8 @@ func (s *GRPCServerTestSuite) TestGRPCServerServiceDiscovery() {
 	err := di.For[*mockRegistrar](container).Instance(mockReg)
 	s.Require().NoError(err)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start.
 	ctx := context.Background()
@@ -163,6 +167,36 @@ func (s *GRPCServerTestSuite) TestGRPCServerServiceDiscovery() {
 	s.True(mockReg.registered, "Service registrar should have been called")
 }
 
+func (s *GRPCServerTestSuite) TestGRPCServerRegisteredServices() {
+	cfg := DefaultConfig()
+	cfg.SkipListener = true
+	logger := slog.Default()
+	container := setupTestContainer(logger)
+
+	err := di.For[*namedServiceRegistrar](container).Named("alpha").
+		Instance(&namedServiceRegistrar{serviceName: "test.v1.Alpha"})
+	s.Require().NoError(err)
+	err = di.For[*namedServiceRegistrar](container).Named("beta").
+		Instance(&namedServiceRegistrar{serviceName: "test.v1.Beta"})
+	s.Require().NoError(err)
+
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
+	s.Empty(server.RegisteredServices(), "no services before OnStart")
+
+	s.Require().NoError(server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+
+	services := server.RegisteredServices()
+	s.Contains(services, "test.v1.Alpha")
+	s.Contains(services, "test.v1.Beta")
+	s.IsNonDecreasing(services, "services should be sorted")
+}
+
 func (s *GRPCServerTestSuite) TestGRPCServerPortBindingError() {
 	// Bind a port first.
 	lis, err := net.Listen("tcp", ":0")
@@ -177,7 +211,8 @@ func (s *GRPCServerTestSuite) TestGRPCServerPortBindingError() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start should fail.
 	ctx := context.Background()
@@ -193,11 +228,12 @@ func (s *GRPCServerTestSuite) TestGRPCServerGracefulShutdown() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	// Give server time to bind.
@@ -228,11 +264,12 @@ func (s *GRPCServerTestSuite) TestGRPCServerReflectionDisabled() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	defer func() {
@@ -277,7 +314,8 @@ func (s *GRPCServerTestSuite) TestGRPCServerGetGRPCServer() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// GRPCServer should return the underlying grpc.Server.
 	grpcServer := server.GRPCServer()
@@ -296,7 +334,8 @@ func (s *GRPCServerTestSuite) TestSkipListenerStartStop() {
 	err := di.For[*mockRegistrar](container).Instance(mockReg)
 	s.Require().NoError(err)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start in skip-listener mode.
 	ctx := context.Background()
@@ -316,6 +355,26 @@ func (s *GRPCServerTestSuite) TestSkipListenerStartStop() {
 	s.Require().NoError(err)
 }
 
+func (s *GRPCServerTestSuite) TestGRPCServerSetListenerPanicsAfterStart() {
+	cfg := DefaultConfig()
+	cfg.SkipListener = true
+	logger := slog.Default()
+	container := setupTestContainer(logger)
+
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+
+	s.Panics(func() {
+		server.SetListener(bufconn.Listen(1024))
+	}, "SetListener should panic after server started")
+}
+
 func (s *GRPCServerTestSuite) TestSkipListenerWithReflection() {
 	// Setup with skip-listener mode and reflection enabled.
 	cfg := DefaultConfig()
@@ -324,11 +383,12 @@ func (s *GRPCServerTestSuite) TestSkipListenerWithReflection() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start in skip-listener mode.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	defer func() {
@@ -353,11 +413,12 @@ func (s *GRPCServerTestSuite) TestSkipListenerNoPortBinding() {
 	logger := slog.Default()
 	container := setupTestContainer(logger)
 
-	server := NewServer(cfg, logger, container, nil)
+	server, err := NewServer(cfg, logger, container, nil)
+	s.Require().NoError(err)
 
 	// Start should succeed even with port=0 because no listener is created.
 	ctx := context.Background()
-	err := server.OnStart(ctx)
+	err = server.OnStart(ctx)
 	s.Require().NoError(err)
 
 	// Stop.
@@ -398,6 +459,18 @@ func (m *mockRegistrar) RegisterService(_ grpc.ServiceRegistrar) {
 	m.registered = true
 }
 
+// namedServiceRegistrar registers an empty service with the given full name.
+type namedServiceRegistrar struct {
+	serviceName string
+}
+
+func (r *namedServiceRegistrar) RegisterService(server grpc.ServiceRegistrar) {
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: r.serviceName,
+		HandlerType: (*any)(nil),
+	}, r)
+}
+
 // getFreePort finds an available port for testing.
 func getFreePort(t *testing.T) int {
 	t.Helper()
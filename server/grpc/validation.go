@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"fmt"
+
+	"buf.build/go/protovalidate"
+
+	"github.com/petabytecl/gaz"
+)
+
+// WithProtoValidation passes options to protovalidate.New for the request
+// validation interceptor. The interceptor checks incoming request messages
+// against their buf.validate rules; invalid requests fail with
+// codes.InvalidArgument and carry the field violations as a
+// *validate.Violations status detail.
+//
+// Validation is on by default, so this option is only needed to configure
+// the validator. It re-enables validation turned off by
+// WithoutProtoValidation.
+//
+// Example, stopping at the first violation:
+//
+//	app.Use(grpc.NewModule(grpc.WithProtoValidation(protovalidate.WithFailFast())))
+func WithProtoValidation(opts ...protovalidate.ValidatorOption) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.noProtoValidation = false
+		mc.validatorOpts = opts
+	}
+}
+
+// WithoutProtoValidation disables the request validation interceptor, for
+// services that validate requests themselves.
+//
+// Example:
+//
+//	app.Use(grpc.NewModule(grpc.WithoutProtoValidation()))
+func WithoutProtoValidation() ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.noProtoValidation = true
+	}
+}
+
+// provideValidationBundle creates a ValidationBundle provider function.
+func provideValidationBundle(opts []protovalidate.ValidatorOption) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if err := gaz.For[*ValidationBundle](c).Provider(func(_ *gaz.Container) (*ValidationBundle, error) {
+			return NewValidationBundle(opts...)
+		}); err != nil {
+			return fmt.Errorf("register validation bundle: %w", err)
+		}
+		return nil
+	}
+}
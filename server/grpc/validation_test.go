@@ -0,0 +1,158 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+// newSignupRequest builds a dynamic message type with a "name" field
+// that must be at least three characters long.
+func newSignupRequest(t *testing.T) protoreflect.MessageType {
+	t.Helper()
+
+	fieldOpts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(fieldOpts, validate.E_Field, validate.FieldRules_builder{
+		String: validate.StringRules_builder{MinLen: proto.Uint64(3)}.Build(),
+	}.Build())
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("gaz/test/signup.proto"),
+		Package:    proto.String("gaz.test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"buf/validate/validate.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("SignupRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Options:  fieldOpts,
+			}},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return dynamicpb.NewMessageType(fd.Messages().ByName("SignupRequest"))
+}
+
+func signupWithName(mt protoreflect.MessageType, name string) proto.Message {
+	msg := mt.New()
+	msg.Set(mt.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString(name))
+	return msg.Interface()
+}
+
+// protoStream is a grpc.ServerStream that receives a single proto message.
+type protoStream struct {
+	grpc.ServerStream
+	recv proto.Message
+}
+
+func (p *protoStream) Context() context.Context {
+	return context.Background()
+}
+
+func (p *protoStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), p.recv)
+	return nil
+}
+
+func TestValidationBundle_RejectsInvalidRequest(t *testing.T) {
+	mt := newSignupRequest(t)
+	bundle, err := NewValidationBundle()
+	require.NoError(t, err)
+	unary, _ := bundle.Interceptors()
+
+	called := false
+	_, err = unary(context.Background(), signupWithName(mt, "al"), &grpc.UnaryServerInfo{FullMethod: echoMethod},
+		func(_ context.Context, _ any) (any, error) {
+			called = true
+			return "ok", nil
+		})
+	require.Error(t, err)
+	assert.False(t, called, "handler must not run for invalid requests")
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	violations, ok := st.Details()[0].(*validate.Violations)
+	require.True(t, ok, "expected *validate.Violations detail, got %T", st.Details()[0])
+	require.Len(t, violations.GetViolations(), 1)
+	assert.Equal(t, "string.min_len", violations.GetViolations()[0].GetRuleId())
+	assert.Equal(t, "name", violations.GetViolations()[0].GetField().GetElements()[0].GetFieldName())
+}
+
+func TestValidationBundle_PassesValidRequest(t *testing.T) {
+	mt := newSignupRequest(t)
+	bundle, err := NewValidationBundle()
+	require.NoError(t, err)
+	unary, _ := bundle.Interceptors()
+
+	resp, err := unary(context.Background(), signupWithName(mt, "alice"), &grpc.UnaryServerInfo{FullMethod: echoMethod}, okHandler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestValidationBundle_StreamRejectsInvalidMessage(t *testing.T) {
+	mt := newSignupRequest(t)
+	bundle, err := NewValidationBundle()
+	require.NoError(t, err)
+	_, stream := bundle.Interceptors()
+
+	ss := &protoStream{recv: signupWithName(mt, "al")}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: uploadMethod}, func(_ any, s grpc.ServerStream) error {
+		return s.RecvMsg(mt.New().Interface())
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ss = &protoStream{recv: signupWithName(mt, "alice")}
+	err = stream(nil, ss, &grpc.StreamServerInfo{FullMethod: uploadMethod}, func(_ any, s grpc.ServerStream) error {
+		return s.RecvMsg(mt.New().Interface())
+	})
+	require.NoError(t, err)
+}
+
+func TestNewModule_ProtoValidationIsDefault(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+	assert.True(t, di.Has[*ValidationBundle](app.Container()))
+
+	bundle, err := di.Resolve[*ValidationBundle](app.Container())
+	require.NoError(t, err)
+	assert.Equal(t, PriorityValidation, bundle.Priority())
+
+	app = gaz.New()
+	require.NoError(t, NewModule(WithProtoValidation(protovalidate.WithFailFast())).Apply(app))
+	require.NoError(t, app.Build())
+	assert.True(t, di.Has[*ValidationBundle](app.Container()))
+}
+
+func TestNewModule_WithoutProtoValidation(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithoutProtoValidation()).Apply(app))
+	assert.False(t, di.Has[*ValidationBundle](app.Container()))
+
+	app = gaz.New()
+	require.NoError(t, NewModule(WithoutProtoValidation(), WithProtoValidation()).Apply(app))
+	assert.True(t, di.Has[*ValidationBundle](app.Container()))
+}
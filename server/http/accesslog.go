@@ -0,0 +1,103 @@
+package http
+
+import (
+	"bufio"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// AccessLog returns middleware that logs one record per request with the
+// method, path, status, bytes written, duration, and remote address.
+// If logger is nil, slog.Default() is used.
+//
+// Example:
+//
+//	mux := http.NewServeMux()
+//	handler := gazhttp.AccessLog(logger)(mux)
+//	gaz.For[http.Handler](c).Instance(handler)
+func AccessLog(logger *slog.Logger) func(http.Handler) http.Handler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rw, r)
+
+			logger.InfoContext(r.Context(), "http request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", rw.status),
+				slog.Int("bytes", rw.bytes),
+				slog.Duration("duration", time.Since(start)),
+				slog.String("remote_addr", r.RemoteAddr),
+			)
+		})
+	}
+}
+
+// statusRecorder wraps http.ResponseWriter to capture the status code and
+// the number of body bytes written. It implements http.Flusher,
+// http.Hijacker, and http.Pusher by delegating to the underlying writer, so
+// streaming responses and connection upgrades keep working behind it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and forwards it.
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write counts body bytes and forwards them. An implicit 200 is recorded if
+// WriteHeader was not called.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Flush sends buffered data to the client. It commits the response, so an
+// implicit 200 is recorded if WriteHeader was not called.
+func (r *statusRecorder) Flush() {
+	r.wroteHeader = true
+	_ = http.NewResponseController(r.ResponseWriter).Flush()
+}
+
+// Hijack lets the handler take over the connection. A hijacked connection
+// counts as a started response.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := http.NewResponseController(r.ResponseWriter).Hijack()
+	if err == nil {
+		r.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+// Push initiates an HTTP/2 server push, or returns http.ErrNotSupported if
+// the underlying writer does not support it.
+func (r *statusRecorder) Push(target string, opts *http.PushOptions) error {
+	if p, ok := r.ResponseWriter.(http.Pusher); ok {
+		return p.Push(target, opts)
+	}
+	return http.ErrNotSupported
+}
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController
+// can reach optional interfaces such as http.Flusher.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
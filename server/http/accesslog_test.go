@@ -0,0 +1,94 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLog(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		bytes  int
+	}{
+		{name: "ok", path: "/hello", status: http.StatusOK, bytes: len("hello")},
+		{name: "not found", path: "/missing", status: http.StatusNotFound, bytes: len("404 page not found\n")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+			handler := AccessLog(logger)(mux)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code)
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, 1)
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+			assert.Equal(t, "http request", record["msg"])
+			assert.Equal(t, http.MethodGet, record["method"])
+			assert.Equal(t, tt.path, record["path"])
+			assert.InDelta(t, tt.status, record["status"], 0)
+			assert.InDelta(t, tt.bytes, record["bytes"], 0)
+			assert.Equal(t, "192.0.2.1:1234", record["remote_addr"])
+			assert.Contains(t, record, "duration")
+		})
+	}
+}
+
+func TestServerAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	cfg := DefaultConfig()
+	cfg.AccessLog = true
+	server := NewServer(cfg, http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		panic("boom")
+	}), logger)
+
+	server.server.Handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/boom", nil))
+
+	// The access log wraps recovery, so it records the recovered 500.
+	var record map[string]any
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &record))
+	assert.Equal(t, "http request", record["msg"])
+	assert.InDelta(t, http.StatusInternalServerError, record["status"], 0)
+}
+
+func TestAccessLog_ExplicitStatus(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	handler := AccessLog(logger)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.WriteHeader(http.StatusInternalServerError) // Superfluous, ignored
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/jobs", nil))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.InDelta(t, http.StatusAccepted, record["status"], 0)
+	assert.InDelta(t, 0, record["bytes"], 0)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+
+	"github.com/petabytecl/gaz/server/cors"
 )
 
 // Default configuration values.
@@ -27,6 +29,12 @@ const (
 	// DefaultReadHeaderTimeout is the default timeout for reading request headers.
 	// This is critical for preventing slow loris attacks.
 	DefaultReadHeaderTimeout = 5 * time.Second
+
+	// DefaultMaxHeaderBytes is the default limit on request header size (1 MiB).
+	DefaultMaxHeaderBytes = 1 << 20
+
+	// DefaultMaxBodyBytes is the default limit on request body size (10 MiB).
+	DefaultMaxBodyBytes = 10 << 20
 )
 
 // Config holds configuration for the HTTP server.
@@ -51,8 +59,50 @@ type Config struct {
 	// This is critical for preventing slow loris attacks.
 	// Defaults to 5 seconds.
 	ReadHeaderTimeout time.Duration `json:"read_header_timeout" yaml:"read_header_timeout" mapstructure:"read_header_timeout"`
+
+	// PreDrainDelay is how long OnStop waits after failing readiness and
+	// before shutting down, so load balancers stop routing new requests.
+	// Defaults to 0 (no delay).
+	PreDrainDelay time.Duration `json:"pre_drain_delay" yaml:"pre_drain_delay" mapstructure:"pre_drain_delay"`
+
+	// MaxHeaderBytes is the maximum size of request headers, including the
+	// request line. Larger requests are refused with 431.
+	// Defaults to 1 MiB.
+	MaxHeaderBytes int `json:"max_header_bytes" yaml:"max_header_bytes" mapstructure:"max_header_bytes"`
+
+	// MaxBodyBytes is the maximum size of a request body. Requests declaring
+	// a larger Content-Length are rejected with 413, and reads past the limit
+	// fail. Defaults to 10 MiB; NewServer applies no limit when it is zero.
+	MaxBodyBytes int64 `json:"max_body_bytes" yaml:"max_body_bytes" mapstructure:"max_body_bytes"`
+
+	// H2C accepts HTTP/2 over cleartext (h2c) alongside HTTP/1.1, for
+	// gateways and service meshes that terminate TLS themselves.
+	// Defaults to false.
+	H2C bool `json:"h2c" yaml:"h2c" mapstructure:"h2c"`
+
+	// DevMode makes CORS permissive: every origin, method, and header is
+	// allowed and the CORS settings are ignored. It only has an effect
+	// when CORS is set. Defaults to false.
+	DevMode bool `json:"dev_mode" yaml:"dev_mode" mapstructure:"dev_mode"`
+
+	// DisableRecovery turns off panic recovery. By default a panicking
+	// handler is logged with its stack trace and answered with 500.
+	// Defaults to false.
+	DisableRecovery bool `json:"disable_recovery" yaml:"disable_recovery" mapstructure:"disable_recovery"`
+
+	// AccessLog logs one record per request through the app logger (see the
+	// AccessLog middleware). Defaults to false.
+	AccessLog bool `json:"access_log" yaml:"access_log" mapstructure:"access_log"`
+
+	// CORS enables CORS handling with these settings. Nil disables it.
+	// Defaults to nil.
+	CORS *CORSConfig `json:"cors" yaml:"cors" mapstructure:"cors"`
 }
 
+// CORSConfig holds CORS configuration for the HTTP server.
+// It is the shared cors.Config, so the same settings work for Vanguard.
+type CORSConfig = cors.Config
+
 // DefaultConfig returns a Config with safe defaults.
 // The timeout values are chosen to balance responsiveness with protection
 // against slow loris and similar attacks.
@@ -63,6 +113,8 @@ func DefaultConfig() Config {
 		WriteTimeout:      DefaultWriteTimeout,
 		IdleTimeout:       DefaultIdleTimeout,
 		ReadHeaderTimeout: DefaultReadHeaderTimeout,
+		MaxHeaderBytes:    DefaultMaxHeaderBytes,
+		MaxBodyBytes:      DefaultMaxBodyBytes,
 	}
 }
 
@@ -78,6 +130,13 @@ func (c *Config) Flags(fs *pflag.FlagSet) {
 	fs.DurationVar(&c.WriteTimeout, "http-write-timeout", c.WriteTimeout, "HTTP write timeout")
 	fs.DurationVar(&c.IdleTimeout, "http-idle-timeout", c.IdleTimeout, "HTTP idle timeout")
 	fs.DurationVar(&c.ReadHeaderTimeout, "http-read-header-timeout", c.ReadHeaderTimeout, "HTTP read header timeout")
+	fs.DurationVar(&c.PreDrainDelay, "http-pre-drain-delay", c.PreDrainDelay, "Delay between failing readiness and HTTP shutdown")
+	fs.IntVar(&c.MaxHeaderBytes, "http-max-header-bytes", c.MaxHeaderBytes, "HTTP maximum request header size in bytes")
+	fs.Int64Var(&c.MaxBodyBytes, "http-max-body-bytes", c.MaxBodyBytes, "HTTP maximum request body size in bytes")
+	fs.BoolVar(&c.H2C, "http-h2c", c.H2C, "Accept HTTP/2 over cleartext (h2c)")
+	fs.BoolVar(&c.DevMode, "http-dev-mode", c.DevMode, "Enable development mode (permissive CORS)")
+	fs.BoolVar(&c.DisableRecovery, "http-disable-recovery", c.DisableRecovery, "Disable HTTP handler panic recovery")
+	fs.BoolVar(&c.AccessLog, "http-access-log", c.AccessLog, "Log one record per HTTP request")
 }
 
 // SetDefaults applies default values to zero-value fields.
@@ -98,6 +157,12 @@ func (c *Config) SetDefaults() {
 	if c.ReadHeaderTimeout == 0 {
 		c.ReadHeaderTimeout = DefaultReadHeaderTimeout
 	}
+	if c.MaxHeaderBytes == 0 {
+		c.MaxHeaderBytes = DefaultMaxHeaderBytes
+	}
+	if c.MaxBodyBytes == 0 {
+		c.MaxBodyBytes = DefaultMaxBodyBytes
+	}
 }
 
 // Validate checks that the configuration is valid.
@@ -121,5 +186,14 @@ func (c *Config) Validate() error {
 	if c.ReadHeaderTimeout <= 0 {
 		return errors.New("http: read_header_timeout must be greater than 0")
 	}
+	if c.MaxHeaderBytes <= 0 {
+		return errors.New("http: max_header_bytes must be greater than 0")
+	}
+	if c.MaxBodyBytes <= 0 {
+		return errors.New("http: max_body_bytes must be greater than 0")
+	}
+	if c.PreDrainDelay < 0 {
+		return errors.New("http: pre_drain_delay must not be negative")
+	}
 	return nil
 }
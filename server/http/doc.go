@@ -27,6 +27,8 @@
 //	    write_timeout: 30s
 //	    idle_timeout: 120s
 //	    read_header_timeout: 5s
+//	    max_header_bytes: 1048576
+//	    max_body_bytes: 10485760
 //
 // Or via module options:
 //
@@ -36,6 +38,43 @@
 //	    http.WithHandler(myHandler),
 //	))
 //
+// # HTTP/2 Cleartext
+//
+// Behind a service mesh or gateway that terminates TLS and speaks HTTP/2 to
+// backends, enable h2c. HTTP/1.1 clients keep working on the same port:
+//
+//	app.Use(http.NewModule(http.WithH2C(true)))
+//
+// # CORS
+//
+// WithCORS enables CORS handling using the same settings type as the
+// Vanguard server. In production only the configured origins are allowed;
+// with Config.DevMode every origin is:
+//
+//	cfg := http.DefaultCORSConfig(false)
+//	cfg.AllowedOrigins = []string{"https://app.example.com"}
+//	app.Use(http.NewModule(http.WithCORS(cfg)))
+//
+// # Panic Recovery
+//
+// Like the gRPC recovery interceptor, the server recovers panicking handlers:
+// the panic is logged with its stack trace through the app logger and the
+// client gets 500 Internal Server Error. Disable it to handle panics
+// yourself:
+//
+//	app.Use(http.NewModule(http.WithRecovery(false)))
+//
+// # Access Logging
+//
+// AccessLog wraps a handler and logs one structured record per request
+// (method, path, status, bytes, duration, remote_addr):
+//
+//	handler := http.AccessLog(logger)(mux)
+//
+// Or let the module wrap the container's handler with the app logger:
+//
+//	app.Use(http.NewModule(http.WithAccessLogging(true)))
+//
 // # Timeout Rationale
 //
 // Each timeout serves a specific security and performance purpose:
@@ -58,6 +97,16 @@
 // where attackers send HTTP headers slowly to exhaust server connections. This
 // is aligned with security research recommending timeouts between 5-10 seconds.
 //
+// Request size is bounded too. Headers larger than MaxHeaderBytes (1 MiB by
+// default) are refused with 431. Bodies declaring more than MaxBodyBytes
+// (10 MiB by default) are rejected with 413 before reaching the handler, and
+// reading past the limit fails with *http.MaxBytesError:
+//
+//	app.Use(http.NewModule(
+//	    http.WithMaxHeaderBytes(64<<10),
+//	    http.WithMaxBodyBytes(100<<20), // large uploads
+//	))
+//
 // For additional security in production:
 //   - Consider using a reverse proxy (nginx, envoy) for TLS termination
 //   - Implement rate limiting at the Gateway layer
@@ -69,6 +118,21 @@
 //   - OnStart: Starts the HTTP server in a background goroutine
 //   - OnStop: Gracefully shuts down the server using http.Server.Shutdown
 //
+// When the health module is registered, OnStop first marks the health
+// ShutdownCheck so readiness returns 503, then waits PreDrainDelay before
+// calling Shutdown. This gives load balancers time to stop routing, like a
+// Kubernetes preStop sleep:
+//
+//	app.Use(http.NewModule(http.WithPreDrainDelay(5*time.Second)))
+//
 // The server is registered as Eager, so it starts automatically when the
 // application starts.
+//
+// # Testing
+//
+// WithListener serves on a provided listener instead of binding Config.Port:
+//
+//	ln, _ := net.Listen("tcp", "127.0.0.1:0")
+//	app.Use(http.NewModule(http.WithListener(ln)))
+//	resp, _ := http.Get("http://" + ln.Addr().String() + "/")
 package http
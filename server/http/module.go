@@ -3,11 +3,123 @@ package http
 import (
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/health"
+	"github.com/petabytecl/gaz/server/cors"
 )
 
+// ModuleOption configures the HTTP module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	preDrainDelay time.Duration
+	h2c           bool
+	cors          *CORSConfig
+	listener      net.Listener
+	maxHeader     int
+	maxBody       int64
+	recovery      bool
+	accessLog     bool
+}
+
+// WithPreDrainDelay sets the default Config.PreDrainDelay: how long the
+// server keeps serving after readiness fails and before it shuts down.
+// Mirrors a Kubernetes preStop sleep. Config files and flags still override it.
+func WithPreDrainDelay(d time.Duration) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.preDrainDelay = d
+	}
+}
+
+// WithH2C sets the default Config.H2C: whether the server accepts HTTP/2
+// over cleartext. Enable it behind proxies or service meshes that speak h2c
+// to backends. Config files and flags still override it.
+func WithH2C(enabled bool) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.h2c = enabled
+	}
+}
+
+// WithCORS sets the default Config.CORS, enabling CORS handling for every
+// request. Preflight OPTIONS requests are answered before reaching the
+// handler. With Config.DevMode, all origins are allowed; otherwise only
+// cfg's origins, methods, and headers are. Config files still override it.
+//
+// Example:
+//
+//	cfg := http.DefaultCORSConfig(false)
+//	cfg.AllowedOrigins = []string{"https://app.example.com"}
+//	app.Use(http.NewModule(http.WithCORS(cfg)))
+func WithCORS(cfg CORSConfig) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.cors = &cfg
+	}
+}
+
+// WithMaxHeaderBytes sets the default Config.MaxHeaderBytes: the largest
+// request header the server accepts before answering 431. Config files and
+// flags still override it.
+func WithMaxHeaderBytes(n int) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.maxHeader = n
+	}
+}
+
+// WithMaxBodyBytes sets the default Config.MaxBodyBytes: the largest request
+// body the server accepts. Larger declared bodies are answered with 413 before
+// reaching the handler. Config files and flags still override it.
+func WithMaxBodyBytes(n int64) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.maxBody = n
+	}
+}
+
+// WithRecovery sets whether panicking handlers are recovered, logged with
+// their stack trace, and answered with 500 (see Recovery). It is enabled by
+// default; pass false to let panics reach net/http. Sets the default
+// Config.DisableRecovery; config files still override it.
+func WithRecovery(enabled bool) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.recovery = enabled
+	}
+}
+
+// WithAccessLogging sets whether the server logs one record per request
+// through the app logger with the AccessLog middleware. It is disabled by
+// default. Sets the default Config.AccessLog; config files and flags still
+// override it.
+func WithAccessLogging(enabled bool) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.accessLog = enabled
+	}
+}
+
+// WithListener makes the server accept connections on ln instead of binding
+// Config.Port, so tests can serve on an httptest or in-memory listener. The
+// server closes ln when it stops.
+//
+// Example:
+//
+//	ln, _ := net.Listen("tcp", "127.0.0.1:0")
+//	app.Use(http.NewModule(http.WithListener(ln)))
+func WithListener(ln net.Listener) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.listener = ln
+	}
+}
+
+// DefaultCORSConfig returns a CORSConfig with appropriate defaults.
+// In dev mode, CORS is wide-open for convenience.
+// In prod mode, origins must be explicitly configured.
+func DefaultCORSConfig(devMode bool) CORSConfig {
+	return cors.DefaultConfig(devMode)
+}
+
 // NewModule creates an HTTP module.
 // Returns a gaz.Module that registers HTTP server components.
 //
@@ -18,12 +130,27 @@ import (
 // The server uses http.Handler resolved from the container if available.
 // Otherwise, it defaults to http.NotFoundHandler().
 //
+// If the health module is registered, the server fails readiness via the
+// health ShutdownCheck before draining on stop (see WithPreDrainDelay).
+//
 // Example:
 //
 //	app := gaz.New()
 //	app.Use(http.NewModule())
-func NewModule() gaz.Module {
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{maxHeader: DefaultMaxHeaderBytes, maxBody: DefaultMaxBodyBytes, recovery: true}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
 	defaultCfg := DefaultConfig()
+	defaultCfg.PreDrainDelay = mc.preDrainDelay
+	defaultCfg.H2C = mc.h2c
+	defaultCfg.CORS = mc.cors
+	defaultCfg.MaxHeaderBytes = mc.maxHeader
+	defaultCfg.MaxBodyBytes = mc.maxBody
+	defaultCfg.DisableRecovery = !mc.recovery
+	defaultCfg.AccessLog = mc.accessLog
 
 	return gaz.NewModule("http").
 		Flags(defaultCfg.Flags).
@@ -70,7 +197,24 @@ func NewModule() gaz.Module {
 						logger = slog.Default()
 					}
 
-					return NewServer(cfg, handler, logger), nil
+					server := NewServer(cfg, handler, logger)
+					if mc.listener != nil {
+						server.SetListener(mc.listener)
+					}
+
+					// Fail readiness before draining when the health module is present.
+					if sc, resolveErr := gaz.Resolve[*health.ShutdownCheck](c); resolveErr == nil {
+						server.SetShutdownCheck(sc)
+					}
+					// Depend on the management server so it stops after this
+					// server and keeps reporting 503 during the drain.
+					if gaz.Has[*health.ManagementServer](c) {
+						if _, resolveErr := gaz.Resolve[*health.ManagementServer](c); resolveErr != nil {
+							return nil, fmt.Errorf("resolve health management server: %w", resolveErr)
+						}
+					}
+
+					return server, nil
 				})
 		}).
 		Build()
@@ -1,7 +1,12 @@
 package http
 
 import (
+	"context"
+	"io"
+	"net"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -33,6 +38,119 @@ func TestNewModule(t *testing.T) {
 	})
 }
 
+func TestNewModuleWithPreDrainDelay(t *testing.T) {
+	app := gaz.New()
+
+	require.NoError(t, NewModule(WithPreDrainDelay(3*time.Second)).Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, cfg.PreDrainDelay)
+}
+
+func TestNewModuleWithH2C(t *testing.T) {
+	app := gaz.New()
+
+	require.NoError(t, NewModule(WithH2C(true)).Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.True(t, cfg.H2C)
+}
+
+func TestNewModuleWithCORS(t *testing.T) {
+	app := gaz.New()
+
+	corsCfg := DefaultCORSConfig(false)
+	corsCfg.AllowedOrigins = []string{"https://app.example.com"}
+	require.NoError(t, NewModule(WithCORS(corsCfg)).Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.NotNil(t, cfg.CORS)
+	require.Equal(t, []string{"https://app.example.com"}, cfg.CORS.AllowedOrigins)
+	require.False(t, cfg.DevMode)
+}
+
+func TestNewModuleWithBodyLimits(t *testing.T) {
+	app := gaz.New()
+
+	require.NoError(t, NewModule(WithMaxHeaderBytes(4096), WithMaxBodyBytes(1024)).Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.Equal(t, 4096, cfg.MaxHeaderBytes)
+	require.Equal(t, int64(1024), cfg.MaxBodyBytes)
+}
+
+func TestNewModuleWithRecovery(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.False(t, cfg.DisableRecovery, "recovery is on by default")
+
+	app = gaz.New()
+	require.NoError(t, NewModule(WithRecovery(false)).Apply(app))
+	require.NoError(t, app.Build())
+	cfg, err = di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.True(t, cfg.DisableRecovery)
+}
+
+func TestNewModuleWithAccessLogging(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.False(t, cfg.AccessLog, "access logging is off by default")
+
+	app = gaz.New()
+	require.NoError(t, NewModule(WithAccessLogging(true)).Apply(app))
+	require.NoError(t, app.Build())
+	cfg, err = di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	require.True(t, cfg.AccessLog)
+}
+
+func TestNewModuleWithListener(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	app := gaz.New()
+	require.NoError(t, NewModule(WithListener(ln)).Apply(app))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+	require.NoError(t, gaz.For[http.Handler](app.Container()).Instance(mux))
+	require.NoError(t, app.Build())
+
+	server, err := di.Resolve[*Server](app.Container())
+	require.NoError(t, err)
+	require.NoError(t, server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+	require.Equal(t, ln.Addr().String(), server.Addr())
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/hello")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "hello", string(body))
+}
+
 func TestConfigSetDefaults(t *testing.T) {
 	cfg := Config{}
 	cfg.SetDefaults()
@@ -42,6 +160,8 @@ func TestConfigSetDefaults(t *testing.T) {
 	require.Equal(t, DefaultWriteTimeout, cfg.WriteTimeout)
 	require.Equal(t, DefaultIdleTimeout, cfg.IdleTimeout)
 	require.Equal(t, DefaultReadHeaderTimeout, cfg.ReadHeaderTimeout)
+	require.Equal(t, DefaultMaxHeaderBytes, cfg.MaxHeaderBytes)
+	require.Equal(t, int64(DefaultMaxBodyBytes), cfg.MaxBodyBytes)
 }
 
 func TestConfigValidate(t *testing.T) {
@@ -91,4 +211,18 @@ func TestConfigValidate(t *testing.T) {
 		require.Error(t, cfg.Validate())
 		require.Contains(t, cfg.Validate().Error(), "read_header_timeout")
 	})
+
+	t.Run("invalid max header bytes", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.MaxHeaderBytes = 0
+		require.Error(t, cfg.Validate())
+		require.Contains(t, cfg.Validate().Error(), "max_header_bytes")
+	})
+
+	t.Run("invalid max body bytes", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.MaxBodyBytes = -1
+		require.Error(t, cfg.Validate())
+		require.Contains(t, cfg.Validate().Error(), "max_body_bytes")
+	})
 }
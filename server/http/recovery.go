@@ -0,0 +1,47 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recovery returns middleware that recovers panics in next, logs them with
+// the request and stack trace, and responds 500 Internal Server Error if the
+// handler had not yet written a status or body; otherwise the partial
+// response is left as is, since its status can no longer change. The server
+// applies it to every request unless Config.DisableRecovery is set. Panics with http.ErrAbortHandler are
+// re-raised so net/http aborts the response as intended.
+// If logger is nil, slog.Default() is used.
+func Recovery(logger *slog.Logger) func(http.Handler) http.Handler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if err, ok := p.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(p)
+				}
+
+				logger.ErrorContext(r.Context(), "panic recovered in HTTP handler",
+					slog.Any("panic", p),
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path),
+					slog.String("stack", string(debug.Stack())),
+				)
+				if !rw.wroteHeader {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
@@ -8,6 +8,13 @@ import (
 	"net"
 	"net/http"
 	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/petabytecl/gaz/health"
+	"github.com/petabytecl/gaz/server/cors"
 )
 
 // Server is a production-ready HTTP server with lifecycle management.
@@ -20,6 +27,12 @@ type Server struct {
 	handler  http.Handler
 	listener net.Listener
 	started  atomic.Bool
+
+	customLis net.Listener // set by SetListener, used instead of binding Config.Port
+
+	shutdownCheck *health.ShutdownCheck
+
+	h2s *http2.Server // set when Config.H2C is enabled
 }
 
 // NewServer creates a new HTTP server with the given configuration.
@@ -33,19 +46,68 @@ func NewServer(cfg Config, handler http.Handler, logger *slog.Logger) *Server {
 		logger = slog.Default()
 	}
 
-	return &Server{
+	s := &Server{
 		config:  cfg,
 		handler: handler,
 		logger:  logger,
 		server: &http.Server{
 			Addr:              fmt.Sprintf(":%d", cfg.Port),
-			Handler:           handler,
 			ReadTimeout:       cfg.ReadTimeout,
 			WriteTimeout:      cfg.WriteTimeout,
 			IdleTimeout:       cfg.IdleTimeout,
 			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			MaxHeaderBytes:    cfg.MaxHeaderBytes,
 		},
 	}
+	if cfg.H2C {
+		s.h2s = &http2.Server{IdleTimeout: cfg.IdleTimeout}
+		// Registers a shutdown hook so Shutdown also drains h2c connections,
+		// which net/http does not track once they are upgraded.
+		if err := http2.ConfigureServer(s.server, s.h2s); err != nil {
+			logger.Warn("HTTP/2 graceful shutdown unavailable", "error", err)
+		}
+	}
+	s.server.Handler = s.wrapHandler(handler)
+	return s
+}
+
+// wrapHandler wraps h with the Config.MaxBodyBytes limit, with CORS handling
+// when Config.CORS is set, with panic recovery unless Config.DisableRecovery
+// is set, with access logging when Config.AccessLog is set, and to accept h2c
+// requests when Config.H2C is enabled.
+func (s *Server) wrapHandler(h http.Handler) http.Handler {
+	if s.config.MaxBodyBytes > 0 {
+		h = maxBodyBytes(s.config.MaxBodyBytes)(h)
+	}
+	if s.config.CORS != nil {
+		h = cors.Middleware(*s.config.CORS, s.config.DevMode)(h)
+	}
+	if !s.config.DisableRecovery {
+		h = Recovery(s.logger)(h)
+	}
+	if s.config.AccessLog {
+		h = AccessLog(s.logger)(h)
+	}
+	if s.h2s == nil {
+		return h
+	}
+	return h2c.NewHandler(h, s.h2s)
+}
+
+// maxBodyBytes returns middleware that rejects requests declaring a body
+// larger than limit with 413 and caps the body of the rest, so handlers
+// reading past limit get an *http.MaxBytesError.
+func maxBodyBytes(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 // SetHandler sets the HTTP handler for the server.
@@ -56,17 +118,39 @@ func (s *Server) SetHandler(h http.Handler) {
 		panic("http: cannot set handler after server started")
 	}
 	s.handler = h
-	s.server.Handler = h
+	s.server.Handler = s.wrapHandler(h)
+}
+
+// SetListener makes OnStart serve on ln instead of binding Config.Port, for
+// example an httptest or in-memory listener in tests. The server closes ln
+// when it stops. This method panics if called after the server has started.
+func (s *Server) SetListener(ln net.Listener) {
+	if s.started.Load() {
+		panic("http: cannot set listener after server started")
+	}
+	s.customLis = ln
+}
+
+// SetShutdownCheck sets the health ShutdownCheck that OnStop marks as
+// shutting down before draining, so readiness fails while connections drain.
+// Call it before the server is stopped.
+func (s *Server) SetShutdownCheck(sc *health.ShutdownCheck) {
+	s.shutdownCheck = sc
 }
 
 // OnStart binds the port synchronously and then serves in a background goroutine.
 // Returns an error immediately if the port cannot be bound (e.g., already in use).
+// A listener set with SetListener is used instead of binding.
 // Implements di.Starter interface.
 func (s *Server) OnStart(ctx context.Context) error {
-	var lc net.ListenConfig
-	ln, err := lc.Listen(ctx, "tcp", s.server.Addr)
-	if err != nil {
-		return fmt.Errorf("http server listen: %w", err)
+	ln := s.customLis
+	if ln == nil {
+		var lc net.ListenConfig
+		var err error
+		ln, err = lc.Listen(ctx, "tcp", s.server.Addr)
+		if err != nil {
+			return fmt.Errorf("http server listen: %w", err)
+		}
 	}
 	s.listener = ln
 	s.started.Store(true)
@@ -82,11 +166,27 @@ func (s *Server) OnStart(ctx context.Context) error {
 }
 
 // OnStop gracefully shuts down the HTTP server.
-// It waits for active connections to complete within the context deadline.
+// If a ShutdownCheck is set, readiness is failed first and OnStop waits
+// Config.PreDrainDelay (or until ctx is done) so load balancers stop routing
+// before listeners close. It then waits for active connections to complete
+// within the context deadline.
 // Implements di.Stopper interface.
 func (s *Server) OnStop(ctx context.Context) error {
 	s.logger.InfoContext(ctx, "HTTP server stopping")
 
+	if s.shutdownCheck != nil {
+		s.shutdownCheck.MarkShuttingDown()
+	}
+	if s.config.PreDrainDelay > 0 {
+		s.logger.InfoContext(ctx, "HTTP server draining", "delay", s.config.PreDrainDelay)
+		timer := time.NewTimer(s.config.PreDrainDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+
 	if err := s.server.Shutdown(ctx); err != nil {
 		return fmt.Errorf("shutdown http server: %w", err)
 	}
@@ -1,16 +1,24 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/net/http2"
+
+	"github.com/petabytecl/gaz/health"
 )
 
 // HTTPServerTestSuite tests the HTTP server lifecycle and functionality.
@@ -298,6 +306,117 @@ func (s *HTTPServerTestSuite) TestHTTPServerGracefulShutdown() {
 	s.Require().NoError(err, "Request should complete successfully during graceful shutdown")
 }
 
+// h2cClient returns a client that speaks HTTP/2 over cleartext.
+func h2cClient() *http.Client {
+	return &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+}
+
+// startProtoServer starts a server with H2C set to h2cEnabled whose handler
+// responds with the request protocol.
+func (s *HTTPServerTestSuite) startProtoServer(h2cEnabled bool) *Server {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.H2C = h2cEnabled
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Proto))
+	})
+
+	server := NewServer(cfg, handler, slog.Default())
+	s.Require().NoError(server.OnStart(context.Background()))
+	s.T().Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	})
+	return server
+}
+
+// getProto issues a GET with client and returns the protocol the handler saw.
+func (s *HTTPServerTestSuite) getProto(client *http.Client, server *Server) (string, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://"+server.Addr()+"/", nil)
+	s.Require().NoError(err)
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerH2C() {
+	server := s.startProtoServer(true)
+
+	proto, err := s.getProto(h2cClient(), server)
+	s.Require().NoError(err)
+	s.Equal("HTTP/2.0", proto)
+
+	// Plain HTTP/1.1 clients are unaffected
+	proto, err = s.getProto(http.DefaultClient, server)
+	s.Require().NoError(err)
+	s.Equal("HTTP/1.1", proto)
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerH2CDisabledByDefault() {
+	server := s.startProtoServer(false)
+
+	_, err := s.getProto(h2cClient(), server)
+	s.Require().Error(err, "h2c requests should fail without H2C")
+
+	proto, err := s.getProto(http.DefaultClient, server)
+	s.Require().NoError(err)
+	s.Equal("HTTP/1.1", proto)
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerH2CGracefulShutdown() {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.H2C = true
+
+	requestStarted := make(chan struct{})
+	requestComplete := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(requestStarted)
+		<-requestComplete
+		_, _ = w.Write([]byte(r.Proto))
+	})
+
+	server := NewServer(cfg, handler, slog.Default())
+	s.Require().NoError(server.OnStart(context.Background()))
+
+	type result struct {
+		proto string
+		err   error
+	}
+	requestDone := make(chan result, 1)
+	go func() {
+		proto, err := s.getProto(h2cClient(), server)
+		requestDone <- result{proto, err}
+	}()
+	<-requestStarted
+
+	shutdownDone := make(chan error, 1)
+	go func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdownDone <- server.OnStop(stopCtx)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	close(requestComplete)
+
+	s.Require().NoError(<-shutdownDone, "Graceful shutdown should complete without error")
+	res := <-requestDone
+	s.Require().NoError(res.err, "In-flight h2c request should complete during shutdown")
+	s.Equal("HTTP/2.0", res.proto)
+}
+
 func (s *HTTPServerTestSuite) TestHTTPServerSetHandler() {
 	// Setup.
 	cfg := DefaultConfig()
@@ -379,6 +498,70 @@ func (s *HTTPServerTestSuite) TestHTTPServerAddrAndPort() {
 	s.Equal(8123, server.Port())
 }
 
+func (s *HTTPServerTestSuite) TestHTTPServerPreDrainFailsReadinessFirst() {
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	cfg.PreDrainDelay = 300 * time.Millisecond
+
+	shutdownCheck := health.NewShutdownCheck()
+	manager := health.NewManager()
+	manager.AddReadinessCheck("shutdown", shutdownCheck.Check)
+	readiness := manager.NewReadinessHandler()
+
+	readinessCode := func() int {
+		rec := httptest.NewRecorder()
+		readiness.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		return rec.Code
+	}
+
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), slog.Default())
+	server.SetShutdownCheck(shutdownCheck)
+
+	ctx := context.Background()
+	s.Require().NoError(server.OnStart(ctx))
+	s.Require().Equal(http.StatusOK, readinessCode())
+
+	start := time.Now()
+	stopped := make(chan error, 1)
+	go func() { stopped <- server.OnStop(ctx) }()
+
+	// Readiness flips to 503 while the server is still serving.
+	s.Require().Eventually(func() bool {
+		return readinessCode() == http.StatusServiceUnavailable
+	}, time.Second, 10*time.Millisecond)
+
+	resp, err := http.Get("http://" + server.Addr() + "/")
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	select {
+	case err := <-stopped:
+		s.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("OnStop did not return")
+	}
+	s.GreaterOrEqual(time.Since(start), cfg.PreDrainDelay)
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerPreDrainRespectsDeadline() {
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	cfg.PreDrainDelay = time.Minute
+
+	server := NewServer(cfg, nil, slog.Default())
+	s.Require().NoError(server.OnStart(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_ = server.OnStop(ctx)
+	s.Less(time.Since(start), 5*time.Second)
+}
+
 // getFreePort finds an available port for testing.
 func getFreePort(t *testing.T) int {
 	t.Helper()
@@ -389,3 +572,267 @@ func getFreePort(t *testing.T) int {
 	defer func() { _ = lis.Close() }()
 	return lis.Addr().(*net.TCPAddr).Port
 }
+
+// corsPreflight sends a preflight request from origin through server's handler.
+func corsPreflight(server *Server, origin string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodOptions, "/api", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	rec := httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerCORSPreflight() {
+	cfg := DefaultConfig()
+	corsCfg := DefaultCORSConfig(false)
+	corsCfg.AllowedOrigins = []string{"https://app.example.com"}
+	cfg.CORS = &corsCfg
+
+	called := false
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusTeapot)
+	}), slog.Default())
+
+	rec := corsPreflight(server, "https://app.example.com")
+	s.Equal(http.StatusNoContent, rec.Code)
+	s.Equal("https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	s.Contains(rec.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+	s.False(called, "preflight must not reach the handler")
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerCORSProdRejectsUnknownOrigin() {
+	cfg := DefaultConfig()
+	corsCfg := DefaultCORSConfig(false)
+	corsCfg.AllowedOrigins = []string{"https://app.example.com"}
+	cfg.CORS = &corsCfg
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), slog.Default())
+
+	rec := corsPreflight(server, "https://evil.example.com")
+	s.Empty(rec.Header().Get("Access-Control-Allow-Origin"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	rec = httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, req)
+	s.Empty(rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerCORSDevModeAllowsAll() {
+	cfg := DefaultConfig()
+	cfg.DevMode = true
+	corsCfg := DefaultCORSConfig(false) // Ignored in dev mode.
+	cfg.CORS = &corsCfg
+	server := NewServer(cfg, nil, slog.Default())
+
+	rec := corsPreflight(server, "https://anything.example.com")
+	s.Equal("*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerCORSDisabledByDefault() {
+	server := NewServer(DefaultConfig(), nil, slog.Default())
+
+	rec := corsPreflight(server, "https://app.example.com")
+	s.Empty(rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerMaxBodyBytes() {
+	cfg := DefaultConfig()
+	cfg.MaxBodyBytes = 8
+	var readErr error
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, readErr = io.ReadAll(r.Body)
+		if readErr != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}), slog.Default())
+
+	// Declared oversized bodies are rejected before the handler runs.
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789"))
+	rec := httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, req)
+	s.Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	s.NoError(readErr, "handler must not run")
+
+	// Bodies without a Content-Length are capped while reading.
+	req = httptest.NewRequest(http.MethodPost, "/", io.NopCloser(strings.NewReader("0123456789")))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, req)
+	s.Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	var maxErr *http.MaxBytesError
+	s.Require().ErrorAs(readErr, &maxErr)
+	s.Equal(int64(8), maxErr.Limit)
+
+	// Bodies within the limit pass.
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("01234567"))
+	rec = httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, req)
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerMaxHeaderBytes() {
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	cfg.MaxHeaderBytes = 1024
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), slog.Default())
+
+	ctx := context.Background()
+	s.Require().NoError(server.OnStart(ctx))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+
+	get := func(headerSize int) int {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+server.Addr()+"/", nil)
+		s.Require().NoError(err)
+		req.Header.Set("X-Padding", strings.Repeat("a", headerSize))
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	s.Equal(http.StatusOK, get(512))
+	// net/http allows 4 KiB of slack on top of MaxHeaderBytes.
+	s.Equal(http.StatusRequestHeaderFieldsTooLarge, get(16<<10))
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerRecoversPanics() {
+	var logs syncBuffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/panic", func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cfg := DefaultConfig()
+	cfg.Port = 0
+	server := NewServer(cfg, mux, logger)
+	ctx := context.Background()
+	s.Require().NoError(server.OnStart(ctx))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(stopCtx)
+	}()
+
+	get := func(path string) int {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+server.Addr()+path, nil)
+		s.Require().NoError(err)
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	s.Equal(http.StatusInternalServerError, get("/panic"))
+	out := logs.String()
+	s.Contains(out, "panic recovered in HTTP handler")
+	s.Contains(out, "panic=boom")
+	s.Contains(out, "path=/panic")
+	s.Contains(out, "goroutine")
+
+	// The server keeps serving after a panic.
+	s.Equal(http.StatusOK, get("/ok"))
+	s.Equal(http.StatusInternalServerError, get("/panic"))
+}
+
+func (s *HTTPServerTestSuite) TestHTTPServerRecoveryDisabled() {
+	cfg := DefaultConfig()
+	cfg.DisableRecovery = true
+	server := NewServer(cfg, http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}), slog.Default())
+
+	s.PanicsWithValue("boom", func() {
+		server.server.Handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func (s *HTTPServerTestSuite) TestRecoveryReraisesAbortHandler() {
+	h := Recovery(slog.Default())(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	s.PanicsWithValue(http.ErrAbortHandler, func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func (s *HTTPServerTestSuite) TestRecoveryKeepsStartedResponse() {
+	h := Recovery(slog.New(slog.DiscardHandler))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("partial"))
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Equal("partial", rec.Body.String())
+}
+
+func (s *HTTPServerTestSuite) TestDefaultMiddlewareKeepsOptionalInterfaces() {
+	cfg := DefaultConfig()
+	cfg.AccessLog = true
+	var flusher, hijacker bool
+	server := NewServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, flusher = w.(http.Flusher)
+		_, hijacker = w.(http.Hijacker)
+		_, _ = w.Write([]byte("event: ping\n\n"))
+		w.(http.Flusher).Flush()
+	}), slog.New(slog.DiscardHandler))
+
+	rec := httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	s.True(flusher, "handlers behind recovery and access logging can flush")
+	s.True(hijacker, "handlers behind recovery and access logging can hijack")
+	s.True(rec.Flushed)
+}
+
+func (s *HTTPServerTestSuite) TestRecoveryAfterFlushKeepsResponse() {
+	h := Recovery(slog.New(slog.DiscardHandler))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.(http.Flusher).Flush()
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Empty(rec.Body.String())
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes from handlers.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
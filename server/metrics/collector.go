@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/petabytecl/gaz"
+)
+
+// namespace prefixes every metric exported by this package.
+const namespace = "gaz"
+
+// appCollector exports a gaz.AppMetrics snapshot, taken on every scrape.
+type appCollector struct {
+	source gaz.MetricsSource
+
+	services        *prometheus.Desc
+	startupDuration *prometheus.Desc
+	workers         *prometheus.Desc
+	workerRestarts  *prometheus.Desc
+	circuitOpen     *prometheus.Desc
+	cronJobs        *prometheus.Desc
+	cronRuns        *prometheus.Desc
+	cronRunning     *prometheus.Desc
+	cronLastRun     *prometheus.Desc
+	busPublished    *prometheus.Desc
+	busDelivered    *prometheus.Desc
+	busDropped      *prometheus.Desc
+	busQueueDepth   *prometheus.Desc
+	busSubscribers  *prometheus.Desc
+	eventPublished  *prometheus.Desc
+	eventDelivered  *prometheus.Desc
+	eventDropped    *prometheus.Desc
+	eventQueueDepth *prometheus.Desc
+}
+
+// NewAppCollector returns a prometheus.Collector that exports the service,
+// worker, cron, and EventBus counters of source. A snapshot is taken with
+// source.Metrics() on every scrape, so values are never stale.
+//
+// Exported metrics:
+//   - gaz_app_services, gaz_app_startup_duration_seconds
+//   - gaz_worker_supervised, gaz_worker_restarts_total, gaz_worker_circuit_open
+//   - gaz_cron_jobs, gaz_cron_job_runs_total{job,result},
+//     gaz_cron_job_running{job}, gaz_cron_job_last_run_timestamp_seconds{job}
+//   - gaz_eventbus_published_total, gaz_eventbus_delivered_total,
+//     gaz_eventbus_dropped_total, gaz_eventbus_queue_depth,
+//     gaz_eventbus_subscriptions
+//   - gaz_eventbus_event_published_total{event,topic},
+//     gaz_eventbus_event_delivered_total{event,topic},
+//     gaz_eventbus_event_dropped_total{event,topic},
+//     gaz_eventbus_event_queue_depth{event,topic}
+//
+// The event label is the event's EventName. The topic label is empty unless
+// the App was created with gaz.WithEventBusTopicMetrics, which bounds the
+// topics that get their own series (see eventbus.WithTopicMetrics).
+func NewAppCollector(source gaz.MetricsSource) prometheus.Collector {
+	desc := func(subsystem, name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, labels, nil)
+	}
+	return &appCollector{
+		source:          source,
+		services:        desc("app", "services", "Number of services registered in the container."),
+		startupDuration: desc("app", "startup_duration_seconds", "Time the last Run took to start services and workers."),
+		workers:         desc("worker", "supervised", "Number of supervised workers."),
+		workerRestarts:  desc("worker", "restarts_total", "Worker restarts since the app was built."),
+		circuitOpen:     desc("worker", "circuit_open", "Number of workers whose circuit breaker has tripped."),
+		cronJobs:        desc("cron", "jobs", "Number of registered cron jobs."),
+		cronRuns:        desc("cron", "job_runs_total", "Cron job executions by result.", "job", "result"),
+		cronRunning:     desc("cron", "job_running", "Whether the cron job is executing (1) or not (0).", "job"),
+		cronLastRun:     desc("cron", "job_last_run_timestamp_seconds", "Unix time the cron job last finished.", "job"),
+		busPublished:    desc("eventbus", "published_total", "Events published to the EventBus."),
+		busDelivered:    desc("eventbus", "delivered_total", "Events delivered to EventBus handlers."),
+		busDropped:      desc("eventbus", "dropped_total", "Events dropped before reaching a handler."),
+		busQueueDepth:   desc("eventbus", "queue_depth", "Events buffered across all subscriptions."),
+		busSubscribers:  desc("eventbus", "subscriptions", "Number of active EventBus subscriptions."),
+		eventPublished:  desc("eventbus", "event_published_total", "Events published by event name and topic.", "event", "topic"),
+		eventDelivered:  desc("eventbus", "event_delivered_total", "Events delivered to handlers by event name and topic.", "event", "topic"),
+		eventDropped:    desc("eventbus", "event_dropped_total", "Events dropped by event name and topic.", "event", "topic"),
+		eventQueueDepth: desc("eventbus", "event_queue_depth", "Events waiting for a handler by event name and topic.", "event", "topic"),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.services, c.startupDuration, c.workers, c.workerRestarts, c.circuitOpen,
+		c.cronJobs, c.cronRuns, c.cronRunning, c.cronLastRun,
+		c.busPublished, c.busDelivered, c.busDropped, c.busQueueDepth, c.busSubscribers,
+		c.eventPublished, c.eventDelivered, c.eventDropped, c.eventQueueDepth,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
+	m := c.source.Metrics()
+
+	gauge := func(d *prometheus.Desc, v float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v, labels...)
+	}
+	counter := func(d *prometheus.Desc, v float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, v, labels...)
+	}
+
+	gauge(c.services, float64(m.Services))
+	gauge(c.startupDuration, m.StartupDuration.Seconds())
+
+	gauge(c.workers, float64(m.Workers.Workers))
+	counter(c.workerRestarts, float64(m.Workers.Restarts))
+	gauge(c.circuitOpen, float64(m.Workers.CircuitOpen))
+
+	gauge(c.cronJobs, float64(m.JobCount()))
+	for _, job := range m.Jobs {
+		counter(c.cronRuns, float64(job.Runs-job.Failures), job.Name, "success")
+		counter(c.cronRuns, float64(job.Failures), job.Name, "failure")
+		running := 0.0
+		if job.Running {
+			running = 1
+		}
+		gauge(c.cronRunning, running, job.Name)
+		if !job.LastRun.IsZero() {
+			gauge(c.cronLastRun, float64(job.LastRun.UnixNano())/1e9, job.Name)
+		}
+	}
+
+	counter(c.busPublished, float64(m.EventBus.Published))
+	counter(c.busDelivered, float64(m.EventBus.Delivered))
+	counter(c.busDropped, float64(m.EventBus.Dropped))
+	gauge(c.busQueueDepth, float64(m.EventBus.QueueDepth))
+	gauge(c.busSubscribers, float64(m.EventBus.Subscriptions))
+	for _, e := range m.EventBus.Events {
+		counter(c.eventPublished, float64(e.Published), e.Event, e.Topic)
+		counter(c.eventDelivered, float64(e.Delivered), e.Event, e.Topic)
+		counter(c.eventDropped, float64(e.Dropped), e.Event, e.Topic)
+		gauge(c.eventQueueDepth, float64(e.QueueDepth), e.Event, e.Topic)
+	}
+}
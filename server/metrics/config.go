@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// DefaultPort is the default metrics server port.
+const DefaultPort = 9090
+
+// DefaultPath is the default path metrics are served on.
+const DefaultPath = "/metrics"
+
+// MaxPort is the maximum valid port number.
+const MaxPort = 65535
+
+// Config holds configuration for the metrics server.
+type Config struct {
+	// Port is the TCP port the metrics server listens on.
+	// Defaults to 9090.
+	Port int `json:"port" yaml:"port" mapstructure:"port"`
+
+	// Path is the HTTP path metrics are served on.
+	// Defaults to "/metrics".
+	Path string `json:"path" yaml:"path" mapstructure:"path"`
+}
+
+// DefaultConfig returns a Config serving DefaultPath on DefaultPort.
+func DefaultConfig() Config {
+	return Config{
+		Port: DefaultPort,
+		Path: DefaultPath,
+	}
+}
+
+// Namespace returns the config namespace.
+func (c *Config) Namespace() string {
+	return "metrics"
+}
+
+// Flags registers the config flags.
+func (c *Config) Flags(fs *pflag.FlagSet) {
+	fs.IntVar(&c.Port, "metrics-port", c.Port, "Metrics server port")
+	fs.StringVar(&c.Path, "metrics-path", c.Path, "HTTP path metrics are served on")
+}
+
+// SetDefaults applies default values to zero-value fields.
+// Implements the config.Defaulter interface.
+func (c *Config) SetDefaults() {
+	if c.Port == 0 {
+		c.Port = DefaultPort
+	}
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+}
+
+// Validate checks that the configuration is valid.
+// Implements the config.Validator interface.
+func (c *Config) Validate() error {
+	if c.Port < 0 {
+		return errors.New("metrics: port must not be negative")
+	}
+	if c.Port > MaxPort {
+		return errors.New("metrics: port must be less than or equal to 65535")
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		return errors.New("metrics: path must start with /")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package metrics exports gaz subsystem and request metrics in the
+// Prometheus exposition format on a dedicated port.
+//
+// # Overview
+//
+// The module registers a *prometheus.Registry with:
+//
+//   - Go runtime and process collectors
+//   - A collector for gaz.App.Metrics: services, startup duration, worker
+//     restarts, cron run results, and EventBus published, delivered,
+//     dropped, and queued events, in total and per event name (and topic,
+//     see gaz.WithEventBusTopicMetrics)
+//   - Request counters for HTTP (via RequestMetrics.HTTPMiddleware) and gRPC
+//     (RequestMetrics is an auto-discovered grpc.InterceptorBundle)
+//
+// The Prometheus client is only a dependency of this package; applications
+// that do not import it do not link it.
+//
+// # Usage
+//
+//	app := gaz.New()
+//	app.Use(grpc.NewModule())
+//	app.Use(metrics.NewModule())
+//	// gRPC calls are counted automatically; scrape http://host:9090/metrics
+//
+// Count HTTP requests by wrapping the handler:
+//
+//	gaz.For[http.Handler](c).Provider(func(c *gaz.Container) (http.Handler, error) {
+//	    rm, err := gaz.Resolve[*metrics.RequestMetrics](c)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return rm.HTTPMiddleware()(mux), nil
+//	})
+//
+// Register application collectors on the same registry:
+//
+//	reg, _ := gaz.Resolve[*prometheus.Registry](c)
+//	reg.MustRegister(myCounter)
+//
+// # Configuration
+//
+//	metrics:
+//	  port: 9090
+//	  path: /metrics
+package metrics
@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"github.com/petabytecl/gaz"
+)
+
+// ModuleOption configures the metrics module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	port int
+	path string
+}
+
+// WithPort sets the default Config.Port. Config files and flags still
+// override it.
+func WithPort(port int) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.port = port
+	}
+}
+
+// WithPath sets the default Config.Path. Config files and flags still
+// override it.
+func WithPath(path string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.path = path
+	}
+}
+
+// NewModule creates a metrics module.
+// Returns a gaz.Module that registers the Prometheus registry and server.
+//
+// Components registered:
+//   - metrics.Config (loaded from flags/config)
+//   - *prometheus.Registry (Go runtime, process, and gaz subsystem collectors)
+//   - *metrics.RequestMetrics (HTTP middleware and gRPC interceptor bundle)
+//   - *metrics.Server (eager, starts on app start)
+//
+// Example:
+//
+//	app := gaz.New()
+//	app.Use(metrics.NewModule())
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{port: DefaultPort, path: DefaultPath}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
+	defaultCfg := DefaultConfig()
+	defaultCfg.Port = mc.port
+	defaultCfg.Path = mc.path
+
+	return gaz.NewModule("metrics").
+		Flags(defaultCfg.Flags).
+		Provide(func(c *gaz.Container) error {
+			return gaz.For[Config](c).Provider(func(c *gaz.Container) (Config, error) {
+				cfg := defaultCfg
+
+				if pv, err := gaz.Resolve[*gaz.ProviderValues](c); err == nil {
+					if unmarshalErr := pv.UnmarshalKey(defaultCfg.Namespace(), &cfg); unmarshalErr != nil {
+						// ignore error, use defaults
+						_ = unmarshalErr
+					}
+				}
+
+				if err := cfg.Validate(); err != nil {
+					return Config{}, fmt.Errorf("metrics config validate: %w", err)
+				}
+
+				return cfg, nil
+			})
+		}).
+		Provide(provideRegistry).
+		Provide(provideRequestMetrics).
+		Provide(provideServer).
+		Build()
+}
+
+// provideRegistry registers a *prometheus.Registry with the Go runtime and
+// process collectors, plus the app collector when the App is available.
+func provideRegistry(c *gaz.Container) error {
+	return gaz.For[*prometheus.Registry](c).Provider(func(c *gaz.Container) (*prometheus.Registry, error) {
+		reg := prometheus.NewRegistry()
+		reg.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+		if source, err := gaz.Resolve[gaz.MetricsSource](c); err == nil {
+			if regErr := reg.Register(NewAppCollector(source)); regErr != nil {
+				return nil, fmt.Errorf("register app collector: %w", regErr)
+			}
+		}
+		return reg, nil
+	})
+}
+
+// provideRequestMetrics registers the *RequestMetrics counters.
+func provideRequestMetrics(c *gaz.Container) error {
+	return gaz.For[*RequestMetrics](c).Provider(func(c *gaz.Container) (*RequestMetrics, error) {
+		reg, err := gaz.Resolve[*prometheus.Registry](c)
+		if err != nil {
+			return nil, fmt.Errorf("resolve metrics registry: %w", err)
+		}
+		return NewRequestMetrics(reg)
+	})
+}
+
+// provideServer registers the eager *Server.
+func provideServer(c *gaz.Container) error {
+	return gaz.For[*Server](c).
+		Eager().
+		Provider(func(c *gaz.Container) (*Server, error) {
+			cfg, err := gaz.Resolve[Config](c)
+			if err != nil {
+				return nil, fmt.Errorf("resolve metrics config: %w", err)
+			}
+
+			reg, err := gaz.Resolve[*prometheus.Registry](c)
+			if err != nil {
+				return nil, fmt.Errorf("resolve metrics registry: %w", err)
+			}
+
+			logger, err := gaz.Resolve[*slog.Logger](c)
+			if err != nil {
+				logger = slog.Default()
+			}
+
+			return NewServer(cfg, reg, logger), nil
+		})
+}
@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/cron"
+	"github.com/petabytecl/gaz/di"
+	"github.com/petabytecl/gaz/eventbus"
+	gazgrpc "github.com/petabytecl/gaz/server/grpc"
+)
+
+type failingJob struct{}
+
+func (failingJob) Name() string                { return "report" }
+func (failingJob) Schedule() string            { return "@hourly" }
+func (failingJob) Timeout() time.Duration      { return time.Second }
+func (failingJob) Run(_ context.Context) error { return errors.New("upstream down") }
+
+type pingEvent struct{}
+
+func (pingEvent) EventName() string { return "pingEvent" }
+
+func TestNewModule_Defaults(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+
+	cfg, err := di.Resolve[Config](app.Container())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), cfg)
+
+	bundles, err := di.ResolveAll[gazgrpc.InterceptorBundle](app.Container())
+	require.NoError(t, err)
+	require.Len(t, bundles, 1, "RequestMetrics is discovered as an interceptor bundle")
+	assert.Equal(t, "metrics", bundles[0].Name())
+}
+
+func TestNewModule_ExportsAppMetrics(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule(WithPort(0), WithPath("/prom")).Apply(app))
+	require.NoError(t, gaz.For[cron.CronJob](app.Container()).Named("report").Transient().
+		Provider(func(_ *gaz.Container) (cron.CronJob, error) { return failingJob{}, nil }))
+	require.NoError(t, app.Build())
+
+	delivered := make(chan struct{}, 1)
+	eventbus.Subscribe(app.EventBus(), func(_ context.Context, _ pingEvent) {
+		delivered <- struct{}{}
+	})
+
+	err := app.RunOnce(context.Background(), func(ctx context.Context) error {
+		require.Error(t, app.Scheduler().TriggerNow(ctx, "report"))
+		eventbus.Publish(ctx, app.EventBus(), pingEvent{}, "")
+		<-delivered
+
+		s, err := di.Resolve[*Server](app.Container())
+		require.NoError(t, err)
+		code, body := scrape(t, s.Addr(), "/prom")
+		assert.Equal(t, 200, code)
+		for _, want := range []string{
+			"go_goroutines",
+			"gaz_app_services",
+			"gaz_app_startup_duration_seconds",
+			"gaz_worker_supervised 1",
+			"gaz_worker_restarts_total 0",
+			"gaz_worker_circuit_open 0",
+			"gaz_cron_jobs 1",
+			`gaz_cron_job_runs_total{job="report",result="failure"} 1`,
+			`gaz_cron_job_runs_total{job="report",result="success"} 0`,
+			`gaz_cron_job_last_run_timestamp_seconds{job="report"}`,
+			"gaz_eventbus_published_total",
+			"gaz_eventbus_delivered_total",
+			"gaz_eventbus_dropped_total 0",
+			"gaz_eventbus_queue_depth",
+			"gaz_eventbus_subscriptions 1",
+		} {
+			assert.Contains(t, body, want)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestNewModule_ExportsPerEventMetrics(t *testing.T) {
+	app := gaz.New(gaz.WithEventBusTopicMetrics(10))
+	require.NoError(t, NewModule(WithPort(0)).Apply(app))
+	require.NoError(t, app.Build())
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	eventbus.Subscribe(app.EventBus(), func(_ context.Context, _ pingEvent) {
+		started <- struct{}{}
+		<-release
+	}, eventbus.WithBufferSize(1))
+
+	err := app.RunOnce(context.Background(), func(ctx context.Context) error {
+		defer close(release)
+
+		eventbus.Publish(ctx, app.EventBus(), pingEvent{}, "health")
+		<-started // Handler is busy
+		eventbus.Publish(ctx, app.EventBus(), pingEvent{}, "health")
+
+		// The buffer is full, so this publish is dropped when its context ends.
+		dropCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		eventbus.Publish(dropCtx, app.EventBus(), pingEvent{}, "health")
+
+		s, err := di.Resolve[*Server](app.Container())
+		require.NoError(t, err)
+		_, body := scrape(t, s.Addr(), DefaultPath)
+		for _, want := range []string{
+			`gaz_eventbus_event_published_total{event="pingEvent",topic="health"} 3`,
+			`gaz_eventbus_event_delivered_total{event="pingEvent",topic="health"} 0`,
+			`gaz_eventbus_event_dropped_total{event="pingEvent",topic="health"} 1`,
+			`gaz_eventbus_event_queue_depth{event="pingEvent",topic="health"} 1`,
+		} {
+			assert.Contains(t, body, want)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestNewModule_RegistryAcceptsCustomCollectors(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+
+	reg, err := di.Resolve[*prometheus.Registry](app.Container())
+	require.NoError(t, err)
+	require.NoError(t, reg.Register(prometheus.NewCounter(prometheus.CounterOpts{Name: "app_custom_total", Help: "Custom."})))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	names := make([]string, 0, len(families))
+	for _, f := range families {
+		names = append(names, f.GetName())
+	}
+	assert.Contains(t, names, "app_custom_total")
+	assert.Contains(t, names, "gaz_app_services")
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Port = -1
+	require.Error(t, cfg.Validate())
+
+	cfg.Port = MaxPort + 1
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.Path = "metrics"
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,130 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	gazgrpc "github.com/petabytecl/gaz/server/grpc"
+)
+
+// PriorityMetrics is the interceptor priority of RequestMetrics: after
+// logging, before rate limiting and auth, so rejected calls are counted too.
+const PriorityMetrics = gazgrpc.PriorityLogging + 1
+
+// RequestMetrics counts HTTP and gRPC requests.
+//
+// It implements grpc.InterceptorBundle, so registering it in the container
+// (as NewModule does) makes the gRPC server count every call. HTTP handlers
+// are counted by wrapping them with HTTPMiddleware.
+//
+// Exported metrics:
+//   - gaz_http_requests_total{method,code}
+//   - gaz_grpc_requests_total{method,code}
+type RequestMetrics struct {
+	http *prometheus.CounterVec
+	grpc *prometheus.CounterVec
+}
+
+// NewRequestMetrics creates request counters and registers them with reg.
+func NewRequestMetrics(reg prometheus.Registerer) (*RequestMetrics, error) {
+	m := &RequestMetrics{
+		http: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP requests by method and status code.",
+		}, []string{"method", "code"}),
+		grpc: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "gRPC calls by full method name and status code.",
+		}, []string{"method", "code"}),
+	}
+	for _, c := range []prometheus.Collector{m.http, m.grpc} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// HTTPMiddleware returns middleware that counts each request by method and
+// response status code.
+//
+// Example:
+//
+//	handler := requestMetrics.HTTPMiddleware()(mux)
+//	gaz.For[http.Handler](c).Instance(handler)
+func (m *RequestMetrics) HTTPMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rw, r)
+			m.http.WithLabelValues(r.Method, strconv.Itoa(rw.status)).Inc()
+		})
+	}
+}
+
+// Name returns the bundle identifier.
+// Implements grpc.InterceptorBundle.
+func (m *RequestMetrics) Name() string {
+	return "metrics"
+}
+
+// Priority returns PriorityMetrics.
+// Implements grpc.InterceptorBundle.
+func (m *RequestMetrics) Priority() int {
+	return PriorityMetrics
+}
+
+// Interceptors returns interceptors that count each call by full method
+// name and status code.
+// Implements grpc.InterceptorBundle.
+func (m *RequestMetrics) Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		m.grpc.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return resp, err
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		m.grpc.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return err
+	}
+	return unary, stream
+}
+
+// statusRecorder wraps http.ResponseWriter to capture the status code.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and forwards it.
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Write forwards body bytes. An implicit 200 is recorded if WriteHeader was
+// not called.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController
+// can reach optional interfaces such as http.Flusher.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
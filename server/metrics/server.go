@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultReadHeaderTimeout is the read header timeout for the metrics server.
+const DefaultReadHeaderTimeout = 5 * time.Second
+
+// Server serves a prometheus.Gatherer in the Prometheus exposition format
+// on a dedicated port. It implements di.Starter and di.Stopper.
+type Server struct {
+	config   Config
+	server   *http.Server
+	listener net.Listener
+	logger   *slog.Logger
+}
+
+// NewServer creates a new metrics server for gatherer.
+// If logger is nil, slog.Default() is used.
+func NewServer(cfg Config, gatherer prometheus.Gatherer, logger *slog.Logger) *Server {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Server{
+		config: cfg,
+		server: &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.Port),
+			Handler:           Handler(cfg.Path, gatherer),
+			ReadHeaderTimeout: DefaultReadHeaderTimeout,
+		},
+		logger: logger,
+	}
+}
+
+// Handler returns a handler serving gatherer's metrics on path.
+// It does not use http.DefaultServeMux.
+func Handler(path string, gatherer prometheus.Gatherer) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+	return mux
+}
+
+// OnStart binds the port synchronously and serves in a background goroutine.
+// Implements di.Starter interface.
+func (s *Server) OnStart(ctx context.Context) error {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("metrics server listen: %w", err)
+	}
+	s.listener = ln
+	s.logger.InfoContext(ctx, "Metrics server starting", "addr", ln.Addr().String(), "path", s.config.Path)
+
+	go func() {
+		if serveErr := s.server.Serve(ln); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("Metrics server error", "error", serveErr)
+		}
+	}()
+
+	return nil
+}
+
+// OnStop gracefully shuts down the metrics server.
+// It does nothing when the server was never started.
+// Implements di.Stopper interface.
+func (s *Server) OnStop(ctx context.Context) error {
+	if s.listener == nil {
+		return nil
+	}
+
+	s.logger.InfoContext(ctx, "Metrics server stopping")
+	if err := s.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown metrics server: %w", err)
+	}
+	return nil
+}
+
+// Addr returns the server's bound address.
+// After OnStart, this returns the actual listener address (useful when port=0).
+// Before OnStart, it returns the configured address ":port".
+func (s *Server) Addr() string {
+	if s.listener != nil {
+		return s.listener.Addr().String()
+	}
+	return s.server.Addr
+}
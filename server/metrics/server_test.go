@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startServer starts a metrics server for reg on a random port.
+func startServer(t *testing.T, reg prometheus.Gatherer) *Server {
+	t.Helper()
+	s := NewServer(Config{Port: 0, Path: DefaultPath}, reg, nil)
+	require.NoError(t, s.OnStart(context.Background()))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.OnStop(ctx)
+	})
+	return s
+}
+
+func scrape(t *testing.T, addr, path string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://"+addr+path, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestServer_ServesRegistry(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "custom_total", Help: "Custom."})
+	reg.MustRegister(counter)
+	counter.Add(3)
+
+	s := startServer(t, reg)
+
+	code, body := scrape(t, s.Addr(), DefaultPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "custom_total 3")
+
+	code, _ = scrape(t, s.Addr(), "/other")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestServer_StopWithoutStart(t *testing.T) {
+	s := NewServer(DefaultConfig(), prometheus.NewRegistry(), nil)
+	require.NoError(t, s.OnStop(context.Background()))
+	assert.Equal(t, ":9090", s.Addr())
+}
+
+func TestRequestMetrics_HTTPMiddleware(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	rm, err := NewRequestMetrics(reg)
+	require.NoError(t, err)
+
+	handler := rm.HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	for _, path := range []string{"/", "/", "/missing"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	s := startServer(t, reg)
+	_, body := scrape(t, s.Addr(), DefaultPath)
+	assert.Contains(t, body, `gaz_http_requests_total{code="200",method="GET"} 2`)
+	assert.Contains(t, body, `gaz_http_requests_total{code="404",method="GET"} 1`)
+}
+
+func TestRequestMetrics_GRPCInterceptors(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	rm, err := NewRequestMetrics(reg)
+	require.NoError(t, err)
+	assert.Equal(t, "metrics", rm.Name())
+	assert.Equal(t, PriorityMetrics, rm.Priority())
+
+	unary, stream := rm.Interceptors()
+	info := &grpc.UnaryServerInfo{FullMethod: "/echo.v1.Echo/Say"}
+	_, err = unary(context.Background(), nil, info, func(context.Context, any) (any, error) { return "ok", nil })
+	require.NoError(t, err)
+	_, err = unary(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.NotFound, "nope")
+	})
+	require.Error(t, err)
+	err = stream(nil, nil, &grpc.StreamServerInfo{FullMethod: "/echo.v1.Echo/Stream"}, func(any, grpc.ServerStream) error {
+		return errors.New("boom")
+	})
+	require.Error(t, err)
+
+	s := startServer(t, reg)
+	_, body := scrape(t, s.Addr(), DefaultPath)
+	assert.Contains(t, body, `gaz_grpc_requests_total{code="OK",method="/echo.v1.Echo/Say"} 1`)
+	assert.Contains(t, body, `gaz_grpc_requests_total{code="NotFound",method="/echo.v1.Echo/Say"} 1`)
+	assert.Contains(t, body, `gaz_grpc_requests_total{code="Unknown",method="/echo.v1.Echo/Stream"} 1`)
+}
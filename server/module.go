@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/health"
 	"github.com/petabytecl/gaz/server/grpc"
 	"github.com/petabytecl/gaz/server/vanguard"
 )
@@ -34,6 +35,36 @@ func forceSkipListener(c *gaz.Container) error {
 	return nil
 }
 
+// provideReadiness registers the Readiness aggregator as an eager service and,
+// when the health module is present, adds it as the "server" readiness check.
+func provideReadiness(c *gaz.Container) error {
+	if err := gaz.For[*Readiness](c).Eager().Provider(func(c *gaz.Container) (*Readiness, error) {
+		grpcSrv, err := gaz.Resolve[*grpc.Server](c)
+		if err != nil {
+			return nil, fmt.Errorf("resolve grpc server: %w", err)
+		}
+		vgSrv, err := gaz.Resolve[*vanguard.Server](c)
+		if err != nil {
+			return nil, fmt.Errorf("resolve vanguard server: %w", err)
+		}
+
+		r := NewReadiness(grpcSrv, vgSrv)
+
+		if gaz.Has[*health.Manager](c) {
+			manager, resolveErr := gaz.Resolve[*health.Manager](c)
+			if resolveErr != nil {
+				return nil, fmt.Errorf("resolve health manager: %w", resolveErr)
+			}
+			manager.AddReadinessCheck("server", r.Check)
+		}
+
+		return r, nil
+	}); err != nil {
+		return fmt.Errorf("register server readiness: %w", err)
+	}
+	return nil
+}
+
 // NewModule creates a unified server module.
 // Returns a gaz.Module that bundles gRPC and Vanguard modules with gRPC
 // SkipListener automatically set to true.
@@ -50,6 +81,11 @@ func forceSkipListener(c *gaz.Container) error {
 //   - Vanguard stops first (drains HTTP connections)
 //   - gRPC stops second (closes service registrations)
 //
+// Readiness:
+//   - A Readiness aggregator reports ready once gRPC has registered its
+//     services and Vanguard is listening with every registrar mounted
+//   - If the health module is present, it is added as the "server" readiness check
+//
 // Configuration:
 //   - gRPC: "grpc-port", "grpc-reflection", "grpc-dev-mode" flags (port unused with SkipListener)
 //   - Vanguard: "vanguard-address", "vanguard-dev-mode", CORS and timeout flags
@@ -63,5 +99,6 @@ func NewModule() gaz.Module {
 		Use(grpc.NewModule()).
 		Use(vanguard.NewModule()).
 		Provide(forceSkipListener).
+		Provide(provideReadiness).
 		Build()
 }
@@ -27,6 +27,10 @@ type Config struct {
 	// Default: 0.1 (10%).
 	SampleRatio float64 `json:"sample_ratio" yaml:"sample_ratio" mapstructure:"sample_ratio"`
 
+	// LogsEndpoint is the OTLP/HTTP logs endpoint (e.g., "localhost:4318").
+	// If empty, log export is disabled and the application logger is untouched.
+	LogsEndpoint string `json:"logs_endpoint" yaml:"logs_endpoint" mapstructure:"logs_endpoint"`
+
 	// Insecure uses insecure connection to the collector.
 	// Default: true for development.
 	Insecure bool `json:"insecure" yaml:"insecure" mapstructure:"insecure"`
@@ -52,6 +56,7 @@ func (c *Config) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.Endpoint, "otel-endpoint", c.Endpoint, "OTLP endpoint (e.g. localhost:4317)")
 	fs.StringVar(&c.ServiceName, "otel-service-name", c.ServiceName, "Service name for traces")
 	fs.Float64Var(&c.SampleRatio, "otel-sample-ratio", c.SampleRatio, "Sampling ratio for root spans (0.0-1.0)")
+	fs.StringVar(&c.LogsEndpoint, "otel-logs-endpoint", c.LogsEndpoint, "OTLP/HTTP logs endpoint (e.g. localhost:4318)")
 	fs.BoolVar(&c.Insecure, "otel-insecure", c.Insecure, "Use insecure connection to collector")
 }
 
@@ -74,7 +79,7 @@ func (c *Config) Validate() error {
 	if c.SampleRatio < 0 || c.SampleRatio > 1.0 {
 		return fmt.Errorf("otel: invalid sample_ratio %f: must be between 0.0 and 1.0", c.SampleRatio)
 	}
-	if c.Endpoint != "" && c.ServiceName == "" {
+	if (c.Endpoint != "" || c.LogsEndpoint != "") && c.ServiceName == "" {
 		return errors.New("otel: service_name required when endpoint is set")
 	}
 	return nil
@@ -34,9 +34,26 @@
 // will automatically detect and use it for request tracing. Traces propagate
 // across service boundaries using W3C Trace Context headers.
 //
+// # Log Export
+//
+// With a logs endpoint configured, application logs are exported to the
+// collector over OTLP/HTTP alongside traces:
+//
+//	app.Use(otel.NewModule(otel.WithLogsEndpoint("localhost:4318")))
+//
+// The application logger is constructed with a [LogBridge] handler (see
+// logger.HandlerWrapper), so records still reach the normal output and are
+// also batched for export by the OpenTelemetry log SDK. Records logged with
+// a context carrying a span include its trace and span IDs, correlating
+// logs with traces in the backend. Without a logs endpoint
+// (flag --otel-logs-endpoint or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT), the
+// logger is left untouched.
+//
 // # Graceful Degradation
 //
 // If the OTLP collector is unreachable at startup, the package logs a warning
 // and continues without tracing. This ensures applications start gracefully
-// even when observability infrastructure is temporarily unavailable.
+// even when observability infrastructure is temporarily unavailable. Log
+// export never blocks logging: failed exports are reported to the
+// OpenTelemetry error handler and dropped.
 package otel
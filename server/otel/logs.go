@@ -0,0 +1,249 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	// logScopeName is the instrumentation scope of bridged records.
+	logScopeName = "github.com/petabytecl/gaz/server/otel"
+
+	// otlpLogsPath is the OTLP/HTTP logs path used when an endpoint URL
+	// has none.
+	otlpLogsPath = "/v1/logs"
+)
+
+// LogBridge exports slog records through an OpenTelemetry LoggerProvider.
+//
+// LogBridge implements logger.HandlerWrapper, so when it is registered in
+// the container the application logger is constructed with its handler.
+type LogBridge struct {
+	provider *sdklog.LoggerProvider
+	logger   otellog.Logger
+}
+
+// NewLogBridge creates a LogBridge emitting records to provider. The bridge
+// owns the provider and shuts it down in Shutdown.
+func NewLogBridge(provider *sdklog.LoggerProvider) *LogBridge {
+	return &LogBridge{
+		provider: provider,
+		logger:   provider.Logger(logScopeName),
+	}
+}
+
+// Handler returns an slog.Handler that passes every record to next and also
+// emits it to the LoggerProvider. Records logged with a context carrying a
+// span are tagged with its trace and span IDs.
+func (b *LogBridge) Handler(next slog.Handler) slog.Handler {
+	return &logHandler{next: next, logger: b.logger}
+}
+
+// WrapHandler implements logger.HandlerWrapper. A nil LogBridge (log export
+// disabled) returns next unchanged.
+func (b *LogBridge) WrapHandler(next slog.Handler) slog.Handler {
+	if b == nil {
+		return next
+	}
+	return b.Handler(next)
+}
+
+// Shutdown exports buffered records and shuts down the LoggerProvider.
+// Records emitted after Shutdown are dropped.
+func (b *LogBridge) Shutdown(ctx context.Context) error {
+	if err := b.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("otel: shutdown log provider: %w", err)
+	}
+	return nil
+}
+
+// logHandler tees records to the next handler and an OpenTelemetry logger.
+type logHandler struct {
+	next   slog.Handler
+	logger otellog.Logger
+	attrs  []otellog.KeyValue
+	groups []string
+}
+
+// Enabled defers to the next handler so export honours the logger's level.
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle emits the record for export and passes it to the next handler.
+func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
+	var rec otellog.Record
+	rec.SetTimestamp(r.Time)
+	rec.SetObservedTimestamp(time.Now())
+	rec.SetSeverity(severity(r.Level))
+	rec.SetSeverityText(r.Level.String())
+	rec.SetBody(otellog.StringValue(r.Message))
+	rec.AddAttributes(h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		if kv, ok := h.convert(a); ok {
+			rec.AddAttributes(kv)
+		}
+		return true
+	})
+	h.logger.Emit(ctx, rec)
+
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler whose exported records carry attrs.
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.next = h.next.WithAttrs(attrs)
+	clone.attrs = append([]otellog.KeyValue(nil), h.attrs...)
+	for _, a := range attrs {
+		if kv, ok := h.convert(a); ok {
+			clone.attrs = append(clone.attrs, kv)
+		}
+	}
+	return &clone
+}
+
+// WithGroup returns a handler that prefixes exported attribute keys with name.
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.next = h.next.WithGroup(name)
+	clone.groups = append(append([]string(nil), h.groups...), name)
+	return &clone
+}
+
+// convert maps an slog attribute to an OpenTelemetry key-value, prefixing
+// the key with the open groups, dot separated. Empty attributes are dropped.
+func (h *logHandler) convert(a slog.Attr) (otellog.KeyValue, bool) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return otellog.KeyValue{}, false
+	}
+	key := a.Key
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		key = h.groups[i] + "." + key
+	}
+	return otellog.KeyValue{Key: key, Value: convertValue(a.Value)}, true
+}
+
+// convertValue maps a resolved slog value to an OpenTelemetry value.
+func convertValue(v slog.Value) otellog.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return otellog.StringValue(v.String())
+	case slog.KindInt64:
+		return otellog.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return otellog.Int64Value(int64(v.Uint64())) //nolint:gosec // OTLP has no unsigned integers.
+	case slog.KindFloat64:
+		return otellog.Float64Value(v.Float64())
+	case slog.KindBool:
+		return otellog.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return otellog.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindTime:
+		return otellog.Int64Value(v.Time().UnixNano())
+	case slog.KindGroup:
+		group := v.Group()
+		kvs := make([]otellog.KeyValue, 0, len(group))
+		for _, a := range group {
+			a.Value = a.Value.Resolve()
+			kvs = append(kvs, otellog.KeyValue{Key: a.Key, Value: convertValue(a.Value)})
+		}
+		return otellog.MapValue(kvs...)
+	case slog.KindAny, slog.KindLogValuer:
+		if err, ok := v.Any().(error); ok {
+			return otellog.StringValue(err.Error())
+		}
+		return otellog.StringValue(fmt.Sprint(v.Any()))
+	default:
+		return otellog.StringValue(v.String())
+	}
+}
+
+// severity maps an slog level to an OpenTelemetry severity. slog levels are
+// spaced so that DEBUG, INFO, WARN and ERROR land on the matching severities.
+func severity(level slog.Level) otellog.Severity {
+	return otellog.Severity(level + slog.Level(otellog.SeverityInfo))
+}
+
+// InitLogs creates a LogBridge exporting to cfg.LogsEndpoint over OTLP/HTTP.
+//
+// If cfg.LogsEndpoint is empty, returns nil (log export disabled). The
+// endpoint is a host and port (e.g. "localhost:4318") or a URL; a URL
+// without a path posts to /v1/logs. If the exporter cannot be created, logs
+// a warning and returns nil (graceful degradation).
+func InitLogs(ctx context.Context, cfg Config, logger *slog.Logger) *LogBridge {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	if cfg.LogsEndpoint == "" {
+		logger.DebugContext(ctx, "OTEL log export disabled, no logs endpoint configured")
+		return nil
+	}
+
+	exporter, err := otlploghttp.New(ctx, logExporterOptions(cfg)...)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to create OTLP log exporter, log export disabled",
+			slog.Any("error", err),
+			slog.String("endpoint", cfg.LogsEndpoint),
+		)
+		return nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String(cfg.ServiceName),
+		),
+	)
+	if err != nil {
+		_ = exporter.Shutdown(ctx)
+		logger.WarnContext(ctx, "failed to create OTEL resource, log export disabled",
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithResource(res),
+	)
+
+	logger.InfoContext(ctx, "OTEL log export initialized",
+		slog.String("endpoint", cfg.LogsEndpoint),
+		slog.String("service", cfg.ServiceName),
+	)
+
+	return NewLogBridge(provider)
+}
+
+// logExporterOptions builds the OTLP/HTTP exporter options for cfg.
+func logExporterOptions(cfg Config) []otlploghttp.Option {
+	if strings.Contains(cfg.LogsEndpoint, "://") {
+		if u, err := url.Parse(cfg.LogsEndpoint); err == nil {
+			if u.Path == "" || u.Path == "/" {
+				u.Path = otlpLogsPath
+			}
+			return []otlploghttp.Option{otlploghttp.WithEndpointURL(u.String())}
+		}
+	}
+
+	opts := []otlploghttp.Option{otlploghttp.WithEndpoint(cfg.LogsEndpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlploghttp.WithInsecure())
+	}
+	return opts
+}
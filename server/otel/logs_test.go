@@ -0,0 +1,226 @@
+package otel
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+)
+
+// memoryLogExporter records exported log records in memory.
+type memoryLogExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+	stopped bool
+}
+
+func (m *memoryLogExporter) Export(_ context.Context, records []sdklog.Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range records {
+		m.records = append(m.records, r.Clone())
+	}
+	return nil
+}
+
+func (m *memoryLogExporter) Shutdown(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped = true
+	return nil
+}
+
+func (m *memoryLogExporter) ForceFlush(_ context.Context) error { return nil }
+
+// attrKeys returns the attribute keys of rec in order.
+func attrKeys(rec sdklog.Record) []string {
+	var keys []string
+	rec.WalkAttributes(func(kv otellog.KeyValue) bool {
+		keys = append(keys, kv.Key)
+		return true
+	})
+	return keys
+}
+
+func TestLogBridge_AttachesTraceContext(t *testing.T) {
+	exporter := &memoryLogExporter{}
+	bridge := NewLogBridge(sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewSimpleProcessor(exporter)),
+	))
+
+	var out bytes.Buffer
+	logger := slog.New(bridge.Handler(slog.NewJSONHandler(&out, nil)))
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "op")
+	logger.With("component", "db").WithGroup("req").WarnContext(ctx, "in span", "id", 7)
+	span.End()
+	logger.Info("outside span")
+
+	require.NoError(t, bridge.Shutdown(context.Background()))
+
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	assert.True(t, exporter.stopped)
+	require.Len(t, exporter.records, 2)
+
+	inSpan := exporter.records[0]
+	assert.Equal(t, "in span", inSpan.Body().AsString())
+	assert.Equal(t, otellog.SeverityWarn, inSpan.Severity())
+	assert.Equal(t, span.SpanContext().TraceID(), inSpan.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), inSpan.SpanID())
+	assert.Equal(t, []string{"component", "req.id"}, attrKeys(inSpan))
+
+	assert.False(t, exporter.records[1].TraceID().IsValid())
+	assert.Equal(t, otellog.SeverityInfo, exporter.records[1].Severity())
+
+	// The wrapped handler still receives every record.
+	assert.Equal(t, 2, bytes.Count(out.Bytes(), []byte("\n")))
+}
+
+func TestLogBridge_DropsAfterShutdown(t *testing.T) {
+	exporter := &memoryLogExporter{}
+	bridge := NewLogBridge(sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+	))
+	require.NoError(t, bridge.Shutdown(context.Background()))
+
+	slog.New(bridge.Handler(slog.NewTextHandler(io.Discard, nil))).Info("late")
+	assert.Empty(t, exporter.records)
+}
+
+func TestLogBridge_NilWrapHandler(t *testing.T) {
+	var bridge *LogBridge
+	assert.Equal(t, slog.DiscardHandler, bridge.WrapHandler(slog.DiscardHandler))
+}
+
+func TestInitLogs_Disabled(t *testing.T) {
+	assert.Nil(t, InitLogs(context.Background(), Config{}, nil))
+}
+
+// otlpCollector is an httptest server recording OTLP/HTTP log requests.
+type otlpCollector struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	paths    []string
+	requests []*collogspb.ExportLogsServiceRequest
+}
+
+func newOTLPCollector(t *testing.T) *otlpCollector {
+	t.Helper()
+	c := &otlpCollector{}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := &collogspb.ExportLogsServiceRequest{}
+		_ = proto.Unmarshal(body, req)
+		c.mu.Lock()
+		c.paths = append(c.paths, r.URL.Path)
+		c.requests = append(c.requests, req)
+		c.mu.Unlock()
+	}))
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestInitLogs_ExportsOverOTLP(t *testing.T) {
+	collector := newOTLPCollector(t)
+	bridge := InitLogs(context.Background(), Config{LogsEndpoint: collector.URL, ServiceName: "svc"}, slog.New(slog.DiscardHandler))
+	require.NotNil(t, bridge)
+
+	tp := sdktrace.NewTracerProvider()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "op")
+	sc := span.SpanContext()
+
+	slog.New(bridge.Handler(slog.NewTextHandler(io.Discard, nil))).WarnContext(ctx, "hello",
+		slog.Int("n", 3), slog.Group("g", slog.Bool("ok", true)))
+	require.NoError(t, bridge.Shutdown(context.Background()))
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	require.Equal(t, []string{"/v1/logs"}, collector.paths)
+
+	rl := collector.requests[0].GetResourceLogs()[0]
+	var service string
+	for _, kv := range rl.GetResource().GetAttributes() {
+		if kv.GetKey() == "service.name" {
+			service = kv.GetValue().GetStringValue()
+		}
+	}
+	assert.Equal(t, "svc", service)
+
+	rec := rl.GetScopeLogs()[0].GetLogRecords()[0]
+	assert.Equal(t, int32(otellog.SeverityWarn), int32(rec.GetSeverityNumber()))
+	assert.Equal(t, "hello", rec.GetBody().GetStringValue())
+	assert.Equal(t, sc.TraceID().String(), hex.EncodeToString(rec.GetTraceId()))
+	assert.Equal(t, sc.SpanID().String(), hex.EncodeToString(rec.GetSpanId()))
+	require.Len(t, rec.GetAttributes(), 2)
+	assert.Equal(t, int64(3), rec.GetAttributes()[0].GetValue().GetIntValue())
+	assert.Equal(t, "g", rec.GetAttributes()[1].GetKey())
+}
+
+func TestNewModule_LogsDisabledLeavesLogger(t *testing.T) {
+	app := gaz.New()
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+
+	logger, err := di.Resolve[*slog.Logger](app.Container())
+	require.NoError(t, err)
+	assert.NotEqual(t, "*otel.logHandler", fmt.Sprintf("%T", logger.Handler()))
+
+	bridge, err := di.Resolve[*LogBridge](app.Container())
+	require.NoError(t, err)
+	assert.Nil(t, bridge)
+}
+
+func TestNewModule_WithLogsEndpoint(t *testing.T) {
+	collector := newOTLPCollector(t)
+
+	app := gaz.New()
+	require.NoError(t, NewModule(WithLogsEndpoint(collector.URL)).Apply(app))
+	require.NoError(t, app.Build())
+
+	logger, err := di.Resolve[*slog.Logger](app.Container())
+	require.NoError(t, err)
+	assert.IsType(t, &logHandler{}, logger.Handler())
+	assert.Same(t, logger, slog.Default())
+
+	logger.Info("exported")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, app.Stop(ctx))
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	var messages []string
+	for _, req := range collector.requests {
+		for _, rl := range req.GetResourceLogs() {
+			for _, sl := range rl.GetScopeLogs() {
+				for _, rec := range sl.GetLogRecords() {
+					messages = append(messages, rec.GetBody().GetStringValue())
+				}
+			}
+		}
+	}
+	assert.Contains(t, messages, "exported")
+}
@@ -17,25 +17,76 @@ type tracerProviderStopper struct {
 }
 
 // OnStop shuts down the TracerProvider.
+// A nil stopper (tracing disabled) is a no-op.
 func (t *tracerProviderStopper) OnStop(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
 	return ShutdownTracer(ctx, t.tp)
 }
 
+// logBridgeStopper wraps LogBridge to implement di.Stopper.
+type logBridgeStopper struct {
+	bridge *LogBridge
+}
+
+// OnStop flushes buffered records and shuts down the LogBridge.
+// A nil stopper (log export disabled) is a no-op.
+func (l *logBridgeStopper) OnStop(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer cancel()
+	return l.bridge.Shutdown(shutdownCtx)
+}
+
+// ModuleOption configures the OTEL module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	logsEndpoint string
+}
+
+// WithLogsEndpoint exports application logs to the OTLP/HTTP logs endpoint
+// (e.g. "localhost:4318"), correlated with traces through the trace and span
+// IDs of the logging context. The --otel-logs-endpoint flag and the
+// OTEL_EXPORTER_OTLP_LOGS_ENDPOINT environment variable take precedence.
+//
+// Example:
+//
+//	app.Use(otel.NewModule(otel.WithLogsEndpoint("localhost:4318")))
+func WithLogsEndpoint(endpoint string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.logsEndpoint = endpoint
+	}
+}
+
 // NewModule creates an OTEL module.
 // Returns a gaz.Module that registers TracerProvider components.
 //
 // If no endpoint is configured (via flags or OTEL_EXPORTER_OTLP_ENDPOINT env var),
 // tracing is disabled and a nil TracerProvider is registered.
 //
+// If a logs endpoint is configured, the application logger is constructed
+// with the LogBridge's handler so every record is also exported over OTLP;
+// otherwise the logger is left untouched.
+//
 // Components registered:
 //   - otel.Config
 //   - *sdktrace.TracerProvider (may be nil if disabled)
+//   - *otel.LogBridge (may be nil if log export is disabled)
 //
 // Example:
 //
 //	app := gaz.New()
 //	app.Use(otel.NewModule())
-func NewModule() gaz.Module {
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{}
+	for _, opt := range opts {
+		opt(mc)
+	}
 	defaultCfg := DefaultConfig()
 
 	return gaz.NewModule("otel").
@@ -58,6 +109,12 @@ func NewModule() gaz.Module {
 				if cfg.Endpoint == "" {
 					cfg.Endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
 				}
+				if cfg.LogsEndpoint == "" {
+					cfg.LogsEndpoint = os.Getenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT")
+				}
+				if cfg.LogsEndpoint == "" {
+					cfg.LogsEndpoint = mc.logsEndpoint
+				}
 
 				if err := cfg.Validate(); err != nil {
 					return Config{}, fmt.Errorf("otel config validate: %w", err)
@@ -68,6 +125,8 @@ func NewModule() gaz.Module {
 		}).
 		Provide(registerTracerProvider).
 		Provide(registerTracerStopper).
+		Provide(registerLogBridge).
+		Provide(registerLogBridgeStopper).
 		Build()
 }
 
@@ -111,3 +170,50 @@ func registerTracerStopper(c *gaz.Container) error {
 	}
 	return nil
 }
+
+// registerLogBridge registers the LogBridge. The bridge implements
+// logger.HandlerWrapper, so the app wraps its logger's handler with it when
+// log export is enabled.
+func registerLogBridge(c *gaz.Container) error {
+	if err := gaz.For[*LogBridge](c).
+		Eager().
+		Provider(func(c *gaz.Container) (*LogBridge, error) {
+			cfg, err := gaz.Resolve[Config](c)
+			if err != nil {
+				return nil, fmt.Errorf("resolve otel config: %w", err)
+			}
+
+			logger := slog.Default()
+			if resolved, resolveErr := gaz.Resolve[*slog.Logger](c); resolveErr == nil {
+				logger = resolved
+			}
+
+			bridge := InitLogs(context.Background(), cfg, logger)
+			if bridge == nil {
+				return nil, nil //nolint:nilnil // Log export disabled.
+			}
+			return bridge, nil
+		}); err != nil {
+		return fmt.Errorf("register log bridge: %w", err)
+	}
+	return nil
+}
+
+// registerLogBridgeStopper registers the LogBridge stopper.
+// This ensures buffered logs are flushed when the app stops.
+func registerLogBridgeStopper(c *gaz.Container) error {
+	if err := gaz.For[*logBridgeStopper](c).
+		Provider(func(c *gaz.Container) (*logBridgeStopper, error) {
+			bridge, err := gaz.Resolve[*LogBridge](c)
+			if err != nil {
+				return nil, fmt.Errorf("resolve log bridge: %w", err)
+			}
+			if bridge == nil {
+				return nil, nil //nolint:nilnil // No stopper needed if log export disabled.
+			}
+			return &logBridgeStopper{bridge: bridge}, nil
+		}); err != nil {
+		return fmt.Errorf("register log bridge stopper: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/petabytecl/gaz/server/grpc"
+	"github.com/petabytecl/gaz/server/vanguard"
+)
+
+// readinessComponent is a named server whose readiness is polled.
+type readinessComponent struct {
+	name  string
+	ready func() bool
+}
+
+// Readiness aggregates the readiness of the gRPC and Vanguard servers.
+// It reports ready only once gRPC has registered all services and Vanguard
+// has built its transcoder from every registrar and is listening.
+//
+// NewModule registers Readiness and, when a *health.Manager is available,
+// adds it as the "server" readiness check.
+type Readiness struct {
+	components []readinessComponent
+}
+
+// NewReadiness creates a Readiness for the given servers.
+// A nil vanguard server is skipped, for apps that serve gRPC only.
+func NewReadiness(grpcSrv *grpc.Server, vgSrv *vanguard.Server) *Readiness {
+	r := &Readiness{}
+	if grpcSrv != nil {
+		r.components = append(r.components, readinessComponent{name: "grpc", ready: grpcSrv.Ready})
+	}
+	if vgSrv != nil {
+		r.components = append(r.components, readinessComponent{name: "vanguard", ready: vgSrv.Ready})
+	}
+	return r
+}
+
+// Check returns nil when every server is ready, or an error wrapping
+// ErrNotReady naming the servers that are not.
+// It has the signature of health.CheckFunc.
+func (r *Readiness) Check(_ context.Context) error {
+	var pending []string
+	for _, c := range r.components {
+		if !c.ready() {
+			pending = append(pending, c.name)
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %s", ErrNotReady, strings.Join(pending, ", "))
+	}
+	return nil
+}
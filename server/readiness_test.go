@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/petabytecl/gaz"
+	"github.com/petabytecl/gaz/di"
+	"github.com/petabytecl/gaz/health"
+	"github.com/petabytecl/gaz/server/grpc"
+	"github.com/petabytecl/gaz/server/vanguard"
+)
+
+func TestReadiness_FlipsWhenServersStart(t *testing.T) {
+	c := di.New()
+
+	grpcCfg := grpc.DefaultConfig()
+	grpcCfg.SkipListener = true
+	grpcCfg.HealthEnabled = false
+	grpcSrv, err := grpc.NewServer(grpcCfg, slog.Default(), c, nil)
+	require.NoError(t, err)
+
+	vgCfg := vanguard.DefaultConfig()
+	vgCfg.Port = 0
+	vgCfg.HealthEnabled = false
+	vgSrv := vanguard.NewServer(vgCfg, slog.Default(), c, grpcSrv.GRPCServer())
+
+	r := NewReadiness(grpcSrv, vgSrv)
+	ctx := context.Background()
+
+	err = r.Check(ctx)
+	require.ErrorIs(t, err, ErrNotReady)
+	assert.ErrorContains(t, err, "grpc, vanguard")
+
+	// gRPC alone is not enough: the gateway has not mounted the registrars yet.
+	require.NoError(t, grpcSrv.OnStart(ctx))
+	err = r.Check(ctx)
+	require.ErrorIs(t, err, ErrNotReady)
+	assert.NotContains(t, err.Error(), "grpc")
+
+	require.NoError(t, vgSrv.OnStart(ctx))
+	require.NoError(t, r.Check(ctx))
+
+	// Stopping flips readiness back before connections drain.
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, vgSrv.OnStop(stopCtx))
+	require.ErrorIs(t, r.Check(ctx), ErrNotReady)
+	require.NoError(t, grpcSrv.OnStop(stopCtx))
+}
+
+func TestReadiness_NilVanguard(t *testing.T) {
+	grpcCfg := grpc.DefaultConfig()
+	grpcCfg.SkipListener = true
+	grpcCfg.HealthEnabled = false
+	grpcSrv, err := grpc.NewServer(grpcCfg, slog.Default(), di.New(), nil)
+	require.NoError(t, err)
+
+	r := NewReadiness(grpcSrv, nil)
+	require.ErrorIs(t, r.Check(context.Background()), ErrNotReady)
+
+	require.NoError(t, grpcSrv.OnStart(context.Background()))
+	require.NoError(t, r.Check(context.Background()))
+}
+
+func TestNewModule_RegistersReadinessCheck(t *testing.T) {
+	app := gaz.New()
+	manager := health.NewManager()
+	require.NoError(t, gaz.For[*health.Manager](app.Container()).Instance(manager))
+
+	require.NoError(t, NewModule().Apply(app))
+	require.NoError(t, app.Build())
+
+	require.True(t, di.Has[*Readiness](app.Container()))
+
+	// Servers have not started, so the "server" check fails.
+	result := manager.ReadinessChecker().Check(context.Background())
+	assert.Equal(t, health.StatusDown, result.Status)
+	require.Contains(t, result.Details, "server")
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+
+	"github.com/petabytecl/gaz/server/cors"
 )
 
 // DefaultPort is the default port for the Vanguard server.
@@ -19,7 +21,7 @@ const DefaultReadHeaderTimeout = 5 * time.Second
 const DefaultIdleTimeout = 120 * time.Second
 
 // DefaultCORSMaxAge is the default max age for preflight request caching (24 hours in seconds).
-const DefaultCORSMaxAge = 86400
+const DefaultCORSMaxAge = cors.DefaultMaxAge
 
 // Config holds configuration for the Vanguard server.
 type Config struct {
@@ -71,28 +73,8 @@ type Config struct {
 }
 
 // CORSConfig holds CORS configuration for the Vanguard server.
-type CORSConfig struct {
-	// AllowedOrigins is a list of allowed origins.
-	// Use ["*"] to allow all origins (dev mode only, not with credentials).
-	AllowedOrigins []string `json:"allowed_origins" yaml:"allowed_origins" mapstructure:"allowed_origins"`
-
-	// AllowedMethods is a list of allowed HTTP methods.
-	AllowedMethods []string `json:"allowed_methods" yaml:"allowed_methods" mapstructure:"allowed_methods"`
-
-	// AllowedHeaders is a list of allowed request headers.
-	// Use ["*"] to allow all headers (dev mode only).
-	AllowedHeaders []string `json:"allowed_headers" yaml:"allowed_headers" mapstructure:"allowed_headers"`
-
-	// ExposedHeaders is a list of headers exposed to the browser.
-	ExposedHeaders []string `json:"exposed_headers" yaml:"exposed_headers" mapstructure:"exposed_headers"`
-
-	// AllowCredentials indicates whether credentials (cookies, auth headers) are allowed.
-	// Cannot be used with AllowedOrigins ["*"].
-	AllowCredentials bool `json:"allow_credentials" yaml:"allow_credentials" mapstructure:"allow_credentials"`
-
-	// MaxAge is the maximum age (in seconds) for preflight request caching.
-	MaxAge int `json:"max_age" yaml:"max_age" mapstructure:"max_age"`
-}
+// It is the shared cors.Config, so the same settings work for the HTTP server.
+type CORSConfig = cors.Config
 
 // DefaultConfig returns a Config with safe defaults.
 // ReadTimeout and WriteTimeout are intentionally zero for streaming safety.
@@ -140,24 +122,7 @@ func (c *Config) Flags(fs *pflag.FlagSet) {
 // In dev mode, CORS is wide-open for convenience.
 // In prod mode, origins must be explicitly configured.
 func DefaultCORSConfig(devMode bool) CORSConfig {
-	if devMode {
-		return CORSConfig{
-			AllowedOrigins:   []string{"*"},
-			AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-			AllowedHeaders:   []string{"*"},
-			ExposedHeaders:   []string{},
-			AllowCredentials: false, // Cannot use * with credentials.
-			MaxAge:           DefaultCORSMaxAge,
-		}
-	}
-	return CORSConfig{
-		AllowedOrigins:   []string{}, // Must be explicitly configured.
-		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
-		AllowedHeaders:   []string{"Authorization", "Content-Type", "X-Request-ID"},
-		ExposedHeaders:   []string{"X-Request-ID"},
-		AllowCredentials: true,
-		MaxAge:           DefaultCORSMaxAge,
-	}
+	return cors.DefaultConfig(devMode)
 }
 
 // SetDefaults applies default values to zero-value fields.
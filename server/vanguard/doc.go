@@ -32,6 +32,33 @@
 //   - /live    — liveness probe
 //   - /startup — startup probe
 //
+// # OpenAPI
+//
+// WithOpenAPISpec serves generated OpenAPI spec files (for example the
+// *.swagger.json output of protoc-gen-openapiv2) beneath a route, and
+// WithSwaggerUI serves a Swagger-UI page for one of them:
+//
+//	sub, _ := fs.Sub(specs, "openapi")
+//	app.Use(vanguard.NewModule(
+//	    vanguard.WithOpenAPISpec(sub, "/openapi/"),
+//	    vanguard.WithSwaggerUI("/docs", "/openapi/api.swagger.json"),
+//	))
+//
+// The page loads a pinned swagger-ui-dist release from the unpkg CDN;
+// WithSwaggerUIAssets points it at a self-hosted copy instead.
+//
+// No routes are mounted unless these options are set.
+//
+// # Timeouts
+//
+// By default proxied calls are bounded only by the HTTP server timeouts.
+// WithDefaultTimeout and WithMethodTimeouts set a deadline on each call's
+// context, which propagates to the gRPC handler. Streaming methods only get
+// a deadline from WithMethodTimeouts. gRPC, gRPC-Web, and Connect clients
+// see DEADLINE_EXCEEDED; a REST call that exceeds its deadline before
+// writing a response gets 504 Gateway Timeout with an RFC 7807
+// application/problem+json body.
+//
 // # Reflection
 //
 // gRPC reflection (v1 and v1alpha) is enabled by default for grpcurl
@@ -9,13 +9,13 @@ import (
 
 	"connectrpc.com/connect"
 	"connectrpc.com/otelconnect"
-	"github.com/rs/cors"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/petabytecl/gaz/di"
 	"github.com/petabytecl/gaz/health"
 	connectpkg "github.com/petabytecl/gaz/server/connect"
+	"github.com/petabytecl/gaz/server/cors"
 )
 
 // Transport middleware priority constants.
@@ -75,27 +75,14 @@ func collectTransportMiddleware(container *di.Container, logger *slog.Logger, ha
 // In dev mode, it allows all origins. In production, it applies
 // configured CORS restrictions.
 type CORSMiddleware struct {
-	corsHandler *cors.Cors
+	wrap func(http.Handler) http.Handler
 }
 
 // NewCORSMiddleware creates a new CORS transport middleware.
 // In dev mode, all origins are allowed. In production, the configured
 // CORSConfig origins, methods, and headers are enforced.
 func NewCORSMiddleware(cfg CORSConfig, devMode bool) *CORSMiddleware {
-	var corsHandler *cors.Cors
-	if devMode {
-		corsHandler = cors.AllowAll()
-	} else {
-		corsHandler = cors.New(cors.Options{
-			AllowedOrigins:   cfg.AllowedOrigins,
-			AllowedMethods:   cfg.AllowedMethods,
-			AllowedHeaders:   cfg.AllowedHeaders,
-			ExposedHeaders:   cfg.ExposedHeaders,
-			AllowCredentials: cfg.AllowCredentials,
-			MaxAge:           cfg.MaxAge,
-		})
-	}
-	return &CORSMiddleware{corsHandler: corsHandler}
+	return &CORSMiddleware{wrap: cors.Middleware(cfg, devMode)}
 }
 
 // Name returns the middleware identifier.
@@ -110,7 +97,7 @@ func (m *CORSMiddleware) Priority() int {
 
 // Wrap applies CORS handling to the given handler.
 func (m *CORSMiddleware) Wrap(next http.Handler) http.Handler {
-	return m.corsHandler.Handler(next)
+	return m.wrap(next)
 }
 
 // --- OTEL Transport Middleware ---
@@ -12,6 +12,17 @@ import (
 	grpcpkg "github.com/petabytecl/gaz/server/grpc"
 )
 
+// ModuleOption configures the Vanguard module.
+type ModuleOption func(*moduleConfig)
+
+// moduleConfig holds options applied by NewModule.
+type moduleConfig struct {
+	openAPI       *openAPISpec
+	swaggerUI     *swaggerUI
+	swaggerAssets string
+	timeouts      routeTimeouts
+}
+
 // resolveLogger attempts to resolve a logger from the container, falling back to slog.Default().
 func resolveLogger(c *gaz.Container) *slog.Logger {
 	if resolved, err := gaz.Resolve[*slog.Logger](c); err == nil {
@@ -186,25 +197,48 @@ func provideConnectRateLimitBundle(c *gaz.Container) error {
 // provideServer creates a Server provider function.
 // The server is registered as Eager so it starts with the application.
 func provideServer(c *gaz.Container) error {
-	if err := gaz.For[*Server](c).
-		Eager().
-		Provider(func(c *gaz.Container) (*Server, error) {
-			cfg, err := gaz.Resolve[Config](c)
-			if err != nil {
-				return nil, fmt.Errorf("resolve vanguard config: %w", err)
-			}
+	return provideServerWithOptions(&moduleConfig{})(c)
+}
 
-			// Resolve the gRPC server wrapper to get the raw *grpc.Server.
-			grpcSrv, err := gaz.Resolve[*grpcpkg.Server](c)
-			if err != nil {
-				return nil, fmt.Errorf("resolve grpc server: %w", err)
-			}
+// provideServerWithOptions creates a Server provider function that applies
+// the module options to the server.
+func provideServerWithOptions(mc *moduleConfig) func(*gaz.Container) error {
+	return func(c *gaz.Container) error {
+		if err := validateRoutes(mc.openAPI, mc.swaggerUI, nil); err != nil {
+			return err
+		}
+		if err := mc.timeouts.validate(); err != nil {
+			return err
+		}
+
+		if err := gaz.For[*Server](c).
+			Eager().
+			Provider(func(c *gaz.Container) (*Server, error) {
+				cfg, err := gaz.Resolve[Config](c)
+				if err != nil {
+					return nil, fmt.Errorf("resolve vanguard config: %w", err)
+				}
+
+				// Resolve the gRPC server wrapper to get the raw *grpc.Server.
+				grpcSrv, err := gaz.Resolve[*grpcpkg.Server](c)
+				if err != nil {
+					return nil, fmt.Errorf("resolve grpc server: %w", err)
+				}
 
-			return NewServer(cfg, resolveLogger(c), c, grpcSrv.GRPCServer()), nil
-		}); err != nil {
-		return fmt.Errorf("register vanguard server: %w", err)
+				srv := NewServer(cfg, resolveLogger(c), c, grpcSrv.GRPCServer())
+				srv.openAPI = mc.openAPI
+				if mc.swaggerUI != nil {
+					ui := *mc.swaggerUI
+					ui.assetsURL = mc.swaggerAssets
+					srv.swaggerUI = &ui
+				}
+				srv.timeouts = mc.timeouts
+				return srv, nil
+			}); err != nil {
+			return fmt.Errorf("register vanguard server: %w", err)
+		}
+		return nil
 	}
-	return nil
 }
 
 // NewModule creates a Vanguard module.
@@ -222,6 +256,12 @@ func provideServer(c *gaz.Container) error {
 //   - *connect.RateLimitBundle (connect rate limit interceptor, uses AlwaysPassLimiter unless Limiter registered)
 //   - *vanguard.Server (eager, starts on app start)
 //
+// Options:
+//   - WithOpenAPISpec: serve OpenAPI spec files beneath a route
+//   - WithSwaggerUI: serve a Swagger-UI page for a spec URL
+//   - WithSwaggerUIAssets: load the Swagger-UI assets from another base URL
+//   - WithDefaultTimeout, WithMethodTimeouts: bound proxied calls, replying 504 on timeout
+//
 // The module depends on grpc.NewModule() being registered first, as it
 // resolves *grpc.Server from the DI container to bridge gRPC services
 // into the Vanguard transcoder.
@@ -231,7 +271,12 @@ func provideServer(c *gaz.Container) error {
 //	app := gaz.New()
 //	app.Use(grpc.NewModule())      // Must come first
 //	app.Use(vanguard.NewModule())  // Vanguard unified server
-func NewModule() gaz.Module {
+func NewModule(opts ...ModuleOption) gaz.Module {
+	mc := &moduleConfig{}
+	for _, opt := range opts {
+		opt(mc)
+	}
+
 	defaultCfg := DefaultConfig()
 
 	return gaz.NewModule("vanguard").
@@ -245,6 +290,6 @@ func NewModule() gaz.Module {
 		Provide(provideConnectValidationBundle).
 		Provide(provideConnectAuthBundle).
 		Provide(provideConnectRateLimitBundle).
-		Provide(provideServer).
+		Provide(provideServerWithOptions(mc)).
 		Build()
 }
@@ -417,10 +417,11 @@ func (s *ModuleTestSuite) TestProvideServer_ConfigResolveError() {
 	// Register gRPC server.
 	grpcCfg := grpcpkg.DefaultConfig()
 	grpcCfg.SkipListener = true
-	grpcSrv := grpcpkg.NewServer(grpcCfg, slog.Default(), container, nil)
+	grpcSrv, err := grpcpkg.NewServer(grpcCfg, slog.Default(), container, nil)
+	s.Require().NoError(err)
 	s.Require().NoError(di.For[*grpcpkg.Server](container).Instance(grpcSrv))
 
-	err := provideServer(container)
+	err = provideServer(container)
 	s.Require().NoError(err, "registration should succeed")
 
 	// Build should fail because config resolution fails.
@@ -509,10 +510,11 @@ func (s *ModuleTestSuite) TestProvideServer_Registers() {
 	// Register a grpc.Server wrapper.
 	grpcCfg := grpcpkg.DefaultConfig()
 	grpcCfg.SkipListener = true
-	grpcSrv := grpcpkg.NewServer(grpcCfg, slog.Default(), container, nil)
+	grpcSrv, err := grpcpkg.NewServer(grpcCfg, slog.Default(), container, nil)
+	s.Require().NoError(err)
 	s.Require().NoError(di.For[*grpcpkg.Server](container).Instance(grpcSrv))
 
-	err := provideServer(container)
+	err = provideServer(container)
 	s.Require().NoError(err)
 
 	// provideServer registers as Eager. Build triggers resolution.
@@ -0,0 +1,228 @@
+package vanguard
+
+import (
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"connectrpc.com/grpcreflect"
+
+	"github.com/petabytecl/gaz/health"
+)
+
+// openAPISpec is a set of spec files served beneath a route prefix.
+type openAPISpec struct {
+	fsys  fs.FS
+	route string
+}
+
+// swaggerUI is a Swagger-UI page served at route that renders specURL.
+type swaggerUI struct {
+	route     string
+	specURL   string
+	assetsURL string // base URL of the swagger-ui-dist files; "" for DefaultSwaggerUIAssets
+}
+
+// DefaultSwaggerUIAssets is the base URL the Swagger-UI page loads its
+// swagger-ui.css and swagger-ui-bundle.js from unless WithSwaggerUIAssets
+// changes it. It pins an exact swagger-ui-dist release on the unpkg CDN.
+const DefaultSwaggerUIAssets = "https://unpkg.com/swagger-ui-dist@5.17.14"
+
+// WithOpenAPISpec serves the files in fsys beneath route on the server's
+// HTTP mux, typically the *.swagger.json files emitted by protoc-gen-openapiv2.
+// JSON files are served as application/json and YAML files as
+// application/yaml. Directory listings are not served.
+//
+// Example:
+//
+//	//go:embed openapi
+//	var specs embed.FS
+//
+//	sub, _ := fs.Sub(specs, "openapi")
+//	app.Use(vanguard.NewModule(vanguard.WithOpenAPISpec(sub, "/openapi/")))
+//	// GET /openapi/api.swagger.json
+func WithOpenAPISpec(fsys fs.FS, route string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.openAPI = &openAPISpec{fsys: fsys, route: route}
+	}
+}
+
+// WithSwaggerUI serves a Swagger-UI page at route that renders the spec at
+// specURL. The page loads the Swagger-UI assets from DefaultSwaggerUIAssets
+// unless WithSwaggerUIAssets is set.
+//
+// Example:
+//
+//	app.Use(vanguard.NewModule(
+//	    vanguard.WithOpenAPISpec(sub, "/openapi/"),
+//	    vanguard.WithSwaggerUI("/docs", "/openapi/api.swagger.json"),
+//	))
+func WithSwaggerUI(route, specURL string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.swaggerUI = &swaggerUI{route: route, specURL: specURL}
+	}
+}
+
+// WithSwaggerUIAssets loads the Swagger-UI page's swagger-ui.css and
+// swagger-ui-bundle.js from baseURL instead of DefaultSwaggerUIAssets, for
+// example a copy of swagger-ui-dist served by the application itself or an
+// internal mirror. It has no effect without WithSwaggerUI.
+//
+// Example:
+//
+//	app.Use(vanguard.NewModule(
+//	    vanguard.WithSwaggerUI("/docs", "/openapi/api.swagger.json"),
+//	    vanguard.WithSwaggerUIAssets("/static/swagger-ui"),
+//	))
+func WithSwaggerUIAssets(baseURL string) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.swaggerAssets = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// reservedRoutes returns the paths the server mounts itself: the health
+// probes from cfg (the defaults when nil) and the gRPC reflection services.
+func reservedRoutes(cfg *health.Config) []string {
+	hcfg := health.DefaultConfig()
+	if cfg != nil {
+		hcfg = *cfg
+	}
+	routes := []string{hcfg.ReadinessPath, hcfg.LivenessPath}
+	if hcfg.StartupPath != "" {
+		routes = append(routes, hcfg.StartupPath)
+	}
+	return append(routes,
+		"/"+grpcreflect.ReflectV1ServiceName+"/",
+		"/"+grpcreflect.ReflectV1AlphaServiceName+"/",
+	)
+}
+
+// validateRoute checks that route is an absolute URL path other than "/"
+// that does not collide with a reserved route.
+func validateRoute(route string, reserved []string) error {
+	if !strings.HasPrefix(route, "/") {
+		return fmt.Errorf("vanguard: route %q must start with /", route)
+	}
+	if route == "/" {
+		return fmt.Errorf("vanguard: route %q would shadow every unmatched path", route)
+	}
+	for _, r := range reserved {
+		if sameRoute(route, r) {
+			return fmt.Errorf("vanguard: route %q is reserved by the server", route)
+		}
+	}
+	return nil
+}
+
+// validateRoutes checks the OpenAPI spec and Swagger-UI routes, either of
+// which may be nil, against each other and the routes reserved for
+// healthCfg.
+func validateRoutes(spec *openAPISpec, ui *swaggerUI, healthCfg *health.Config) error {
+	reserved := reservedRoutes(healthCfg)
+	if spec != nil {
+		if err := validateRoute(spec.route, reserved); err != nil {
+			return err
+		}
+	}
+	if ui != nil {
+		if err := validateRoute(ui.route, reserved); err != nil {
+			return err
+		}
+	}
+	if spec != nil && ui != nil && sameRoute(spec.route, ui.route) {
+		return fmt.Errorf("vanguard: Swagger-UI route %q duplicates the OpenAPI spec route", ui.route)
+	}
+	return nil
+}
+
+// sameRoute reports whether a and b name the same path, ignoring a
+// trailing slash.
+func sameRoute(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// newOpenAPIHandler returns a handler serving the files in fsys beneath
+// prefix. prefix must end with "/".
+func newOpenAPIHandler(fsys fs.FS, prefix string) http.Handler {
+	files := http.FileServerFS(fsys)
+
+	return http.StripPrefix(strings.TrimSuffix(prefix, "/"), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		if ct := specContentType(r.URL.Path); ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+		files.ServeHTTP(w, r)
+	}))
+}
+
+// specContentType returns the content type for an OpenAPI spec file, or ""
+// to let http.FileServer detect it.
+func specContentType(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		return "application/json"
+	case ".yaml", ".yml":
+		return "application/yaml"
+	default:
+		return ""
+	}
+}
+
+// mountOpenAPI registers the spec and Swagger-UI handlers on mux.
+// A nil spec or ui is skipped.
+func mountOpenAPI(mux *http.ServeMux, spec *openAPISpec, ui *swaggerUI) {
+	if spec != nil {
+		prefix := spec.route
+		if !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		mux.Handle(prefix, newOpenAPIHandler(spec.fsys, prefix))
+	}
+	if ui != nil {
+		mux.Handle(ui.route, newSwaggerUIHandler(ui.specURL, ui.assetsURL))
+	}
+}
+
+// swaggerUIPage is the data rendered by swaggerUITemplate.
+type swaggerUIPage struct {
+	SpecURL   string
+	AssetsURL string
+}
+
+// swaggerUITemplate renders a Swagger-UI page for a single spec URL.
+var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>API Reference</title>
+<link rel="stylesheet" href="{{.AssetsURL}}/swagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{.AssetsURL}}/swagger-ui-bundle.js"></script>
+<script>
+window.ui = SwaggerUIBundle({url: {{.SpecURL}}, dom_id: "#swagger-ui"});
+</script>
+</body>
+</html>
+`))
+
+// newSwaggerUIHandler returns a handler rendering the Swagger-UI page for
+// specURL with the assets beneath assetsURL, or DefaultSwaggerUIAssets when
+// assetsURL is empty.
+func newSwaggerUIHandler(specURL, assetsURL string) http.Handler {
+	if assetsURL == "" {
+		assetsURL = DefaultSwaggerUIAssets
+	}
+	page := swaggerUIPage{SpecURL: specURL, AssetsURL: assetsURL}
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = swaggerUITemplate.Execute(w, page)
+	})
+}
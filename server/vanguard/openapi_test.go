@@ -0,0 +1,226 @@
+package vanguard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+
+	"github.com/petabytecl/gaz/di"
+	"github.com/petabytecl/gaz/health"
+)
+
+const testSpec = `{"swagger":"2.0","info":{"title":"test"}}`
+
+func testSpecFS() fstest.MapFS {
+	return fstest.MapFS{
+		"api.swagger.json": {Data: []byte(testSpec)},
+		"api.yaml":         {Data: []byte("openapi: 3.0.0\n")},
+		"v1/users.json":    {Data: []byte(`{}`)},
+	}
+}
+
+// OpenAPITestSuite tests OpenAPI spec and Swagger-UI serving.
+type OpenAPITestSuite struct {
+	suite.Suite
+}
+
+func TestOpenAPITestSuite(t *testing.T) {
+	suite.Run(t, new(OpenAPITestSuite))
+}
+
+func (s *OpenAPITestSuite) serve(mux *http.ServeMux, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func (s *OpenAPITestSuite) TestServesSpecWithContentType() {
+	mux := http.NewServeMux()
+	mountOpenAPI(mux, &openAPISpec{fsys: testSpecFS(), route: "/openapi"}, nil)
+
+	tests := []struct {
+		target      string
+		contentType string
+		body        string
+	}{
+		{target: "/openapi/api.swagger.json", contentType: "application/json", body: testSpec},
+		{target: "/openapi/api.yaml", contentType: "application/yaml", body: "openapi: 3.0.0\n"},
+		{target: "/openapi/v1/users.json", contentType: "application/json", body: `{}`},
+	}
+
+	for _, tt := range tests {
+		rec := s.serve(mux, tt.target)
+		s.Equal(http.StatusOK, rec.Code, tt.target)
+		s.Equal(tt.contentType, rec.Header().Get("Content-Type"), tt.target)
+		s.Equal(tt.body, rec.Body.String(), tt.target)
+	}
+}
+
+func (s *OpenAPITestSuite) TestDoesNotListDirectories() {
+	mux := http.NewServeMux()
+	mountOpenAPI(mux, &openAPISpec{fsys: testSpecFS(), route: "/openapi/"}, nil)
+
+	s.Equal(http.StatusNotFound, s.serve(mux, "/openapi/").Code)
+	s.Equal(http.StatusNotFound, s.serve(mux, "/openapi/v1/").Code)
+	s.Equal(http.StatusNotFound, s.serve(mux, "/openapi/missing.json").Code)
+}
+
+func (s *OpenAPITestSuite) TestServesSwaggerUI() {
+	mux := http.NewServeMux()
+	mountOpenAPI(mux, nil, &swaggerUI{route: "/docs", specURL: "/openapi/api.swagger.json"})
+
+	rec := s.serve(mux, "/docs")
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	s.Contains(rec.Body.String(), `"/openapi/api.swagger.json"`)
+	s.Contains(rec.Body.String(), DefaultSwaggerUIAssets+"/swagger-ui-bundle.js")
+}
+
+func (s *OpenAPITestSuite) TestServesSwaggerUIWithCustomAssets() {
+	mux := http.NewServeMux()
+	mountOpenAPI(mux, nil, &swaggerUI{
+		route:     "/docs",
+		specURL:   "/openapi/api.swagger.json",
+		assetsURL: "/static/swagger-ui",
+	})
+
+	body := s.serve(mux, "/docs").Body.String()
+	s.Contains(body, `href="/static/swagger-ui/swagger-ui.css"`)
+	s.Contains(body, `src="/static/swagger-ui/swagger-ui-bundle.js"`)
+	s.NotContains(body, "unpkg.com")
+}
+
+func (s *OpenAPITestSuite) TestServerMountsSpec() {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.Reflection = false
+	cfg.HealthEnabled = false
+
+	server := NewServer(cfg, slog.Default(), di.New(), grpc.NewServer())
+	server.openAPI = &openAPISpec{fsys: testSpecFS(), route: "/openapi/"}
+	s.Require().NoError(server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.Require().NoError(server.OnStop(stopCtx))
+	}()
+
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/openapi/api.swagger.json", cfg.Port))
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("application/json", resp.Header.Get("Content-Type"))
+	s.Equal(testSpec, string(body))
+}
+
+func (s *OpenAPITestSuite) TestServerWithoutSpecMountsNothing() {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.Reflection = false
+	cfg.HealthEnabled = false
+
+	server := NewServer(cfg, slog.Default(), di.New(), grpc.NewServer())
+	s.Require().NoError(server.OnStart(context.Background()))
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.Require().NoError(server.OnStop(stopCtx))
+	}()
+
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/openapi/api.swagger.json", cfg.Port))
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *OpenAPITestSuite) TestModuleOptions() {
+	mc := &moduleConfig{}
+	WithOpenAPISpec(testSpecFS(), "/openapi/")(mc)
+	WithSwaggerUI("/docs", "/openapi/api.swagger.json")(mc)
+	WithSwaggerUIAssets("/static/swagger-ui/")(mc)
+
+	s.Equal("/static/swagger-ui", mc.swaggerAssets)
+	s.Require().NotNil(mc.openAPI)
+	s.Equal("/openapi/", mc.openAPI.route)
+	s.Require().NotNil(mc.swaggerUI)
+	s.Equal("/docs", mc.swaggerUI.route)
+	s.Equal("/openapi/api.swagger.json", mc.swaggerUI.specURL)
+}
+
+func (s *OpenAPITestSuite) TestProvideServerRejectsRelativeRoute() {
+	mc := &moduleConfig{}
+	WithOpenAPISpec(testSpecFS(), "openapi")(mc)
+
+	err := provideServerWithOptions(mc)(di.New())
+	s.Require().ErrorContains(err, "must start with /")
+}
+
+func (s *OpenAPITestSuite) TestProvideServerRejectsConflictingRoutes() {
+	tests := []struct {
+		name string
+		opts []ModuleOption
+		want string
+	}{
+		{
+			name: "root spec route",
+			opts: []ModuleOption{WithOpenAPISpec(testSpecFS(), "/")},
+			want: "shadow every unmatched path",
+		},
+		{
+			name: "root swagger-ui route",
+			opts: []ModuleOption{WithSwaggerUI("/", "/openapi/api.swagger.json")},
+			want: "shadow every unmatched path",
+		},
+		{
+			name: "health path",
+			opts: []ModuleOption{WithSwaggerUI("/ready", "/openapi/api.swagger.json")},
+			want: "reserved",
+		},
+		{
+			name: "reflection path",
+			opts: []ModuleOption{WithOpenAPISpec(testSpecFS(), "/grpc.reflection.v1.ServerReflection")},
+			want: "reserved",
+		},
+		{
+			name: "duplicate routes",
+			opts: []ModuleOption{
+				WithOpenAPISpec(testSpecFS(), "/docs/"),
+				WithSwaggerUI("/docs", "/docs/api.swagger.json"),
+			},
+			want: "duplicates",
+		},
+	}
+
+	for _, tt := range tests {
+		mc := &moduleConfig{}
+		for _, opt := range tt.opts {
+			opt(mc)
+		}
+		err := provideServerWithOptions(mc)(di.New())
+		s.Require().ErrorContains(err, tt.want, tt.name)
+	}
+}
+
+func (s *OpenAPITestSuite) TestServerRejectsCustomHealthPathConflict() {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.Reflection = false
+
+	server := NewServer(cfg, slog.Default(), di.New(), grpc.NewServer())
+	server.healthConfig = &health.Config{LivenessPath: "/live", ReadinessPath: "/docs"}
+	server.swaggerUI = &swaggerUI{route: "/docs", specURL: "/openapi/api.swagger.json"}
+
+	s.Require().ErrorContains(server.OnStart(context.Background()), "reserved")
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"sync/atomic"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
@@ -31,6 +32,10 @@ type Server struct {
 	healthManager      *health.Manager
 	healthConfig       *health.Config
 	userUnknownHandler http.Handler
+	openAPI            *openAPISpec
+	swaggerUI          *swaggerUI
+	timeouts           routeTimeouts
+	ready              atomic.Bool
 }
 
 // NewServer creates a new Vanguard server with the given configuration.
@@ -129,6 +134,13 @@ func (s *Server) OnStart(ctx context.Context) error {
 		mountHealthEndpoints(unknownMux, s.healthManager, s.healthConfig)
 	}
 
+	// 5.5. Mount OpenAPI spec files and Swagger-UI if configured. The health
+	// paths may be customized, so check the routes against them again.
+	if err := validateRoutes(s.openAPI, s.swaggerUI, s.healthConfig); err != nil {
+		return err
+	}
+	mountOpenAPI(unknownMux, s.openAPI, s.swaggerUI)
+
 	// 6. Mount user-defined unknown handler as fallback.
 	if s.userUnknownHandler != nil {
 		unknownMux.Handle("/", s.userUnknownHandler)
@@ -145,6 +157,11 @@ func (s *Server) OnStart(ctx context.Context) error {
 		return transcoderErr
 	}
 
+	// 8.25. Bound proxied calls with the configured timeouts.
+	if s.timeouts.enabled() {
+		handler = newTimeoutHandler(handler, s.timeouts)
+	}
+
 	// 8.5. Apply transport middleware chain (CORS, OTEL, custom middleware).
 	handler = collectTransportMiddleware(s.container, s.logger, handler)
 
@@ -187,9 +204,17 @@ func (s *Server) OnStart(ctx context.Context) error {
 		}
 	}()
 
+	s.ready.Store(true)
 	return nil
 }
 
+// Ready reports whether OnStart has completed: all Connect and gRPC services
+// are registered on the transcoder and the port is bound and serving.
+// It returns false again once OnStop begins.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
+
 // buildTranscoder creates the Vanguard transcoder.
 // Uses vanguardgrpc if a gRPC server is available, otherwise uses plain vanguard transcoder.
 func (s *Server) buildTranscoder(opts []vanguard.TranscoderOption) (http.Handler, error) {
@@ -213,6 +238,7 @@ func (s *Server) buildTranscoder(opts []vanguard.TranscoderOption) (http.Handler
 // It waits for active connections to drain or forces shutdown on context timeout.
 // Implements di.Stopper.
 func (s *Server) OnStop(ctx context.Context) error {
+	s.ready.Store(false)
 	if s.httpServer == nil {
 		return nil
 	}
@@ -0,0 +1,102 @@
+package vanguard
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/petabytecl/gaz/di"
+)
+
+// TestServerStreamingFlushesIncrementally checks that a server-streaming
+// gRPC method proxied through the transcoder reaches the HTTP client one
+// message at a time. Health.Watch sends the current status and then blocks
+// until it changes, so a buffered response would never deliver the first
+// message while the stream is open.
+func TestServerStreamingFlushesIncrementally(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(t)
+	cfg.Reflection = false
+	cfg.HealthEnabled = false
+
+	grpcServer := grpc.NewServer()
+	hs := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, hs)
+	hs.SetServingStatus("svc", healthpb.HealthCheckResponse_SERVING)
+
+	server := NewServer(cfg, slog.Default(), di.New(), grpcServer)
+	require.NoError(t, server.OnStart(context.Background()))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.OnStop(ctx)
+	})
+
+	reqMsg, err := proto.Marshal(&healthpb.HealthCheckRequest{Service: "svc"})
+	require.NoError(t, err)
+	var body bytes.Buffer
+	body.WriteByte(0)
+	require.NoError(t, binary.Write(&body, binary.BigEndian, uint32(len(reqMsg))))
+	body.Write(reqMsg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	url := fmt.Sprintf("http://localhost:%d/grpc.health.v1.Health/Watch", cfg.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/connect+proto")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	frames := make(chan *healthpb.HealthCheckResponse)
+	go func() {
+		defer close(frames)
+		for {
+			var header [5]byte
+			if _, readErr := io.ReadFull(resp.Body, header[:]); readErr != nil {
+				return
+			}
+			data := make([]byte, binary.BigEndian.Uint32(header[1:]))
+			if _, readErr := io.ReadFull(resp.Body, data); readErr != nil {
+				return
+			}
+			if header[0]&0x02 != 0 { // end-of-stream frame
+				return
+			}
+			msg := &healthpb.HealthCheckResponse{}
+			if proto.Unmarshal(data, msg) == nil {
+				frames <- msg
+			}
+		}
+	}()
+
+	next := func() healthpb.HealthCheckResponse_ServingStatus {
+		select {
+		case msg, ok := <-frames:
+			require.True(t, ok, "stream ended early")
+			return msg.GetStatus()
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "stream message was not flushed")
+			return 0
+		}
+	}
+
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, next())
+	hs.SetServingStatus("svc", healthpb.HealthCheckResponse_NOT_SERVING)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, next())
+}
@@ -0,0 +1,323 @@
+package vanguard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// WithDefaultTimeout bounds every proxied unary call to d; streaming methods
+// are exempt unless WithMethodTimeouts names them. The deadline is set on
+// the request context, so it propagates to the gRPC handler. gRPC, gRPC-Web,
+// and Connect clients get a DEADLINE_EXCEEDED error in their protocol. When a
+// REST call exceeds its timeout and no response has been written yet, the
+// server replies with 504 Gateway Timeout and an RFC 7807 Problem Details
+// body. Zero disables the default timeout.
+//
+// Example:
+//
+//	app.Use(vanguard.NewModule(vanguard.WithDefaultTimeout(5 * time.Second)))
+func WithDefaultTimeout(d time.Duration) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.timeouts.defaultTimeout = d
+	}
+}
+
+// WithMethodTimeouts overrides the default timeout for specific methods,
+// keyed by full method name (e.g. "/pkg.Service/Method").
+// Overrides match gRPC, Connect, and gRPC-Web requests, whose URL path is
+// the method name; REST requests use the default timeout.
+//
+// Example:
+//
+//	app.Use(vanguard.NewModule(
+//	    vanguard.WithDefaultTimeout(5*time.Second),
+//	    vanguard.WithMethodTimeouts(map[string]time.Duration{
+//	        "/report.v1.ReportService/Generate": time.Minute,
+//	    }),
+//	))
+func WithMethodTimeouts(timeouts map[string]time.Duration) ModuleOption {
+	return func(mc *moduleConfig) {
+		mc.timeouts.methods = timeouts
+	}
+}
+
+// Protocol headers carrying a call's timeout.
+const (
+	connectTimeoutHeader = "Connect-Timeout-Ms"
+	grpcTimeoutHeader    = "Grpc-Timeout"
+)
+
+// routeTimeouts holds the default and per-method call timeouts.
+type routeTimeouts struct {
+	defaultTimeout time.Duration
+	methods        map[string]time.Duration
+}
+
+// enabled reports whether any timeout is configured.
+func (t routeTimeouts) enabled() bool {
+	return t.defaultTimeout > 0 || len(t.methods) > 0
+}
+
+// validate checks that timeouts are non-negative and keyed by full method name.
+func (t routeTimeouts) validate() error {
+	if t.defaultTimeout < 0 {
+		return fmt.Errorf("vanguard: invalid default timeout %s: must not be negative", t.defaultTimeout)
+	}
+	for method, d := range t.methods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return fmt.Errorf("vanguard: method timeout key %q must be a full method name (/pkg.Service/Method)", method)
+		}
+		if d < 0 {
+			return fmt.Errorf("vanguard: invalid timeout %s for %s: must not be negative", d, method)
+		}
+	}
+	return nil
+}
+
+// forPath returns the timeout for a request path, or zero for none.
+// Streaming methods only get a timeout from WithMethodTimeouts, since
+// streams are expected to outlive unary calls.
+func (t routeTimeouts) forPath(path string) time.Duration {
+	if d, ok := t.methods[path]; ok {
+		return d
+	}
+	if isStreamingMethod(path) {
+		return 0
+	}
+	return t.defaultTimeout
+}
+
+// isStreamingMethod reports whether path names a client- or server-streaming
+// method in the global proto registry.
+func isStreamingMethod(path string) bool {
+	name := protoreflect.FullName(strings.Replace(strings.TrimPrefix(path, "/"), "/", ".", 1))
+	if !name.IsValid() {
+		return false
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return false
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	return ok && (method.IsStreamingClient() || method.IsStreamingServer())
+}
+
+// isRPCRequest reports whether r uses the gRPC, gRPC-Web, or Connect
+// protocol rather than a REST route.
+func isRPCRequest(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	return strings.HasPrefix(contentType, "application/grpc") ||
+		strings.HasPrefix(contentType, "application/connect+") ||
+		r.Header.Get("Connect-Protocol-Version") != "" ||
+		(r.Method == http.MethodGet && r.URL.Query().Get("connect") != "")
+}
+
+// problemDetails is an RFC 7807 Problem Details body.
+type problemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance"`
+}
+
+// newTimeoutHandler wraps next so that each request runs with the deadline
+// from timeouts. Requests without a timeout are passed through unchanged.
+//
+// For gRPC, gRPC-Web, and Connect requests only the context deadline is set,
+// so the protocol reports DEADLINE_EXCEEDED in its own encoding. REST
+// requests that time out before responding get a Problem Details 504.
+func newTimeoutHandler(next http.Handler, timeouts routeTimeouts) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		d := timeouts.forPath(path)
+		if d <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if isRPCRequest(r) {
+			next.ServeHTTP(w, withProtocolTimeout(r, d))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+
+		tw := &timeoutWriter{ctx: ctx, w: w, h: make(http.Header)}
+		done := make(chan struct{})
+		// The handler may rewrite the request URL (vanguard's transcoder
+		// does), so it gets a clone and the path is read once above.
+		inner := r.Clone(ctx)
+		go func() {
+			defer close(done)
+			next.ServeHTTP(tw, inner)
+		}()
+
+		select {
+		case <-done:
+			tw.mu.Lock()
+			timedOut := tw.timedOut
+			tw.mu.Unlock()
+			if timedOut {
+				writeTimeoutProblem(w, path, d)
+			}
+			return
+		case <-ctx.Done():
+		}
+
+		tw.mu.Lock()
+		if tw.wroteHeader {
+			// The response is already streaming; the cancelled context ends it.
+			tw.mu.Unlock()
+			<-done
+			return
+		}
+		tw.timedOut = true
+		tw.mu.Unlock()
+
+		writeTimeoutProblem(w, path, d)
+	})
+}
+
+// withProtocolTimeout returns r with its protocol's timeout header lowered
+// to d, so the transcoder applies the deadline and reports it in the
+// protocol's encoding. A shorter timeout sent by the client is kept.
+func withProtocolTimeout(r *http.Request, d time.Duration) *http.Request {
+	key, value := connectTimeoutHeader, strconv.FormatInt(max(d.Milliseconds(), 1), 10)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		key, value = grpcTimeoutHeader, value+"m"
+	}
+	if current, ok := parseProtocolTimeout(key, r.Header.Get(key)); ok && current <= d {
+		return r
+	}
+	r = r.Clone(r.Context())
+	r.Header.Set(key, value)
+	return r
+}
+
+// parseProtocolTimeout parses a Connect-Timeout-Ms or Grpc-Timeout value.
+func parseProtocolTimeout(key, value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	unit := time.Millisecond
+	if key == grpcTimeoutHeader {
+		units := map[byte]time.Duration{
+			'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+			'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond,
+		}
+		var ok bool
+		if unit, ok = units[value[len(value)-1]]; !ok {
+			return 0, false
+		}
+		value = value[:len(value)-1]
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// writeTimeoutProblem writes a 504 Problem Details response.
+// Instance is the request path.
+func writeTimeoutProblem(w http.ResponseWriter, path string, d time.Duration) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusGatewayTimeout)
+	_ = json.NewEncoder(w).Encode(problemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(http.StatusGatewayTimeout),
+		Status:   http.StatusGatewayTimeout,
+		Detail:   fmt.Sprintf("request exceeded the %s timeout", d),
+		Instance: path,
+	})
+}
+
+// timeoutWriter forwards writes to w until the request times out.
+// Headers are staged in h so the handler goroutine never touches w's header
+// map concurrently with the timeout response. A response started after ctx
+// expired is the handler reporting the deadline itself, so it is dropped in
+// favor of the Problem Details response.
+type timeoutWriter struct {
+	mu          sync.Mutex
+	ctx         context.Context
+	w           http.ResponseWriter
+	h           http.Header
+	wroteHeader bool
+	timedOut    bool
+}
+
+// Header returns the staged header map, or the underlying one once the
+// response has started so trailers reach the client.
+func (tw *timeoutWriter) Header() http.Header {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.wroteHeader {
+		return tw.w.Header()
+	}
+	return tw.h
+}
+
+// WriteHeader copies the staged headers and forwards the status code.
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeaderLocked(code)
+}
+
+func (tw *timeoutWriter) writeHeaderLocked(code int) {
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	if tw.ctx.Err() != nil {
+		tw.timedOut = true
+		return
+	}
+	tw.wroteHeader = true
+	dst := tw.w.Header()
+	for k, v := range tw.h {
+		dst[k] = v
+	}
+	tw.w.WriteHeader(code)
+}
+
+// Write forwards body bytes, or returns http.ErrHandlerTimeout once the
+// request has timed out.
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeaderLocked(http.StatusOK)
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	return tw.w.Write(b)
+}
+
+// Flush forwards to the underlying writer so streaming responses are not held.
+func (tw *timeoutWriter) Flush() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeaderLocked(http.StatusOK)
+	if tw.timedOut {
+		return
+	}
+	if f, ok := tw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController
+// can reach optional interfaces.
+func (tw *timeoutWriter) Unwrap() http.ResponseWriter {
+	return tw.w
+}
@@ -0,0 +1,263 @@
+package vanguard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/petabytecl/gaz/di"
+)
+
+// slowHealthServer answers health checks after delay, or fails with the
+// call's context error once its deadline passes.
+type slowHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	delay time.Duration
+}
+
+func (h *slowHealthServer) Check(ctx context.Context, _ *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	select {
+	case <-time.After(h.delay):
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// TimeoutTestSuite tests per-route timeouts on proxied calls.
+type TimeoutTestSuite struct {
+	suite.Suite
+}
+
+func TestTimeoutTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeoutTestSuite))
+}
+
+func (s *TimeoutTestSuite) assertProblem(rec *httptest.ResponseRecorder, instance string) {
+	s.Equal(http.StatusGatewayTimeout, rec.Code)
+	s.Equal("application/problem+json", rec.Header().Get("Content-Type"))
+
+	var problem problemDetails
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &problem))
+	s.Equal(http.StatusGatewayTimeout, problem.Status)
+	s.Equal("Gateway Timeout", problem.Title)
+	s.Equal(instance, problem.Instance)
+	s.Contains(problem.Detail, "timeout")
+}
+
+func (s *TimeoutTestSuite) TestTimeoutHandler_SlowCallTimesOut() {
+	block := make(chan struct{})
+	defer close(block)
+	slow := http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-block // Ignores the context deliberately.
+	})
+
+	handler := newTimeoutHandler(slow, routeTimeouts{defaultTimeout: 20 * time.Millisecond})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/echo.v1.EchoService/Echo", nil))
+
+	s.assertProblem(rec, "/echo.v1.EchoService/Echo")
+}
+
+func (s *TimeoutTestSuite) TestTimeoutHandler_FastCallPassesThrough() {
+	fast := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline := r.Context().Deadline()
+		w.Header().Set("X-Has-Deadline", fmt.Sprint(hasDeadline))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	handler := newTimeoutHandler(fast, routeTimeouts{defaultTimeout: time.Second})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/things", nil))
+
+	s.Equal(http.StatusCreated, rec.Code)
+	s.Equal("true", rec.Header().Get("X-Has-Deadline"))
+	s.Equal("ok", rec.Body.String())
+}
+
+func (s *TimeoutTestSuite) TestTimeoutHandler_MethodOverride() {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			w.WriteHeader(http.StatusOK)
+		case <-r.Context().Done():
+		}
+	})
+	handler := newTimeoutHandler(slow, routeTimeouts{
+		defaultTimeout: 10 * time.Millisecond,
+		methods:        map[string]time.Duration{"/report.v1.ReportService/Generate": time.Second},
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/report.v1.ReportService/Generate", nil))
+	s.Equal(http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/report.v1.ReportService/List", nil))
+	s.assertProblem(rec, "/report.v1.ReportService/List")
+}
+
+func (s *TimeoutTestSuite) TestTimeoutHandler_StartedResponseIsNotReplaced() {
+	streaming := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("partial"))
+		<-r.Context().Done()
+	})
+
+	handler := newTimeoutHandler(streaming, routeTimeouts{defaultTimeout: 20 * time.Millisecond})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/stream", nil))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("partial", rec.Body.String())
+}
+
+func (s *TimeoutTestSuite) TestRouteTimeoutsValidate() {
+	s.Require().NoError(routeTimeouts{}.validate())
+	s.Require().ErrorContains(routeTimeouts{defaultTimeout: -time.Second}.validate(), "must not be negative")
+	s.Require().ErrorContains(routeTimeouts{
+		methods: map[string]time.Duration{"pkg.Service/Method": time.Second},
+	}.validate(), "full method name")
+	s.Require().ErrorContains(routeTimeouts{
+		methods: map[string]time.Duration{"/pkg.Service/Method": -time.Second},
+	}.validate(), "must not be negative")
+}
+
+func (s *TimeoutTestSuite) TestModuleOptions() {
+	mc := &moduleConfig{}
+	methods := map[string]time.Duration{"/pkg.Service/Method": time.Minute}
+	WithDefaultTimeout(5 * time.Second)(mc)
+	WithMethodTimeouts(methods)(mc)
+
+	s.Equal(5*time.Second, mc.timeouts.defaultTimeout)
+	s.Equal(methods, mc.timeouts.methods)
+
+	mc = &moduleConfig{}
+	WithDefaultTimeout(-time.Second)(mc)
+	s.Require().ErrorContains(provideServerWithOptions(mc)(di.New()), "must not be negative")
+}
+
+// startHealthServer starts a Vanguard server bridging a slow gRPC health
+// service and returns its base URL.
+func (s *TimeoutTestSuite) startHealthServer(delay time.Duration, timeouts routeTimeouts) string {
+	grpcServer := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, &slowHealthServer{delay: delay})
+
+	cfg := DefaultConfig()
+	cfg.Port = getFreePort(s.T())
+	cfg.Reflection = false
+	cfg.HealthEnabled = false
+
+	server := NewServer(cfg, slog.Default(), di.New(), grpcServer)
+	server.timeouts = timeouts
+	s.Require().NoError(server.OnStart(context.Background()))
+	s.T().Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.Require().NoError(server.OnStop(stopCtx))
+	})
+
+	return fmt.Sprintf("http://localhost:%d", cfg.Port)
+}
+
+func (s *TimeoutTestSuite) callHealthCheck(baseURL string) *http.Response {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		baseURL+"/grpc.health.v1.Health/Check", strings.NewReader(`{}`))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *TimeoutTestSuite) TestServer_SlowConnectCallReturnsDeadlineExceeded() {
+	baseURL := s.startHealthServer(2*time.Second, routeTimeouts{defaultTimeout: 100 * time.Millisecond})
+
+	start := time.Now()
+	resp := s.callHealthCheck(baseURL)
+	defer func() { _ = resp.Body.Close() }()
+
+	// Connect clients get their protocol's error, not Problem Details.
+	s.Less(time.Since(start), time.Second)
+	s.Equal("application/json", resp.Header.Get("Content-Type"))
+	var body map[string]any
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&body))
+	s.Equal("deadline_exceeded", body["code"])
+}
+
+func (s *TimeoutTestSuite) TestServer_SlowGRPCCallReturnsDeadlineExceeded() {
+	baseURL := s.startHealthServer(2*time.Second, routeTimeouts{defaultTimeout: 100 * time.Millisecond})
+
+	conn, err := grpc.NewClient(strings.TrimPrefix(baseURL, "http://"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	s.Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func (s *TimeoutTestSuite) TestServer_ClientTimeoutShorterThanDefaultIsKept() {
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", nil)
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("Grpc-Timeout", "50m")
+	var got string
+	handler := newTimeoutHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Grpc-Timeout")
+	}), routeTimeouts{defaultTimeout: time.Second})
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	s.Equal("50m", got)
+
+	req.Header.Set("Grpc-Timeout", "5S")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	s.Equal("1000m", got)
+}
+
+func (s *TimeoutTestSuite) TestTimeoutHandler_StreamingMethodExempt() {
+	watch := func(timeouts routeTimeouts) string {
+		var header string
+		handler := newTimeoutHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			header = r.Header.Get("Connect-Timeout-Ms")
+		}), timeouts)
+		req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Watch", nil)
+		req.Header.Set("Content-Type", "application/connect+proto")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return header
+	}
+
+	s.Empty(watch(routeTimeouts{defaultTimeout: 10 * time.Millisecond}),
+		"streaming methods are exempt from the default timeout")
+	s.Equal("60000", watch(routeTimeouts{methods: map[string]time.Duration{
+		"/grpc.health.v1.Health/Watch": time.Minute,
+	}}), "an explicit method timeout still applies")
+}
+
+func (s *TimeoutTestSuite) TestServer_FastGRPCCallPassesThrough() {
+	baseURL := s.startHealthServer(0, routeTimeouts{defaultTimeout: time.Second})
+
+	resp := s.callHealthCheck(baseURL)
+	defer func() { _ = resp.Body.Close() }()
+
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	var body map[string]any
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&body))
+	s.Equal("SERVING", body["status"])
+}
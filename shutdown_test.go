@@ -547,6 +547,41 @@ func (s *ShutdownTestSuite) TestDoubleSIGINTForcesImmediateExit() {
 	s.Contains(logOutput, "second interrupt", "log should mention second interrupt")
 }
 
+// TestSIGINTThenOtherSignalDoesNotForceExit verifies that only a second
+// SIGINT forces exit: a SIGHUP during graceful shutdown is ignored.
+func (s *ShutdownTestSuite) TestSIGINTThenOtherSignalDoesNotForceExit() {
+	app := New(
+		WithShutdownTimeout(10*time.Second),
+		WithReloadHandler(func(context.Context) {}),
+	)
+	err := For[*slowShutdownService](app.Container()).
+		Named("SlowService").
+		Eager().
+		ProviderFunc(func(_ *Container) *slowShutdownService {
+			return &slowShutdownService{duration: 300 * time.Millisecond}
+		})
+	s.Require().NoError(err)
+	s.Require().NoError(app.Build())
+
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- app.Run(context.Background())
+	}()
+	s.True(s.waitForAppRunning(app, 1*time.Second), "app should be running")
+
+	s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGINT))
+	time.Sleep(50 * time.Millisecond)
+	s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGHUP))
+
+	select {
+	case runErr := <-runDone:
+		s.Require().NoError(runErr, "Run should complete graceful shutdown")
+	case <-time.After(2 * time.Second):
+		s.Fail("Run should return after graceful shutdown")
+	}
+	s.False(s.exitCalled.Load(), "exitFunc should NOT be called for a non-interrupt signal")
+}
+
 // TestSIGTERMDoesNotEnableDoubleSignal verifies that SIGTERM performs graceful
 // shutdown without the double-signal force exit behavior (SIGTERM + SIGKILL is
 // the standard ops pattern for force exit).
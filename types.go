@@ -1,6 +1,8 @@
 package gaz
 
 import (
+	"context"
+
 	"github.com/petabytecl/gaz/cron"
 	"github.com/petabytecl/gaz/di"
 	"github.com/petabytecl/gaz/worker"
@@ -26,6 +28,9 @@ type ResolveOption = di.ResolveOption
 // RegistrationBuilder provides a fluent API for configuring services.
 type RegistrationBuilder[T any] = di.RegistrationBuilder[T]
 
+// Factory creates a fresh instance of T from runtime arguments.
+type Factory[T any] = di.Factory[T]
+
 // ServiceWrapper is the interface for service lifecycle management.
 type ServiceWrapper = di.ServiceWrapper
 
@@ -52,6 +57,17 @@ func MustResolve[T any](c *Container, opts ...di.ResolveOption) T {
 	return di.MustResolve[T](c, opts...)
 }
 
+// ResolveOr resolves a service of type T, or returns fallback() if none is registered.
+// Panics if T is registered but resolution fails.
+func ResolveOr[T any](c *Container, fallback func() T) T {
+	return di.ResolveOr[T](c, fallback)
+}
+
+// ResolveOrErr is like ResolveOr but returns resolution errors instead of panicking.
+func ResolveOrErr[T any](c *Container, fallback func() T) (T, error) {
+	return di.ResolveOrErr[T](c, fallback)
+}
+
 // Has returns true if a service of type T is registered.
 func Has[T any](c *Container) bool {
 	return di.Has[T](c)
@@ -79,6 +95,33 @@ func ResolveGroup[T any](c *Container, group string) ([]T, error) {
 	return di.ResolveGroup[T](c, group)
 }
 
+// ProviderWithGroup registers a provider for b's type that receives the
+// members of group, in group order.
+//
+// Example:
+//
+//	gaz.ProviderWithGroup(gaz.For[*PluginManager](c), "plugins", NewPluginManager)
+func ProviderWithGroup[T, P any](b *RegistrationBuilder[T], group string, fn func(*Container, []P) (T, error)) error {
+	return di.ProviderWithGroup(b, group, fn)
+}
+
+// ResolveFactory retrieves the Factory[T] registered with RegistrationBuilder.Factory.
+func ResolveFactory[T any](c *Container, opts ...di.ResolveOption) (Factory[T], error) {
+	return di.ResolveFactory[T](c, opts...)
+}
+
+// ResolveTransientScoped resolves a service and runs its WithCleanup cleanups
+// on the instance once ctx is done.
+func ResolveTransientScoped[T any](ctx context.Context, c *Container, opts ...di.ResolveOption) (T, error) {
+	return di.ResolveTransientScoped[T](ctx, c, opts...)
+}
+
+// ResolveBatch resolves one service per pointer target under a single
+// container lock acquisition, setting the targets only if all succeed.
+func ResolveBatch(c *Container, targets ...any) error {
+	return di.ResolveBatch(c, targets...)
+}
+
 // Named resolves a service by its registered name instead of type.
 func Named(name string) di.ResolveOption {
 	return di.Named(name)
@@ -62,6 +62,35 @@
 //   - [WithStableRunPeriod] - Duration of stable run before backoff resets
 //   - [WithMaxRestarts] - Maximum restarts before circuit breaker trips
 //   - [WithCircuitWindow] - Time window for circuit breaker tracking
+//   - [WithInitialDelay] - Delay before the first start, to stagger workers
+//
+// Workers discovered by gaz are registered with default options. Implement
+// [OptionsProvider] to choose per-worker options instead:
+//
+//	func (p *Poller) WorkerOptions() []worker.WorkerOption {
+//	    return []worker.WorkerOption{worker.WithStableRunPeriod(2 * time.Minute)}
+//	}
+//
+// # Stop Order
+//
+// Workers discovered by gaz stop in reverse dependency order, like services:
+// a producer that resolves the consumer it feeds stops, and returns from
+// OnStop, before the consumer is stopped. Workers without dependencies on
+// each other stop concurrently. [Manager.SetStopOrder] sets the order for a
+// standalone Manager. A layer that does not stop within the app's per-hook
+// timeout ([WithStopLayerTimeout] for a standalone Manager) is logged and
+// the next layer stops anyway.
+//
+// # Work Queues
+//
+// [WithPoolSize] runs independent copies of a worker. For a shared queue,
+// use [NewPool], which runs a fixed number of goroutines consuming items
+// passed to Submit. A Pool is itself a Worker; OnStop stops accepting items
+// and drains the queue before returning:
+//
+//	pool := worker.NewPool("emails", 4, sendEmail)
+//	_ = manager.Register(pool)
+//	_ = pool.Submit(Email{To: "user@example.com"})
 //
 // # Panic Recovery and Restart
 //
@@ -69,6 +98,31 @@
 // If a worker panics, it is recovered, logged, and restarted with exponential
 // backoff. The [BackoffConfig] controls restart delays.
 //
+// [Manager.RestartWorker] restarts a single worker by name under a fresh
+// supervisor, for example after an external dependency is fixed. Workers
+// whose circuit breaker has tripped require [ForceRestart].
+//
+// [Manager.Healthy] gives a single yes/no answer for the workers registered
+// with [WithCritical], plus the names of those whose circuit breaker has
+// tripped. Non-critical failures do not affect it:
+//
+//	if ok, down := mgr.Healthy(); !ok {
+//	    log.Printf("critical workers down: %v", down)
+//	}
+//
+// # Heartbeats
+//
+// A worker whose goroutine is alive but stuck looks healthy to supervision.
+// Embed [Heartbeat] and call Beat from the worker loop, then create the
+// manager with [WithHeartbeatTimeout]. [Manager.HealthCheck] reports
+// [ErrHeartbeatTimeout] for workers that have not beaten within the timeout;
+// [WithHeartbeatRestart] also restarts them:
+//
+//	mgr := worker.NewManager(logger,
+//	    worker.WithHeartbeatTimeout(30*time.Second),
+//	    worker.WithHeartbeatRestart(),
+//	)
+//
 // # Backoff Configuration
 //
 // The [BackoffConfig] wraps the internal [backoff.ExponentialBackOff] with sensible defaults:
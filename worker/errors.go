@@ -20,4 +20,19 @@ var (
 	// ErrManagerAlreadyRunning indicates an attempt to register a worker
 	// after the manager has started.
 	ErrManagerAlreadyRunning = errors.New("worker: cannot register worker after manager has started")
+
+	// ErrWorkerNotFound indicates no registered worker has the given name.
+	ErrWorkerNotFound = errors.New("worker: worker not found")
+
+	// ErrManagerNotRunning indicates an operation that requires a started
+	// manager was called before Start or after Stop.
+	ErrManagerNotRunning = errors.New("worker: manager not running")
+
+	// ErrHeartbeatTimeout indicates a Heartbeater worker has not beaten within
+	// the Manager's heartbeat timeout and is likely stuck.
+	ErrHeartbeatTimeout = errors.New("worker: heartbeat timeout")
+
+	// ErrPoolClosed indicates an item was submitted to a Pool that is stopping
+	// or has stopped.
+	ErrPoolClosed = errors.New("worker: pool closed")
 )
@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Heartbeater is implemented by workers that report liveness. A worker whose
+// goroutine is alive but stuck stops beating, which the Manager detects when
+// configured with WithHeartbeatTimeout.
+//
+// Embed Heartbeat to implement it.
+type Heartbeater interface {
+	// LastHeartbeat returns the time of the most recent beat, or the zero
+	// time if the worker has not beaten yet.
+	LastHeartbeat() time.Time
+}
+
+// Heartbeat records liveness beats. Embed it in a worker and call Beat from
+// the worker's loop.
+//
+// Example:
+//
+//	type Poller struct {
+//	    worker.Heartbeat
+//	    // ...
+//	}
+//
+//	// In the poll loop:
+//	p.Beat()
+type Heartbeat struct {
+	last atomic.Int64
+}
+
+// Beat records a heartbeat at the current time.
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+// LastHeartbeat returns the time of the most recent Beat, or the zero time.
+func (h *Heartbeat) LastHeartbeat() time.Time {
+	n := h.last.Load()
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithHeartbeatTimeout marks a worker implementing Heartbeater unhealthy if
+// it has not beaten within d. Until its first beat, the time since the
+// worker started is used. Unhealthy workers fail HealthCheck with
+// ErrHeartbeatTimeout. Workers that do not implement Heartbeater are not
+// checked.
+//
+// Example:
+//
+//	mgr := worker.NewManager(logger, worker.WithHeartbeatTimeout(time.Minute))
+func WithHeartbeatTimeout(d time.Duration) ManagerOption {
+	return func(m *Manager) {
+		if d > 0 {
+			m.heartbeatTimeout = d
+		}
+	}
+}
+
+// WithHeartbeatRestart restarts a worker through RestartWorker when it misses
+// its heartbeat timeout. It has no effect without WithHeartbeatTimeout.
+func WithHeartbeatRestart() ManagerOption {
+	return func(m *Manager) {
+		m.heartbeatRestart = true
+	}
+}
+
+// heartbeater returns the Heartbeater for a supervised worker, looking
+// through pool instance wrappers.
+func heartbeater(w Worker) (Heartbeater, bool) {
+	if p, ok := w.(*pooledWorker); ok {
+		w = p.delegate
+	}
+	hb, ok := w.(Heartbeater)
+	return hb, ok
+}
+
+// heartbeatStale reports whether the supervised worker has missed its
+// heartbeat timeout as of now.
+func heartbeatStale(sup *supervisor, timeout time.Duration, now time.Time) bool {
+	hb, ok := heartbeater(sup.worker)
+	if !ok {
+		return false
+	}
+
+	last := hb.LastHeartbeat()
+	if started := sup.startedAt(); started.After(last) {
+		last = started
+	}
+	if last.IsZero() {
+		return false // Not started yet
+	}
+	return now.Sub(last) > timeout
+}
+
+// checkHeartbeats returns ErrHeartbeatTimeout naming the first supervised
+// worker that has missed its heartbeat, or nil.
+func (m *Manager) checkHeartbeats(supervisors []*supervisor) error {
+	if m.heartbeatTimeout <= 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, sup := range supervisors {
+		if heartbeatStale(sup, m.heartbeatTimeout, now) {
+			return fmt.Errorf("%w: %s (timeout %s)", ErrHeartbeatTimeout, sup.worker.Name(), m.heartbeatTimeout)
+		}
+	}
+	return nil
+}
+
+// monitorHeartbeats restarts workers that miss their heartbeat until ctx is
+// cancelled. It runs only when WithHeartbeatRestart is set.
+func (m *Manager) monitorHeartbeats(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(m.heartbeatTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.mu.Lock()
+			supervisors := append([]*supervisor(nil), m.supervisors...)
+			m.mu.Unlock()
+
+			for _, sup := range supervisors {
+				if !heartbeatStale(sup, m.heartbeatTimeout, now) {
+					continue
+				}
+				name := sup.worker.Name()
+				m.logger.WarnContext(ctx, "worker missed heartbeat, restarting",
+					slog.String("worker", name),
+					slog.Duration("timeout", m.heartbeatTimeout),
+				)
+				// Bound the wait: a stuck worker may never return from OnStop,
+				// and it must not stall monitoring of the others.
+				restartCtx, cancel := context.WithTimeout(ctx, m.heartbeatTimeout)
+				err := m.RestartWorker(restartCtx, name)
+				cancel()
+				if err != nil {
+					m.logger.ErrorContext(ctx, "heartbeat restart failed",
+						slog.String("worker", name),
+						slog.Any("error", err),
+					)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// beatingWorker beats every interval until stall is set.
+type beatingWorker struct {
+	Heartbeat
+	name       string
+	interval   time.Duration
+	stall      atomic.Bool
+	startCount atomic.Int32
+	cancel     context.CancelFunc
+}
+
+func (w *beatingWorker) OnStart(ctx context.Context) error {
+	w.startCount.Add(1)
+	w.Beat()
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !w.stall.Load() {
+					w.Beat()
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (w *beatingWorker) OnStop(_ context.Context) error {
+	w.cancel()
+	return nil
+}
+
+func (w *beatingWorker) Name() string {
+	return w.name
+}
+
+func TestHeartbeat_Beat(t *testing.T) {
+	var hb Heartbeat
+	assert.True(t, hb.LastHeartbeat().IsZero())
+
+	before := time.Now()
+	hb.Beat()
+	assert.False(t, hb.LastHeartbeat().Before(before))
+}
+
+func TestManager_HeartbeatTimeout(t *testing.T) {
+	mgr := NewManager(slog.Default(), WithHeartbeatTimeout(100*time.Millisecond))
+
+	w := &beatingWorker{name: "poller", interval: 10 * time.Millisecond}
+	require.NoError(t, mgr.Register(w))
+	require.NoError(t, mgr.Start(context.Background()))
+	defer func() { _ = mgr.Stop() }()
+
+	// Beating worker stays healthy past the timeout.
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, mgr.HealthCheck(context.Background()))
+
+	w.stall.Store(true)
+	require.Eventually(t, func() bool {
+		return mgr.HealthCheck(context.Background()) != nil
+	}, time.Second, 10*time.Millisecond)
+
+	err := mgr.HealthCheck(context.Background())
+	require.ErrorIs(t, err, ErrHeartbeatTimeout)
+	assert.Contains(t, err.Error(), "poller")
+
+	// Resuming beats makes the worker healthy again.
+	w.stall.Store(false)
+	require.Eventually(t, func() bool {
+		return mgr.HealthCheck(context.Background()) == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestManager_HeartbeatIgnoresNonHeartbeaters(t *testing.T) {
+	mgr := NewManager(slog.Default(), WithHeartbeatTimeout(10*time.Millisecond))
+
+	require.NoError(t, mgr.Register(newSimpleWorker("plain")))
+	require.NoError(t, mgr.Start(context.Background()))
+	defer func() { _ = mgr.Stop() }()
+
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, mgr.HealthCheck(context.Background()))
+}
+
+func TestManager_HeartbeatRestart(t *testing.T) {
+	mgr := NewManager(slog.Default(),
+		WithHeartbeatTimeout(50*time.Millisecond),
+		WithHeartbeatRestart(),
+	)
+
+	w := &beatingWorker{name: "poller", interval: 10 * time.Millisecond}
+	w.stall.Store(true)
+	require.NoError(t, mgr.Register(w))
+	require.NoError(t, mgr.Start(context.Background()))
+	defer func() { _ = mgr.Stop() }()
+
+	require.Eventually(t, func() bool {
+		return w.startCount.Load() >= 2
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Manager coordinates multiple workers, providing registration, startup,
@@ -13,7 +16,8 @@ import (
 //
 // Workers are registered before Start() is called. After Start(), new
 // registrations are rejected. All workers start concurrently when Start()
-// is called and stop concurrently when Stop() is called.
+// is called and stop concurrently when Stop() is called, unless a stop order
+// is set with SetStopOrder.
 //
 // Example:
 //
@@ -33,22 +37,46 @@ type Manager struct {
 
 	mu      sync.Mutex
 	running bool
+	stopped bool // true after Stop; Start recreates supervisors
 	ctx     context.Context
 	cancel  context.CancelFunc
-	wg      sync.WaitGroup
+	wg      *sync.WaitGroup // per run, waited on by Stop
+	active  int             // running supervisors; done closes when it reaches zero
 	done    chan struct{}
 
 	// Callback for critical worker failure (signals app shutdown)
 	onCriticalFail func()
+
+	// Heartbeat monitoring (see WithHeartbeatTimeout)
+	heartbeatTimeout time.Duration
+	heartbeatRestart bool
+
+	// restarts counts worker restarts since NewManager (see Stats)
+	restarts atomic.Int64
+
+	// stopOrder lists layers of worker names stopped in sequence (see SetStopOrder)
+	stopOrder [][]string
+
+	// stopLayerTimeout bounds the wait for each stop layer (see WithStopLayerTimeout)
+	stopLayerTimeout time.Duration
+
+	// restartSem serializes RestartWorker calls until each has swapped in
+	// its replacement supervisor. It is a channel so waiting honors ctx.
+	restartSem chan struct{}
 }
 
-// NewManager creates a new worker manager with the given logger.
-func NewManager(logger *slog.Logger) *Manager {
-	return &Manager{
+// NewManager creates a new worker manager with the given logger and options.
+func NewManager(logger *slog.Logger, opts ...ManagerOption) *Manager {
+	m := &Manager{
 		logger:      logger.With(slog.String("component", "worker.Manager")),
 		supervisors: make([]*supervisor, 0),
 		done:        make(chan struct{}),
+		restartSem:  make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 // SetCriticalFailHandler sets the callback invoked when a critical worker's
@@ -60,6 +88,33 @@ func (m *Manager) SetCriticalFailHandler(fn func()) {
 	m.onCriticalFail = fn
 }
 
+// SetStopOrder sets the order in which Stop stops workers. Each layer is a
+// list of worker names (Worker.Name); the workers of a layer stop
+// concurrently, and Stop waits for them before stopping the next layer.
+// Workers not listed stop concurrently after the last layer. Pool workers
+// are listed by the name of the registered worker.
+//
+// App sets this from the container's dependency graph so a worker stops
+// before the workers it depends on.
+func (m *Manager) SetStopOrder(layers [][]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopOrder = layers
+}
+
+// WithStopLayerTimeout bounds how long Stop waits for each layer set with
+// SetStopOrder. When d expires, Stop logs the layer's workers and moves on
+// to the next layer, so one worker ignoring cancellation does not keep the
+// rest running. The workers were already signalled to stop and may still
+// finish later. By default Stop waits for each layer without limit.
+func WithStopLayerTimeout(d time.Duration) ManagerOption {
+	return func(m *Manager) {
+		if d > 0 {
+			m.stopLayerTimeout = d
+		}
+	}
+}
+
 // Register adds a worker to the manager with the given options.
 // For pool workers (WithPoolSize > 1), multiple supervisors are created
 // with indexed names (e.g., "worker-1", "worker-2").
@@ -85,11 +140,11 @@ func (m *Manager) Register(w Worker, opts ...WorkerOption) error {
 				delegate: w,
 				name:     fmt.Sprintf("%s-%d", w.Name(), i),
 			}
-			sup := newSupervisor(poolWorker, options, m.logger, m.handleCriticalFail)
+			sup := m.newSupervisor(poolWorker, options)
 			m.supervisors = append(m.supervisors, sup)
 		}
 	} else {
-		sup := newSupervisor(w, options, m.logger, m.handleCriticalFail)
+		sup := m.newSupervisor(w, options)
 		m.supervisors = append(m.supervisors, sup)
 	}
 
@@ -102,9 +157,19 @@ func (m *Manager) Register(w Worker, opts ...WorkerOption) error {
 	return nil
 }
 
+// newSupervisor creates a supervisor for w that reports to the manager.
+func (m *Manager) newSupervisor(w Worker, opts *WorkerOptions) *supervisor {
+	sup := newSupervisor(w, opts, m.logger, m.handleCriticalFail)
+	sup.restarts = &m.restarts
+	return sup
+}
+
 // Start begins all registered workers concurrently.
 // It returns immediately after spawning supervisor goroutines.
 // The context controls the lifetime of all workers.
+//
+// Start may be called again after Stop to restart all workers with fresh
+// supervisors (restart counters and circuit breakers are reset).
 func (m *Manager) Start(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -113,62 +178,370 @@ func (m *Manager) Start(ctx context.Context) error {
 		return nil // Already running, idempotent
 	}
 
+	// Restarting after Stop: the previous supervisors and done channel have
+	// been consumed, so recreate them from the registered workers and options.
+	if m.stopped {
+		for i, sup := range m.supervisors {
+			m.supervisors[i] = m.newSupervisor(sup.worker, sup.opts)
+		}
+		m.done = make(chan struct{})
+		m.stopped = false
+	}
+
 	m.running = true
 	m.ctx, m.cancel = context.WithCancel(ctx)
+	wg := &sync.WaitGroup{}
+	m.wg = wg
 
 	m.logger.InfoContext(ctx, "starting workers", slog.Int("count", len(m.supervisors)))
 
-	// Start all supervisors concurrently
+	// Start all supervisors concurrently. start only spawns the supervision
+	// goroutine, so calling it here ensures RestartWorker sees it started.
+	done := m.done
+	m.active = len(m.supervisors)
+	if m.active == 0 {
+		close(done)
+	}
 	for _, sup := range m.supervisors {
-		m.wg.Add(1)
+		wg.Add(1)
+		sup.start(m.ctx)
 		go func(s *supervisor) {
-			defer m.wg.Done()
-			s.start(m.ctx)
+			defer wg.Done()
 			// Wait for supervisor to fully stop
 			<-s.wait()
+			m.supervisorExited(done)
 		}(sup)
 	}
 
-	// Watch for all workers to complete and close done channel
-	go func() {
-		m.wg.Wait()
-		close(m.done)
-	}()
+	if m.heartbeatTimeout > 0 && m.heartbeatRestart {
+		wg.Add(1)
+		go m.monitorHeartbeats(m.ctx, wg)
+	}
 
 	return nil
 }
 
+// supervisorExited records that a supervisor has stopped and closes done
+// once none are left running.
+func (m *Manager) supervisorExited(done chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active--
+	if m.active > 0 {
+		return
+	}
+	select {
+	case <-done:
+	default:
+		close(done)
+	}
+}
+
 // Stop signals all workers to stop and waits for them to complete.
 // It cancels the context and waits for all supervisor goroutines to exit.
 func (m *Manager) Stop() error {
+	return m.StopContext(context.Background())
+}
+
+// StopContext stops all workers like Stop, but gives up waiting when ctx is
+// done and returns its error. Each layer set with SetStopOrder is waited for
+// until ctx is done or the WithStopLayerTimeout expires, whichever is first;
+// StopContext then continues with the next layer.
+func (m *Manager) StopContext(ctx context.Context) error {
 	m.mu.Lock()
 	if !m.running {
 		m.mu.Unlock()
 		return nil // Not running
 	}
 	m.running = false
+	m.stopped = true
+	wg := m.wg
+	supervisors := slices.Clone(m.supervisors)
+	stopOrder := m.stopOrder
 	m.mu.Unlock()
 
-	m.logger.Info("stopping workers", slog.Int("count", len(m.supervisors)))
+	m.logger.Info("stopping workers", slog.Int("count", len(supervisors)))
+
+	// Stop ordered layers first, each after the previous has fully stopped
+	for _, layer := range stopOrder {
+		m.stopLayer(ctx, supervisors, layer)
+	}
 
-	// Cancel context to signal all supervisors
+	// Cancel context to signal the remaining supervisors
 	if m.cancel != nil {
 		m.cancel()
 	}
 
 	// Wait for all supervisors to complete
-	m.wg.Wait()
+	if !waitGroupContext(ctx, wg) {
+		return fmt.Errorf("worker: stop: %w", ctx.Err())
+	}
 
 	m.logger.Info("all workers stopped")
 	return nil
 }
 
+// stopLayer stops the supervisors of the named workers concurrently and
+// waits for them, up to the stop layer timeout or until ctx is done.
+func (m *Manager) stopLayer(ctx context.Context, supervisors []*supervisor, names []string) {
+	if m.stopLayerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.stopLayerTimeout)
+		defer cancel()
+	}
+
+	var wg sync.WaitGroup
+	for _, sup := range supervisors {
+		if slices.Contains(names, registeredName(sup.worker)) {
+			wg.Go(sup.stop)
+		}
+	}
+	if !waitGroupContext(ctx, &wg) {
+		m.logger.Warn("workers did not stop in time, stopping next layer",
+			slog.Any("workers", names),
+			slog.Any("error", ctx.Err()),
+		)
+	}
+}
+
+// waitGroupContext waits for wg and reports whether it finished before ctx
+// was done.
+func waitGroupContext(ctx context.Context, wg *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// registeredName returns the name w was registered under: the delegate's
+// name for pool workers.
+func registeredName(w Worker) string {
+	if pw, ok := w.(*pooledWorker); ok {
+		return pw.delegate.Name()
+	}
+	return w.Name()
+}
+
 // Done returns a channel that closes when all workers have stopped.
 // This is useful for external shutdown verification.
 func (m *Manager) Done() <-chan struct{} {
 	return m.done
 }
 
+// HealthCheck reports whether any supervised worker has tripped its circuit
+// breaker. It returns ErrCircuitBreakerTripped wrapped with the name of the
+// first worker whose circuit is open, or nil if all circuits are closed.
+// With WithHeartbeatTimeout, it also returns ErrHeartbeatTimeout for a
+// Heartbeater worker that has missed its heartbeat. gaz.App registers it as
+// the "workers" readiness check.
+func (m *Manager) HealthCheck(_ context.Context) error {
+	m.mu.Lock()
+	supervisors := slices.Clone(m.supervisors)
+	m.mu.Unlock()
+
+	for _, sup := range supervisors {
+		if sup.circuitOpen.Load() {
+			return fmt.Errorf("%w: %s", ErrCircuitBreakerTripped, sup.worker.Name())
+		}
+	}
+	return m.checkHeartbeats(supervisors)
+}
+
+// Healthy reports whether every critical worker is running, along with the
+// names of the critical workers that are not. A critical worker is unhealthy
+// once its circuit breaker has tripped or, with WithHeartbeatTimeout, while it
+// has missed its heartbeat. Failures of non-critical workers are ignored.
+//
+// Use HealthCheck to also account for non-critical workers.
+//
+// Example:
+//
+//	if ok, unhealthy := mgr.Healthy(); !ok {
+//	    log.Printf("critical workers down: %v", unhealthy)
+//	}
+func (m *Manager) Healthy() (bool, []string) {
+	m.mu.Lock()
+	supervisors := slices.Clone(m.supervisors)
+	m.mu.Unlock()
+
+	now := time.Now()
+	var unhealthy []string
+	for _, sup := range supervisors {
+		if !sup.opts.Critical {
+			continue
+		}
+		if sup.circuitOpen.Load() ||
+			(m.heartbeatTimeout > 0 && heartbeatStale(sup, m.heartbeatTimeout, now)) {
+			unhealthy = append(unhealthy, sup.worker.Name())
+		}
+	}
+	return len(unhealthy) == 0, unhealthy
+}
+
+// Stats is a snapshot of the Manager's worker counters.
+type Stats struct {
+	// Workers is the number of supervised workers. Pool instances are
+	// counted individually.
+	Workers int
+
+	// Running reports whether the manager has been started and not stopped.
+	Running bool
+
+	// Restarts counts restarts since the manager was created, both automatic
+	// restarts after a panic or start failure and RestartWorker calls.
+	Restarts int64
+
+	// CircuitOpen is the number of workers whose circuit breaker has tripped.
+	CircuitOpen int
+}
+
+// Stats returns a snapshot of the manager's worker counters.
+//
+// Example:
+//
+//	stats := mgr.Stats()
+//	log.Printf("%d workers, %d restarts", stats.Workers, stats.Restarts)
+func (m *Manager) Stats() Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := Stats{
+		Workers:  len(m.supervisors),
+		Running:  m.running,
+		Restarts: m.restarts.Load(),
+	}
+	for _, sup := range m.supervisors {
+		if sup.circuitOpen.Load() {
+			stats.CircuitOpen++
+		}
+	}
+	return stats
+}
+
+// RestartOption configures RestartWorker.
+type RestartOption func(*restartOptions)
+
+// restartOptions holds RestartWorker configuration.
+type restartOptions struct {
+	force bool
+}
+
+// ForceRestart allows RestartWorker to restart a worker whose circuit
+// breaker has tripped.
+func ForceRestart() RestartOption {
+	return func(o *restartOptions) {
+		o.force = true
+	}
+}
+
+// RestartWorker stops the named worker and starts it again under a fresh
+// supervisor, resetting its backoff and circuit breaker state. Other workers
+// are not affected. For pool workers, name is the indexed instance name
+// (e.g. "queue-processor-2").
+//
+// It returns ErrWorkerNotFound for unknown names, ErrManagerNotRunning if the
+// manager has not been started or is stopped before the worker could be
+// started again, and ErrCircuitBreakerTripped if the worker's circuit is
+// open, unless ForceRestart is given. Concurrent restarts run one at a time;
+// if ctx expires while waiting for another restart, ctx's error is returned.
+// If ctx expires while waiting for the worker to stop, ctx's error is
+// returned and the worker is started once the stop completes, unless the
+// manager is stopping by then.
+//
+// Example:
+//
+//	// After the upstream dependency is fixed:
+//	err := mgr.RestartWorker(ctx, "sync-worker", worker.ForceRestart())
+func (m *Manager) RestartWorker(ctx context.Context, name string, opts ...RestartOption) error {
+	var o restartOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	select {
+	case m.restartSem <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("worker: restart %s: %w", name, ctx.Err())
+	}
+	m.mu.Lock()
+	if !m.running {
+		m.mu.Unlock()
+		<-m.restartSem
+		return fmt.Errorf("%w: %s", ErrManagerNotRunning, name)
+	}
+
+	index := -1
+	for i, sup := range m.supervisors {
+		if sup.worker.Name() == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		m.mu.Unlock()
+		<-m.restartSem
+		return fmt.Errorf("%w: %s", ErrWorkerNotFound, name)
+	}
+
+	old := m.supervisors[index]
+	if old.circuitOpen.Load() && !o.force {
+		m.mu.Unlock()
+		<-m.restartSem
+		return fmt.Errorf("%w: %s (use ForceRestart to override)", ErrCircuitBreakerTripped, name)
+	}
+
+	runCtx, wg, done := m.ctx, m.wg, m.done
+	// Count the replacement before the old supervisor exits so Done does not
+	// close in between, and so Stop waits for the restart to finish.
+	wg.Add(1)
+	m.active++
+	m.mu.Unlock()
+
+	m.logger.InfoContext(ctx, "restarting worker", slog.String("worker", name))
+
+	result := make(chan error, 1)
+	go func() {
+		defer wg.Done()
+		old.stop()
+
+		// Start and swap in the replacement under mu, so Stop either sees it
+		// running or sees the old, stopped supervisor and skips it.
+		m.mu.Lock()
+		if !m.running || m.ctx != runCtx {
+			m.mu.Unlock()
+			<-m.restartSem
+			result <- fmt.Errorf("%w: %s", ErrManagerNotRunning, name)
+			m.supervisorExited(done)
+			return
+		}
+		next := m.newSupervisor(old.worker, old.opts)
+		next.start(runCtx)
+		m.supervisors[index] = next
+		m.restarts.Add(1)
+		m.mu.Unlock()
+		<-m.restartSem
+		result <- nil
+
+		<-next.wait()
+		m.supervisorExited(done)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("worker: restart %s: %w", name, ctx.Err())
+	}
+}
+
 // handleCriticalFail is called by supervisors when a critical worker's
 // circuit breaker trips.
 func (m *Manager) handleCriticalFail() {
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"sync"
 	"sync/atomic"
@@ -317,3 +318,433 @@ func TestManager_EmptyStart(t *testing.T) {
 	err = mgr.Stop()
 	assert.NoError(t, err)
 }
+
+func TestManager_HealthCheck(t *testing.T) {
+	logger := slog.Default()
+	mgr := NewManager(logger)
+
+	healthy := newSimpleWorker("healthy-worker")
+	require.NoError(t, mgr.Register(healthy))
+	require.NoError(t, mgr.Register(&errorWorker{name: "failing-worker"}, WithMaxRestarts(1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// All circuits closed before start
+	require.NoError(t, mgr.HealthCheck(ctx))
+
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	// Failing worker trips its circuit on the first failure
+	require.Eventually(t, func() bool {
+		return mgr.HealthCheck(ctx) != nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	err := mgr.HealthCheck(ctx)
+	require.ErrorIs(t, err, ErrCircuitBreakerTripped)
+	assert.Contains(t, err.Error(), "failing-worker")
+}
+
+func TestManager_Healthy_AllRunning(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	require.NoError(t, mgr.Register(newSimpleWorker("critical-worker"), WithCritical()))
+	require.NoError(t, mgr.Register(newSimpleWorker("plain-worker")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	ok, unhealthy := mgr.Healthy()
+	assert.True(t, ok)
+	assert.Empty(t, unhealthy)
+}
+
+func TestManager_Healthy_CriticalCircuitOpen(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	mgr.SetCriticalFailHandler(func() {})
+	require.NoError(t, mgr.Register(newSimpleWorker("healthy-worker"), WithCritical()))
+	require.NoError(t, mgr.Register(&errorWorker{name: "critical-failing"}, WithCritical(), WithMaxRestarts(1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	require.Eventually(t, func() bool {
+		ok, _ := mgr.Healthy()
+		return !ok
+	}, 2*time.Second, 10*time.Millisecond)
+
+	ok, unhealthy := mgr.Healthy()
+	assert.False(t, ok)
+	assert.Equal(t, []string{"critical-failing"}, unhealthy)
+}
+
+func TestManager_Healthy_IgnoresNonCritical(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	require.NoError(t, mgr.Register(newSimpleWorker("critical-worker"), WithCritical()))
+	require.NoError(t, mgr.Register(&errorWorker{name: "optional-failing"}, WithMaxRestarts(1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	// The non-critical worker trips its circuit, which HealthCheck reports...
+	require.Eventually(t, func() bool {
+		return mgr.HealthCheck(ctx) != nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// ...but Healthy only considers critical workers.
+	ok, unhealthy := mgr.Healthy()
+	assert.True(t, ok)
+	assert.Empty(t, unhealthy)
+}
+
+func TestManager_Healthy_DuringRestartWorker(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	w := newSimpleWorker("target")
+	require.NoError(t, mgr.Register(w, WithCritical()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	// Healthy and HealthCheck read the supervisors while RestartWorker swaps
+	// one in; run with -race to catch unsynchronized reads.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 20 {
+			assert.NoError(t, mgr.RestartWorker(ctx, "target"))
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			ok, _ := mgr.Healthy()
+			assert.True(t, ok)
+			return
+		default:
+			mgr.Healthy()
+			_ = mgr.HealthCheck(ctx)
+		}
+	}
+}
+
+func TestManager_RestartAfterStop(t *testing.T) {
+	logger := slog.Default()
+	mgr := NewManager(logger)
+
+	w := newSimpleWorker("restartable")
+	require.NoError(t, mgr.Register(w))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mgr.Start(ctx))
+	require.Eventually(t, func() bool { return w.getStartCount() == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, mgr.Stop())
+
+	require.NoError(t, mgr.Start(ctx))
+	require.Eventually(t, func() bool { return w.getStartCount() == 2 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, mgr.Stop())
+
+	assert.Equal(t, 2, w.getStopCount())
+	select {
+	case <-mgr.Done():
+	case <-time.After(time.Second):
+		t.Fatal("done channel not closed after restart stop")
+	}
+}
+
+// flakyWorker fails to start the first time, then runs normally.
+type flakyWorker struct {
+	simpleWorker
+	attempts atomic.Int32
+}
+
+func (w *flakyWorker) OnStart(ctx context.Context) error {
+	if w.attempts.Add(1) == 1 {
+		return errors.New("dependency unavailable")
+	}
+	return w.simpleWorker.OnStart(ctx)
+}
+
+func TestManager_RestartWorker(t *testing.T) {
+	mgr := NewManager(slog.Default())
+
+	target := newSimpleWorker("target")
+	other := newSimpleWorker("other")
+	require.NoError(t, mgr.Register(target))
+	require.NoError(t, mgr.Register(other))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+	require.Eventually(t, func() bool {
+		return target.getStartCount() == 1 && other.getStartCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, mgr.RestartWorker(ctx, "target"))
+
+	require.Eventually(t, func() bool { return target.getStartCount() == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, target.getStopCount(), "OnStop fires before the second OnStart")
+	assert.Equal(t, 1, other.getStartCount(), "other workers are not restarted")
+	assert.Equal(t, 0, other.getStopCount())
+}
+
+func TestManager_RestartWorker_Errors(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	require.NoError(t, mgr.Register(newSimpleWorker("known")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.ErrorIs(t, mgr.RestartWorker(ctx, "known"), ErrManagerNotRunning)
+
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	err := mgr.RestartWorker(ctx, "unknown")
+	require.ErrorIs(t, err, ErrWorkerNotFound)
+	assert.Contains(t, err.Error(), "unknown")
+}
+
+func TestManager_RestartWorker_CircuitOpen(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	w := &flakyWorker{simpleWorker: simpleWorker{
+		name:    "flaky",
+		started: make(chan struct{}),
+		stopped: make(chan struct{}),
+	}}
+	require.NoError(t, mgr.Register(w, WithMaxRestarts(1)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	// The first start failure trips the circuit.
+	require.Eventually(t, func() bool {
+		return errors.Is(mgr.HealthCheck(ctx), ErrCircuitBreakerTripped)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	require.ErrorIs(t, mgr.RestartWorker(ctx, "flaky"), ErrCircuitBreakerTripped)
+
+	require.NoError(t, mgr.RestartWorker(ctx, "flaky", ForceRestart()))
+	require.Eventually(t, func() bool { return w.getStartCount() == 1 }, time.Second, 10*time.Millisecond)
+
+	// The fresh supervisor starts with a closed circuit.
+	require.NoError(t, mgr.HealthCheck(ctx))
+}
+
+// liveWorker tracks how many of its instances are between OnStart and OnStop.
+type liveWorker struct {
+	name string
+	live atomic.Int32
+}
+
+func (w *liveWorker) Name() string { return w.name }
+
+func (w *liveWorker) OnStart(_ context.Context) error {
+	w.live.Add(1)
+	return nil
+}
+
+func (w *liveWorker) OnStop(_ context.Context) error {
+	w.live.Add(-1)
+	return nil
+}
+
+func TestManager_RestartWorker_RacingStop(t *testing.T) {
+	for range 50 {
+		mgr := NewManager(slog.Default())
+		w := &liveWorker{name: "racer"}
+		require.NoError(t, mgr.Register(w))
+		require.NoError(t, mgr.Start(context.Background()))
+
+		restarted := make(chan error, 1)
+		go func() { restarted <- mgr.RestartWorker(context.Background(), "racer") }()
+		require.NoError(t, mgr.Stop())
+
+		err := <-restarted
+		if err != nil {
+			require.ErrorIs(t, err, ErrManagerNotRunning)
+		}
+		require.Zero(t, w.live.Load(), "a restarted worker outlived Stop")
+		require.ErrorIs(t, mgr.RestartWorker(context.Background(), "racer"), ErrManagerNotRunning)
+	}
+}
+
+// blockingStopWorker blocks in OnStop until release is closed.
+type blockingStopWorker struct {
+	name    string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (w *blockingStopWorker) Name() string { return w.name }
+
+func (w *blockingStopWorker) OnStart(_ context.Context) error {
+	select {
+	case w.started <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (w *blockingStopWorker) OnStop(_ context.Context) error {
+	<-w.release
+	return nil
+}
+
+func TestManager_RestartWorker_WaitHonorsContext(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	w := &blockingStopWorker{name: "stuck", started: make(chan struct{}, 1), release: make(chan struct{})}
+	require.NoError(t, mgr.Register(w))
+	require.NoError(t, mgr.Start(context.Background()))
+	<-w.started
+
+	// The first restart gives up waiting for OnStop but keeps the restart
+	// slot until the stop completes.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, mgr.RestartWorker(ctx, "stuck"), context.DeadlineExceeded)
+
+	// A second restart waiting for that slot returns when its ctx expires.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	done := make(chan error, 1)
+	go func() { done <- mgr.RestartWorker(ctx2, "stuck") }()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(2 * time.Second):
+		t.Fatal("RestartWorker ignored ctx while waiting for another restart")
+	}
+
+	close(w.release)
+	require.NoError(t, mgr.Stop())
+}
+
+func TestManager_Stats(t *testing.T) {
+	mgr := NewManager(slog.Default())
+	require.NoError(t, mgr.Register(newSimpleWorker("pool"), WithPoolSize(2)))
+	w := &flakyWorker{simpleWorker: simpleWorker{
+		name:    "flaky",
+		started: make(chan struct{}),
+		stopped: make(chan struct{}),
+	}}
+	require.NoError(t, mgr.Register(w))
+
+	assert.Equal(t, Stats{Workers: 3}, mgr.Stats())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, mgr.Start(ctx))
+	defer func() { _ = mgr.Stop() }()
+
+	// The flaky worker fails its first start and is restarted automatically.
+	require.Eventually(t, func() bool { return w.getStartCount() == 1 }, 3*time.Second, 10*time.Millisecond)
+	stats := mgr.Stats()
+	assert.True(t, stats.Running)
+	assert.Equal(t, int64(1), stats.Restarts)
+
+	require.NoError(t, mgr.RestartWorker(ctx, "pool-1"))
+	assert.Equal(t, int64(2), mgr.Stats().Restarts)
+	assert.Zero(t, mgr.Stats().CircuitOpen)
+}
+
+// stopOrderWorker records its name in order when stopped.
+type stopOrderWorker struct {
+	*simpleWorker
+	delay time.Duration
+	mu    *sync.Mutex
+	order *[]string
+}
+
+func (w *stopOrderWorker) OnStop(ctx context.Context) error {
+	time.Sleep(w.delay)
+	w.mu.Lock()
+	*w.order = append(*w.order, w.name)
+	w.mu.Unlock()
+	return w.simpleWorker.OnStop(ctx)
+}
+
+func TestManager_SetStopOrder(t *testing.T) {
+	mgr := NewManager(slog.Default())
+
+	var mu sync.Mutex
+	var order []string
+	// Without ordering, the slower "producer" would stop last.
+	producer := &stopOrderWorker{simpleWorker: newSimpleWorker("producer"), delay: 50 * time.Millisecond, mu: &mu, order: &order}
+	consumer := &stopOrderWorker{simpleWorker: newSimpleWorker("consumer"), mu: &mu, order: &order}
+	unordered := &stopOrderWorker{simpleWorker: newSimpleWorker("unordered"), mu: &mu, order: &order}
+
+	require.NoError(t, mgr.Register(consumer))
+	require.NoError(t, mgr.Register(producer, WithPoolSize(2)))
+	require.NoError(t, mgr.Register(unordered))
+	mgr.SetStopOrder([][]string{{"producer"}, {"consumer"}})
+
+	require.NoError(t, mgr.Start(context.Background()))
+	for _, w := range []*stopOrderWorker{producer, consumer, unordered} {
+		select {
+		case <-w.started:
+		case <-time.After(time.Second):
+			t.Fatalf("worker %s did not start", w.name)
+		}
+	}
+	require.NoError(t, mgr.Stop())
+
+	assert.Equal(t, []string{"producer", "producer", "consumer", "unordered"}, order)
+}
+
+func TestManager_StopLayerTimeoutContinues(t *testing.T) {
+	mgr := NewManager(slog.Default(), WithStopLayerTimeout(50*time.Millisecond))
+
+	var mu sync.Mutex
+	var order []string
+	producer := &stopOrderWorker{simpleWorker: newSimpleWorker("producer"), delay: 500 * time.Millisecond, mu: &mu, order: &order}
+	consumer := &stopOrderWorker{simpleWorker: newSimpleWorker("consumer"), mu: &mu, order: &order}
+
+	require.NoError(t, mgr.Register(producer))
+	require.NoError(t, mgr.Register(consumer))
+	mgr.SetStopOrder([][]string{{"producer"}, {"consumer"}})
+
+	require.NoError(t, mgr.Start(context.Background()))
+	for _, w := range []*stopOrderWorker{producer, consumer} {
+		select {
+		case <-w.started:
+		case <-time.After(time.Second):
+			t.Fatalf("worker %s did not start", w.name)
+		}
+	}
+	require.NoError(t, mgr.Stop())
+
+	// The hung producer layer timed out, so the consumer stopped first.
+	assert.Equal(t, []string{"consumer", "producer"}, order)
+}
+
+func TestManager_StopContextExpires(t *testing.T) {
+	mgr := NewManager(slog.Default())
+
+	var mu sync.Mutex
+	var order []string
+	slow := &stopOrderWorker{simpleWorker: newSimpleWorker("slow"), delay: 500 * time.Millisecond, mu: &mu, order: &order}
+	require.NoError(t, mgr.Register(slow))
+	require.NoError(t, mgr.Start(context.Background()))
+	<-slow.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := mgr.StopContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	<-mgr.Done()
+}
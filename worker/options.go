@@ -62,6 +62,14 @@ type WorkerOptions struct {
 	// Default: 10 minutes
 	CircuitWindow time.Duration
 
+	// InitialDelay is how long the worker waits after the Manager starts it
+	// before its first OnStart. Use it to stagger workers that would
+	// otherwise all begin at once. It applies whenever the Manager starts
+	// the worker, including Manager.RestartWorker, but not to automatic
+	// restarts after a failure, which use backoff instead.
+	// Default: 0 (start immediately)
+	InitialDelay time.Duration
+
 	// OnDeadLetter is called when the circuit breaker trips.
 	// Use this to log, alert, or persist failed worker info.
 	// The handler is wrapped in recover() for safety.
@@ -71,6 +79,23 @@ type WorkerOptions struct {
 // WorkerOption configures WorkerOptions.
 type WorkerOption func(*WorkerOptions)
 
+// OptionsProvider is implemented by workers that choose their own
+// registration options. When gaz discovers a worker in the container it
+// registers it with these options, so a flaky poller can use a longer
+// StableRunPeriod or a wider CircuitWindow than a critical consumer.
+//
+// Example:
+//
+//	func (p *Poller) WorkerOptions() []worker.WorkerOption {
+//	    return []worker.WorkerOption{
+//	        worker.WithStableRunPeriod(2 * time.Minute),
+//	        worker.WithMaxRestarts(10),
+//	    }
+//	}
+type OptionsProvider interface {
+	WorkerOptions() []WorkerOption
+}
+
 // DefaultWorkerOptions returns WorkerOptions with sensible defaults.
 //
 // Default values:
@@ -174,6 +199,23 @@ func WithCircuitWindow(d time.Duration) WorkerOption {
 	}
 }
 
+// WithInitialDelay delays the worker's first OnStart by d after the Manager
+// starts it, so workers that would all begin at once (e.g. pollers hitting
+// the same API) can be staggered. A stop during the delay ends the worker
+// without calling OnStart or OnStop. Zero or negative durations start the
+// worker immediately.
+//
+// Example:
+//
+//	manager.Register(poller, WithInitialDelay(10*time.Second))
+func WithInitialDelay(d time.Duration) WorkerOption {
+	return func(o *WorkerOptions) {
+		if d > 0 {
+			o.InitialDelay = d
+		}
+	}
+}
+
 // WithDeadLetterHandler sets a callback for dead letter handling.
 // The handler is called when a worker's circuit breaker trips
 // (after MaxRestarts failures within CircuitWindow).
@@ -108,6 +108,20 @@ func TestWithCircuitWindow_IgnoresZero(t *testing.T) {
 	assert.Equal(t, 10*time.Minute, opts.CircuitWindow) // Default unchanged
 }
 
+func TestWithInitialDelay_SetsDelay(t *testing.T) {
+	opts := DefaultWorkerOptions()
+	opts.ApplyOptions(WithInitialDelay(5 * time.Second))
+
+	assert.Equal(t, 5*time.Second, opts.InitialDelay)
+}
+
+func TestWithInitialDelay_IgnoresNegative(t *testing.T) {
+	opts := DefaultWorkerOptions()
+	opts.ApplyOptions(WithInitialDelay(-time.Second))
+
+	assert.Zero(t, opts.InitialDelay) // Default unchanged
+}
+
 func TestApplyOptions_ChainsMultipleOptions(t *testing.T) {
 	opts := DefaultWorkerOptions()
 	opts.ApplyOptions(
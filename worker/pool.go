@@ -0,0 +1,237 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/petabytecl/gaz/backoff"
+)
+
+// PoolOption configures a Pool.
+type PoolOption func(*poolOptions)
+
+// poolOptions holds Pool configuration.
+type poolOptions struct {
+	queueSize int
+	logger    *slog.Logger
+}
+
+// WithQueueSize sets the number of items that can be buffered before Submit
+// blocks. Defaults to the pool's concurrency.
+func WithQueueSize(n int) PoolOption {
+	return func(o *poolOptions) {
+		if n >= 0 {
+			o.queueSize = n
+		}
+	}
+}
+
+// WithPoolLogger sets the logger used for handler errors and panics.
+// Defaults to slog.Default().
+func WithPoolLogger(logger *slog.Logger) PoolOption {
+	return func(o *poolOptions) {
+		if logger != nil {
+			o.logger = logger
+		}
+	}
+}
+
+// Pool is a Worker that runs a fixed number of goroutines consuming items
+// from a shared queue. Items are added with Submit and passed to the handler.
+//
+// Register the pool with the Manager like any other worker; OnStart launches
+// the goroutines and OnStop stops accepting items and drains the queue.
+//
+// A handler panic is recovered and logged, and the goroutine that panicked
+// waits with exponential backoff before taking the next item. Handler errors
+// are logged; retrying is up to the handler.
+//
+// Example:
+//
+//	pool := worker.NewPool("emails", 4, func(ctx context.Context, msg Email) error {
+//	    return mailer.Send(ctx, msg)
+//	})
+//	_ = manager.Register(pool)
+//	_ = pool.Submit(Email{To: "user@example.com"})
+type Pool[T any] struct {
+	name        string
+	concurrency int
+	handler     func(context.Context, T) error
+	opts        poolOptions
+
+	mu       sync.RWMutex
+	queue    chan T
+	closing  chan struct{}
+	closed   bool
+	senders  sync.WaitGroup
+	workers  sync.WaitGroup
+	runCtx   context.Context
+	abortRun context.CancelFunc
+}
+
+// NewPool creates a Pool named name that processes submitted items with
+// handler on concurrency goroutines. concurrency below 1 is treated as 1.
+func NewPool[T any](name string, concurrency int, handler func(context.Context, T) error, opts ...PoolOption) *Pool[T] {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	o := poolOptions{queueSize: concurrency, logger: slog.Default()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	p := &Pool[T]{
+		name:        name,
+		concurrency: concurrency,
+		handler:     handler,
+		opts:        o,
+	}
+	p.reset()
+	return p
+}
+
+// reset creates a fresh queue so the pool can accept items again.
+func (p *Pool[T]) reset() {
+	p.queue = make(chan T, p.opts.queueSize)
+	p.closing = make(chan struct{})
+	p.closed = false
+}
+
+// Name returns the pool name.
+func (p *Pool[T]) Name() string {
+	return p.name
+}
+
+// Submit adds item to the queue, blocking while the queue is full.
+// Items submitted before OnStart are buffered and processed once the pool
+// starts. Returns ErrPoolClosed once OnStop has begun.
+func (p *Pool[T]) Submit(item T) error {
+	p.mu.RLock()
+	if p.closed {
+		p.mu.RUnlock()
+		return fmt.Errorf("%w: %s", ErrPoolClosed, p.name)
+	}
+	queue, closing := p.queue, p.closing
+	p.senders.Add(1)
+	p.mu.RUnlock()
+	defer p.senders.Done()
+
+	select {
+	case queue <- item:
+		return nil
+	case <-closing:
+		return fmt.Errorf("%w: %s", ErrPoolClosed, p.name)
+	}
+}
+
+// OnStart launches the pool goroutines. It returns immediately.
+// Handlers receive a context that is cancelled only if OnStop's deadline
+// expires before the queue has drained.
+func (p *Pool[T]) OnStart(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		p.reset()
+	}
+
+	p.runCtx, p.abortRun = context.WithCancel(context.WithoutCancel(ctx))
+
+	for i := range p.concurrency {
+		p.workers.Add(1)
+		go p.run(p.runCtx, i+1, p.queue)
+	}
+	return nil
+}
+
+// OnStop stops accepting items, waits for queued items to be processed,
+// and returns once all goroutines have exited. If ctx expires first, the
+// handler context is cancelled and ctx's error is returned.
+func (p *Pool[T]) OnStop(ctx context.Context) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.closing)
+	queue, abort := p.queue, p.abortRun
+	p.mu.Unlock()
+
+	// No sender can reach the queue once pending Submit calls return,
+	// so closing it lets the goroutines drain and exit.
+	p.senders.Wait()
+	close(queue)
+
+	done := make(chan struct{})
+	go func() {
+		p.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		if abort != nil {
+			abort()
+		}
+		return nil
+	case <-ctx.Done():
+		if abort != nil {
+			abort()
+		}
+		return fmt.Errorf("worker: pool %s drain: %w", p.name, ctx.Err())
+	}
+}
+
+// run consumes items until the queue is closed and drained.
+func (p *Pool[T]) run(ctx context.Context, index int, queue <-chan T) {
+	defer p.workers.Done()
+
+	logger := p.opts.logger.With(slog.String("worker", fmt.Sprintf("%s-%d", p.name, index)))
+	b := backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(100*time.Millisecond),
+		backoff.WithMaxInterval(defaultMaxInterval),
+		backoff.WithMultiplier(defaultMultiplier),
+		backoff.WithRandomizationFactor(defaultRandomizationFactor),
+	)
+
+	for item := range queue {
+		if !p.handle(ctx, logger, item) {
+			b.Reset()
+			continue
+		}
+
+		delay := b.NextBackOff()
+		logger.Warn("pool worker backing off after panic", slog.Duration("delay", delay))
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+}
+
+// handle runs the handler for one item, recovering panics.
+// Returns true if the handler panicked.
+func (p *Pool[T]) handle(ctx context.Context, logger *slog.Logger, item T) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("pool handler panicked",
+				slog.Any("panic", r),
+				slog.String("stack", string(debug.Stack())),
+			)
+			panicked = true
+		}
+	}()
+
+	if err := p.handler(ctx, item); err != nil {
+		logger.Error("pool handler failed", slog.Any("error", err))
+	}
+	return false
+}
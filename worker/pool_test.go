@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quietPoolLogger() PoolOption {
+	return WithPoolLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestPool_ProcessesAllItemsConcurrently(t *testing.T) {
+	const concurrency = 3
+
+	var (
+		mu       sync.Mutex
+		seen     = map[int]bool{}
+		inFlight atomic.Int32
+		peak     atomic.Int32
+	)
+	release := make(chan struct{})
+
+	pool := NewPool("items", concurrency, func(_ context.Context, n int) error {
+		cur := inFlight.Add(1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		<-release
+		inFlight.Add(-1)
+
+		mu.Lock()
+		seen[n] = true
+		mu.Unlock()
+		return nil
+	}, quietPoolLogger())
+
+	require.NoError(t, pool.OnStart(context.Background()))
+	go func() {
+		for i := range 10 {
+			_ = pool.Submit(i)
+		}
+	}()
+
+	// All goroutines pick up an item before any finishes.
+	require.Eventually(t, func() bool {
+		return peak.Load() == concurrency
+	}, time.Second, 5*time.Millisecond)
+	close(release)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(seen) == 10
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, pool.OnStop(context.Background()))
+}
+
+func TestPool_RecoversHandlerPanics(t *testing.T) {
+	var processed atomic.Int32
+	pool := NewPool("panicky", 1, func(_ context.Context, n int) error {
+		if n == 0 {
+			panic("boom")
+		}
+		processed.Add(1)
+		return nil
+	}, quietPoolLogger())
+
+	require.NoError(t, pool.OnStart(context.Background()))
+	for i := range 3 {
+		require.NoError(t, pool.Submit(i))
+	}
+
+	require.Eventually(t, func() bool {
+		return processed.Load() == 2
+	}, 2*time.Second, 10*time.Millisecond, "items after a panic must still be processed")
+
+	require.NoError(t, pool.OnStop(context.Background()))
+}
+
+func TestPool_StopDrainsQueue(t *testing.T) {
+	var processed atomic.Int32
+	pool := NewPool("drain", 2, func(_ context.Context, _ int) error {
+		time.Sleep(5 * time.Millisecond)
+		processed.Add(1)
+		return nil
+	}, WithQueueSize(20), quietPoolLogger())
+
+	// Items submitted before start are buffered.
+	for i := range 20 {
+		require.NoError(t, pool.Submit(i))
+	}
+	require.NoError(t, pool.OnStart(context.Background()))
+	require.NoError(t, pool.OnStop(context.Background()))
+
+	assert.Equal(t, int32(20), processed.Load())
+	require.ErrorIs(t, pool.Submit(21), ErrPoolClosed)
+}
+
+func TestPool_StopRespectsDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	var handlerCtx atomic.Value
+	pool := NewPool("stuck", 1, func(ctx context.Context, _ int) error {
+		handlerCtx.Store(ctx)
+		<-release
+		return nil
+	}, quietPoolLogger())
+
+	require.NoError(t, pool.OnStart(context.Background()))
+	require.NoError(t, pool.Submit(1))
+	require.Eventually(t, func() bool { return handlerCtx.Load() != nil }, time.Second, 5*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := pool.OnStop(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The handler context is cancelled so well-behaved handlers can abort.
+	hctx, ok := handlerCtx.Load().(context.Context)
+	require.True(t, ok)
+	assert.Error(t, hctx.Err())
+}
+
+func TestPool_HandlerErrorsDoNotStopPool(t *testing.T) {
+	var calls atomic.Int32
+	pool := NewPool("errs", 1, func(_ context.Context, _ int) error {
+		calls.Add(1)
+		return errors.New("failed")
+	}, quietPoolLogger())
+
+	require.NoError(t, pool.OnStart(context.Background()))
+	for i := range 3 {
+		require.NoError(t, pool.Submit(i))
+	}
+	require.NoError(t, pool.OnStop(context.Background()))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestPool_WithManager(t *testing.T) {
+	var processed atomic.Int32
+	pool := NewPool("managed", 2, func(_ context.Context, _ string) error {
+		processed.Add(1)
+		return nil
+	}, quietPoolLogger())
+
+	mgr := NewManager(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, mgr.Register(pool))
+	require.NoError(t, mgr.Start(context.Background()))
+
+	for _, s := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, pool.Submit(s))
+	}
+
+	require.NoError(t, mgr.Stop())
+	assert.Equal(t, int32(4), processed.Load(), "Stop drains queued items")
+	require.ErrorIs(t, pool.Submit("e"), ErrPoolClosed)
+}
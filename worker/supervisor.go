@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"runtime/debug"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/petabytecl/gaz/backoff"
@@ -28,9 +29,16 @@ type supervisor struct {
 	backoff *backoff.ExponentialBackOff
 	logger  *slog.Logger
 
+	// started records when start was called (UnixNano), for heartbeat checks.
+	started atomic.Int64
+
 	// Circuit breaker state
 	failures    int
 	windowStart time.Time
+	circuitOpen atomic.Bool // set once the circuit breaker trips
+
+	// restarts, if set, counts automatic restarts (see Manager.Stats)
+	restarts *atomic.Int64
 
 	// Last error for dead letter reporting
 	lastError error
@@ -68,12 +76,25 @@ func newSupervisor(w Worker, opts *WorkerOptions, logger *slog.Logger, onCritica
 // The supervision runs until the context is cancelled or the circuit breaker trips.
 func (s *supervisor) start(ctx context.Context) {
 	s.ctx, s.cancel = context.WithCancel(ctx)
-	s.windowStart = time.Now()
+	// The worker counts as started once its initial delay has passed, so
+	// heartbeat checks do not flag it while it waits.
+	s.windowStart = time.Now().Add(s.opts.InitialDelay)
+	s.started.Store(s.windowStart.UnixNano())
 
 	s.wg.Add(1)
 	go s.supervise()
 }
 
+// startedAt returns when start was called plus the initial delay, or the
+// zero time.
+func (s *supervisor) startedAt() time.Time {
+	n := s.started.Load()
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
 // stop signals the supervisor to stop and waits for completion.
 func (s *supervisor) stop() {
 	if s.cancel != nil {
@@ -94,6 +115,10 @@ func (s *supervisor) supervise() {
 	defer s.wg.Done()
 	defer close(s.done)
 
+	if !s.waitInitialDelay() {
+		return
+	}
+
 	for {
 		// Check if context is cancelled before starting
 		select {
@@ -124,6 +149,7 @@ func (s *supervisor) supervise() {
 
 		// Check if circuit breaker should trip
 		if s.failures >= s.opts.MaxRestarts {
+			s.circuitOpen.Store(true)
 			s.logger.Error("circuit breaker tripped",
 				slog.Int("failures", s.failures),
 				slog.Duration("window", s.opts.CircuitWindow),
@@ -162,6 +188,9 @@ func (s *supervisor) supervise() {
 		select {
 		case <-timer.C:
 			// Continue to restart
+			if s.restarts != nil {
+				s.restarts.Add(1)
+			}
 		case <-s.ctx.Done():
 			timer.Stop()
 			s.logger.Info("supervisor stopping during restart delay")
@@ -170,6 +199,25 @@ func (s *supervisor) supervise() {
 	}
 }
 
+// waitInitialDelay waits for opts.InitialDelay before the first run.
+// It returns false if the context is cancelled first.
+func (s *supervisor) waitInitialDelay() bool {
+	if s.opts.InitialDelay <= 0 {
+		return true
+	}
+
+	s.logger.Info("worker start delayed", slog.Duration("delay", s.opts.InitialDelay))
+	timer := time.NewTimer(s.opts.InitialDelay)
+	select {
+	case <-timer.C:
+		return true
+	case <-s.ctx.Done():
+		timer.Stop()
+		s.logger.Info("supervisor stopping during initial delay")
+		return false
+	}
+}
+
 // runWithRecovery runs the worker and recovers from any panic.
 // Returns true if the worker panicked or failed to start, false if it exited normally.
 func (s *supervisor) runWithRecovery() (panicked bool) {
@@ -1,9 +1,11 @@
 package worker
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -232,13 +234,85 @@ func TestSupervisor_CleanExit(t *testing.T) {
 	assert.Equal(t, 1, worker.getStopCount(), "worker should be stopped once")
 }
 
-// TestSupervisor_StableRunResetBackoff tests that after StableRunPeriod, backoff resets.
+// syncBuffer is a thread-safe bytes.Buffer for capturing supervisor logs.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// slowFailWorker runs for runFor and then fails on its first start.
+// Later starts succeed.
+type slowFailWorker struct {
+	runFor     time.Duration
+	startCount int32
+}
+
+func (w *slowFailWorker) OnStart(ctx context.Context) error {
+	if atomic.AddInt32(&w.startCount, 1) > 1 {
+		return nil
+	}
+	select {
+	case <-time.After(w.runFor):
+	case <-ctx.Done():
+	}
+	return errors.New("connection lost")
+}
+
+func (w *slowFailWorker) OnStop(_ context.Context) error { return nil }
+
+func (w *slowFailWorker) Name() string { return "slow-fail-worker" }
+
+// runUntilRestart registers a slowFailWorker with opts, waits for the
+// supervisor to schedule its restart, and returns the captured logs.
+func runUntilRestart(t *testing.T, runFor time.Duration, opts ...WorkerOption) string {
+	t.Helper()
+	logs := &syncBuffer{}
+	mgr := NewManager(slog.New(slog.NewTextHandler(logs, nil)))
+	assert.NoError(t, mgr.Register(&slowFailWorker{runFor: runFor}, opts...))
+	assert.NoError(t, mgr.Start(context.Background()))
+	defer func() { _ = mgr.Stop() }()
+
+	assert.Eventually(t, func() bool {
+		return strings.Contains(logs.String(), "worker will restart")
+	}, 2*time.Second, 10*time.Millisecond)
+	return logs.String()
+}
+
+// TestSupervisor_StableRunResetBackoff tests that a worker registered with a
+// custom StableRunPeriod resets its backoff after running that long.
 func TestSupervisor_StableRunResetBackoff(t *testing.T) {
-	// This test is tricky to verify directly without exposing backoff state.
-	// We'll rely on the code path being tested through integration.
-	// The supervisor logs "worker ran stable period, resetting backoff" which
-	// we could check with a custom logger, but for now we'll trust the implementation.
-	t.Skip("Stable run period behavior tested implicitly through supervisor code path")
+	logs := runUntilRestart(t, 100*time.Millisecond, WithStableRunPeriod(50*time.Millisecond))
+
+	assert.Contains(t, logs, "resetting backoff")
+	assert.Contains(t, logs, "stable_period=50ms")
+}
+
+// TestSupervisor_StableRunResetBackoff_DefaultPeriod tests that the same run
+// is too short to reset backoff under the default StableRunPeriod.
+func TestSupervisor_StableRunResetBackoff_DefaultPeriod(t *testing.T) {
+	logs := runUntilRestart(t, 100*time.Millisecond)
+
+	assert.NotContains(t, logs, "resetting backoff")
+}
+
+// TestSupervisor_StableRunResetBackoff_ShortRun tests that a run shorter than
+// a custom StableRunPeriod does not reset backoff.
+func TestSupervisor_StableRunResetBackoff_ShortRun(t *testing.T) {
+	logs := runUntilRestart(t, 10*time.Millisecond, WithStableRunPeriod(time.Second))
+
+	assert.NotContains(t, logs, "resetting backoff")
 }
 
 // TestSupervisor_CriticalWorkerCallback tests that critical workers call onCriticalFail.
@@ -662,3 +736,93 @@ func TestSupervisor_OnStop_FreshContext(t *testing.T) {
 	// OnStop must have received a live context (not the cancelled supervisor context)
 	assert.True(t, w.stopCtxAlive.Load(), "OnStop should receive a live (non-cancelled) context")
 }
+
+// TestSupervisor_InitialDelay tests that a delayed worker does not start
+// until its initial delay has passed.
+func TestSupervisor_InitialDelay(t *testing.T) {
+	worker := newMockWorker("delayed-worker")
+	opts := DefaultWorkerOptions()
+	opts.ApplyOptions(WithInitialDelay(150 * time.Millisecond))
+	sup := newSupervisor(worker, opts, slog.Default(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	begin := time.Now()
+	sup.start(ctx)
+
+	select {
+	case <-worker.started:
+		t.Fatal("worker started before its initial delay")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	select {
+	case <-worker.started:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not start after its initial delay")
+	}
+	assert.GreaterOrEqual(t, time.Since(begin), 150*time.Millisecond)
+
+	cancel()
+	<-sup.wait()
+	assert.Equal(t, 1, worker.getStopCount())
+}
+
+// TestSupervisor_NoInitialDelayStartsImmediately tests that the default
+// zero delay keeps the immediate-start behavior.
+func TestSupervisor_NoInitialDelayStartsImmediately(t *testing.T) {
+	worker := newMockWorker("immediate-worker")
+	sup := newSupervisor(worker, DefaultWorkerOptions(), slog.Default(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sup.start(ctx)
+
+	select {
+	case <-worker.started:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("worker without initial delay did not start immediately")
+	}
+
+	cancel()
+	<-sup.wait()
+}
+
+// TestSupervisor_StopDuringInitialDelay tests that stopping a delayed worker
+// ends it without calling OnStart or OnStop.
+func TestSupervisor_StopDuringInitialDelay(t *testing.T) {
+	worker := newMockWorker("stopped-early")
+	opts := DefaultWorkerOptions()
+	opts.ApplyOptions(WithInitialDelay(time.Hour))
+	sup := newSupervisor(worker, opts, slog.Default(), nil)
+
+	sup.start(context.Background())
+	sup.stop()
+
+	select {
+	case <-sup.wait():
+	case <-time.After(time.Second):
+		t.Fatal("supervisor did not stop during initial delay")
+	}
+	assert.Equal(t, 0, worker.getStartCount())
+	assert.Equal(t, 0, worker.getStopCount())
+}
+
+// TestSupervisor_CancelledContextSkipsStart tests that a supervisor started
+// with a cancelled context never starts its worker.
+func TestSupervisor_CancelledContextSkipsStart(t *testing.T) {
+	worker := newMockWorker("never-started")
+	sup := newSupervisor(worker, DefaultWorkerOptions(), slog.Default(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sup.start(ctx)
+
+	select {
+	case <-sup.wait():
+	case <-time.After(time.Second):
+		t.Fatal("supervisor did not stop")
+	}
+	assert.Equal(t, 0, worker.getStartCount())
+	assert.Equal(t, 0, worker.getStopCount())
+}